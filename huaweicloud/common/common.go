@@ -29,6 +29,7 @@ import (
 
 	"github.com/huaweicloud/huaweicloud-sdk-go-v3/core/sdkerr"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils/fmtp"
 )
 
@@ -259,3 +260,32 @@ func HasFilledOpt(d *schema.ResourceData, param string) bool {
 	_, b := d.GetOk(param)
 	return b
 }
+
+// RequestApi sends the request to the service API which is not supported by the SDK and returns the flattened
+// response body. The path is relative to the endpoint of the client and the {project_id} in it is replaced by the
+// project ID of the client. The headers with empty values are ignored, and the nil values are removed from params.
+func RequestApi(client *golangsdk.ServiceClient, method, path string, headers map[string]string,
+	params map[string]interface{}) (interface{}, error) {
+	requestPath := client.Endpoint + strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	moreHeaders := map[string]string{"Content-Type": "application/json"}
+	for k, v := range headers {
+		if v != "" {
+			moreHeaders[k] = v
+		}
+	}
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 201, 202, 204,
+		},
+		MoreHeaders: moreHeaders,
+	}
+	if params != nil {
+		opt.JSONBody = utils.RemoveNil(params)
+	}
+	resp, err := client.Request(method, requestPath, &opt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
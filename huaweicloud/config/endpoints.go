@@ -35,6 +35,7 @@ var multiCatalogKeys = map[string][]string{
 	"waf":          {"waf-dedicated"},
 	"geminidb":     {"geminidbv31"},
 	"dli":          {"dliv2"},
+	"dataarts":     {"dataarts_dlf"},
 	"dcs":          {"dcsv1"},
 	"dis":          {"disv3"},
 	"dms":          {"dmsv2"},
@@ -360,6 +361,11 @@ var allServiceCatalog = map[string]ServiceCatalog{
 		Version: "v3",
 		Product: "DRS",
 	},
+	"ddm": {
+		Name:    "ddm",
+		Version: "v1",
+		Product: "DDM",
+	},
 
 	// catalog for management service
 	"ces": {
@@ -485,6 +491,12 @@ var allServiceCatalog = map[string]ServiceCatalog{
 		Version: "v1",
 		Product: "DataArtsStudio",
 	},
+	// dataarts_dlf is used for the DataArts Factory (data development) APIs
+	"dataarts_dlf": {
+		Name:    "dayu-dlf",
+		Version: "v1",
+		Product: "DataArtsStudio",
+	},
 	"dws": {
 		Name:    "dws",
 		Version: "v1.0",
@@ -287,6 +287,18 @@ func TestAccServiceEndpoints_Database(t *testing.T) {
 		t.Fatalf("DRS endpoint: expected %s but got %s", green(expectedURL), yellow(actualURL))
 	}
 	t.Logf("DRS endpoint:\t %s", actualURL)
+
+	// test the endpoint of DDM v1 service
+	serviceClient, err = config.NewServiceClient("ddm", HW_REGION_NAME)
+	if err != nil {
+		t.Fatalf("Error creating HuaweiCloud DDM v1 client: %s", err)
+	}
+	expectedURL = fmt.Sprintf("https://ddm.%s.%s/v1/%s/", HW_REGION_NAME, config.Cloud, config.TenantID)
+	actualURL = serviceClient.ResourceBaseURL()
+	if actualURL != expectedURL {
+		t.Fatalf("DDM v1 endpoint: expected %s but got %s", green(expectedURL), yellow(actualURL))
+	}
+	t.Logf("DDM v1 endpoint:\t %s", actualURL)
 }
 
 func TestAccServiceEndpoints_Security(t *testing.T) {
@@ -1112,6 +1124,18 @@ func TestAccServiceEndpoints_EnterpriseIntelligence(t *testing.T) {
 	}
 	t.Logf("DataArts v1 endpoint:\t %s", actualURL)
 
+	// test the endpoint of DataArts Factory v1 service
+	serviceClient, err = config.NewServiceClient("dataarts_dlf", HW_REGION_NAME)
+	if err != nil {
+		t.Fatalf("Error creating HuaweiCloud DataArts Factory v1 client: %s", err)
+	}
+	expectedURL = fmt.Sprintf("https://dayu-dlf.%s.%s/v1/%s/", HW_REGION_NAME, config.Cloud, config.TenantID)
+	actualURL = serviceClient.ResourceBaseURL()
+	if actualURL != expectedURL {
+		t.Fatalf("DataArts Factory v1 endpoint: expected %s but got %s", green(expectedURL), yellow(actualURL))
+	}
+	t.Logf("DataArts Factory v1 endpoint:\t %s", actualURL)
+
 	// test the endpoint of Workspace service (with region)
 	serviceClient, err = config.WorkspaceV2Client(HW_REGION_NAME)
 	if err != nil {
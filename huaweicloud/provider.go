@@ -35,12 +35,14 @@ import (
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dataarts"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dc"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dcs"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/ddm"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dds"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/deprecated"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dew"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dis"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dli"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dms"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dns"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/drs"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/dws"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/ecs"
@@ -62,6 +64,7 @@ import (
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/modelarts"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/mpc"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/mrs"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/nat"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/obs"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/oms"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/projectman"
@@ -409,19 +412,20 @@ func Provider() *schema.Provider {
 			"huaweicloud_evs_volumes":      evs.DataSourceEvsVolumesV2(),
 			"huaweicloud_fgs_dependencies": fgs.DataSourceFunctionGraphDependencies(),
 
-			"huaweicloud_gaussdb_cassandra_dedicated_resource": gaussdb.DataSourceGeminiDBDehResource(),
-			"huaweicloud_gaussdb_cassandra_flavors":            gaussdb.DataSourceCassandraFlavors(),
-			"huaweicloud_gaussdb_nosql_flavors":                gaussdb.DataSourceGaussDBNoSQLFlavors(),
-			"huaweicloud_gaussdb_cassandra_instance":           gaussdb.DataSourceGeminiDBInstance(),
-			"huaweicloud_gaussdb_cassandra_instances":          gaussdb.DataSourceGeminiDBInstances(),
-			"huaweicloud_gaussdb_opengauss_instance":           gaussdb.DataSourceOpenGaussInstance(),
-			"huaweicloud_gaussdb_opengauss_instances":          gaussdb.DataSourceOpenGaussInstances(),
-			"huaweicloud_gaussdb_mysql_configuration":          gaussdb.DataSourceGaussdbMysqlConfigurations(),
-			"huaweicloud_gaussdb_mysql_dedicated_resource":     gaussdb.DataSourceGaussDBMysqlDehResource(),
-			"huaweicloud_gaussdb_mysql_flavors":                gaussdb.DataSourceGaussdbMysqlFlavors(),
-			"huaweicloud_gaussdb_mysql_instance":               gaussdb.DataSourceGaussDBMysqlInstance(),
-			"huaweicloud_gaussdb_mysql_instances":              gaussdb.DataSourceGaussDBMysqlInstances(),
-			"huaweicloud_gaussdb_redis_instance":               gaussdb.DataSourceGaussRedisInstance(),
+			"huaweicloud_gaussdb_cassandra_dedicated_resource":     gaussdb.DataSourceGeminiDBDehResource(),
+			"huaweicloud_gaussdb_cassandra_flavors":                gaussdb.DataSourceCassandraFlavors(),
+			"huaweicloud_gaussdb_nosql_flavors":                    gaussdb.DataSourceGaussDBNoSQLFlavors(),
+			"huaweicloud_gaussdb_cassandra_instance":               gaussdb.DataSourceGeminiDBInstance(),
+			"huaweicloud_gaussdb_cassandra_instances":              gaussdb.DataSourceGeminiDBInstances(),
+			"huaweicloud_gaussdb_opengauss_instance":               gaussdb.DataSourceOpenGaussInstance(),
+			"huaweicloud_gaussdb_opengauss_instances":              gaussdb.DataSourceOpenGaussInstances(),
+			"huaweicloud_gaussdb_mysql_configuration":              gaussdb.DataSourceGaussdbMysqlConfigurations(),
+			"huaweicloud_gaussdb_mysql_dedicated_resource":         gaussdb.DataSourceGaussDBMysqlDehResource(),
+			"huaweicloud_gaussdb_mysql_flavors":                    gaussdb.DataSourceGaussdbMysqlFlavors(),
+			"huaweicloud_gaussdb_mysql_instance":                   gaussdb.DataSourceGaussDBMysqlInstance(),
+			"huaweicloud_gaussdb_mysql_instances":                  gaussdb.DataSourceGaussDBMysqlInstances(),
+			"huaweicloud_gaussdb_mysql_parameter_template_compare": gaussdb.DataSourceGaussDBMysqlTemplateCompare(),
+			"huaweicloud_gaussdb_redis_instance":                   gaussdb.DataSourceGaussRedisInstance(),
 
 			"huaweicloud_identity_role":        iam.DataSourceIdentityRoleV3(),
 			"huaweicloud_identity_custom_role": iam.DataSourceIdentityCustomRole(),
@@ -470,11 +474,12 @@ func Provider() *schema.Provider {
 			"huaweicloud_obs_buckets":       obs.DataSourceObsBuckets(),
 			"huaweicloud_obs_bucket_object": obs.DataSourceObsBucketObject(),
 
-			"huaweicloud_rds_flavors":         rds.DataSourceRdsFlavor(),
-			"huaweicloud_rds_engine_versions": rds.DataSourceRdsEngineVersionsV3(),
-			"huaweicloud_rds_instances":       rds.DataSourceRdsInstances(),
-			"huaweicloud_rds_backups":         rds.DataSourceBackup(),
-			"huaweicloud_rds_storage_types":   rds.DataSourceStoragetype(),
+			"huaweicloud_rds_flavors":             rds.DataSourceRdsFlavor(),
+			"huaweicloud_rds_engine_versions":     rds.DataSourceRdsEngineVersionsV3(),
+			"huaweicloud_rds_instances":           rds.DataSourceRdsInstances(),
+			"huaweicloud_rds_backups":             rds.DataSourceBackup(),
+			"huaweicloud_rds_storage_types":       rds.DataSourceStoragetype(),
+			"huaweicloud_rds_restore_time_ranges": rds.DataSourceRestoreTimeRanges(),
 
 			"huaweicloud_servicestage_component_runtimes": servicestage.DataSourceComponentRuntimes(),
 
@@ -623,9 +628,10 @@ func Provider() *schema.Provider {
 			"huaweicloud_cci_network":      cci.ResourceCciNetworkV1(),
 			"huaweicloud_cci_pvc":          ResourceCCIPersistentVolumeClaimV1(),
 
-			"huaweicloud_cdm_cluster": cdm.ResourceCdmCluster(),
-			"huaweicloud_cdm_job":     cdm.ResourceCdmJob(),
-			"huaweicloud_cdm_link":    cdm.ResourceCdmLink(),
+			"huaweicloud_cdm_cluster":    cdm.ResourceCdmCluster(),
+			"huaweicloud_cdm_job":        cdm.ResourceCdmJob(),
+			"huaweicloud_cdm_job_action": cdm.ResourceCdmJobAction(),
+			"huaweicloud_cdm_link":       cdm.ResourceCdmLink(),
 
 			"huaweicloud_cdn_domain":         resourceCdnDomainV1(),
 			"huaweicloud_ces_alarmrule":      ces.ResourceAlarmRule(),
@@ -649,44 +655,76 @@ func Provider() *schema.Provider {
 
 			"huaweicloud_csms_secret": dew.ResourceCsmsSecret(),
 
-			"huaweicloud_css_cluster":   css.ResourceCssCluster(),
-			"huaweicloud_css_snapshot":  css.ResourceCssSnapshot(),
-			"huaweicloud_css_thesaurus": css.ResourceCssthesaurus(),
+			"huaweicloud_css_cluster":                   css.ResourceCssCluster(),
+			"huaweicloud_css_snapshot":                  css.ResourceCssSnapshot(),
+			"huaweicloud_css_thesaurus":                 css.ResourceCssthesaurus(),
+			"huaweicloud_css_configuration":             css.ResourceCssConfiguration(),
+			"huaweicloud_css_cross_cluster_replication": css.ResourceCssCrossClusterReplication(),
+			"huaweicloud_css_log_setting":               css.ResourceCssLogSetting(),
+			"huaweicloud_css_plugin":                    css.ResourceCssPlugin(),
 
 			"huaweicloud_dc_virtual_gateway": dc.ResourceVirtualGateway(),
 
+			"huaweicloud_dcs_backup":   dcs.ResourceDcsBackup(),
 			"huaweicloud_dcs_instance": dcs.ResourceDcsInstance(),
+			"huaweicloud_dcs_restore":  dcs.ResourceDcsRestore(),
 
-			"huaweicloud_dds_database_role": dds.ResourceDatabaseRole(),
-			"huaweicloud_dds_database_user": dds.ResourceDatabaseUser(),
-			"huaweicloud_dds_instance":      dds.ResourceDdsInstanceV3(),
+			"huaweicloud_ddm_account":  ddm.ResourceDdmAccount(),
+			"huaweicloud_ddm_instance": ddm.ResourceDdmInstance(),
+			"huaweicloud_ddm_schema":   ddm.ResourceDdmSchema(),
 
-			"huaweicloud_dis_stream": dis.ResourceDisStream(),
-
-			"huaweicloud_dli_database":     dli.ResourceDliSqlDatabaseV1(),
-			"huaweicloud_dli_package":      dli.ResourceDliPackageV2(),
-			"huaweicloud_dli_queue":        dli.ResourceDliQueue(),
-			"huaweicloud_dli_spark_job":    dli.ResourceDliSparkJobV2(),
-			"huaweicloud_dli_sql_job":      dli.ResourceSqlJob(),
-			"huaweicloud_dli_table":        dli.ResourceDliTable(),
-			"huaweicloud_dli_flinksql_job": dli.ResourceFlinkSqlJob(),
-			"huaweicloud_dli_flinkjar_job": dli.ResourceFlinkJarJob(),
-			"huaweicloud_dli_permission":   dli.ResourceDliPermission(),
-
-			"huaweicloud_dms_kafka_user":        dms.ResourceDmsKafkaUser(),
-			"huaweicloud_dms_kafka_permissions": dms.ResourceDmsKafkaPermissions(),
-			"huaweicloud_dms_kafka_instance":    dms.ResourceDmsKafkaInstance(),
-			"huaweicloud_dms_kafka_topic":       dms.ResourceDmsKafkaTopic(),
-			"huaweicloud_dms_rabbitmq_instance": dms.ResourceDmsRabbitmqInstance(),
+			"huaweicloud_dds_database_role":      dds.ResourceDatabaseRole(),
+			"huaweicloud_dds_database_user":      dds.ResourceDatabaseUser(),
+			"huaweicloud_dds_instance":           dds.ResourceDdsInstanceV3(),
+			"huaweicloud_dds_parameter_template": dds.ResourceDdsParameterTemplate(),
 
-			"huaweicloud_dms_rocketmq_instance": dms.ResourceDmsRocketMQInstance(),
-
-			"huaweicloud_dns_ptrrecord": ResourceDNSPtrRecordV2(),
-			"huaweicloud_dns_recordset": ResourceDNSRecordSetV2(),
-			"huaweicloud_dns_zone":      ResourceDNSZoneV2(),
+			"huaweicloud_dis_stream": dis.ResourceDisStream(),
 
-			"huaweicloud_drs_job":     drs.ResourceDrsJob(),
-			"huaweicloud_dws_cluster": dws.ResourceDwsCluster(),
+			"huaweicloud_dli_database":                    dli.ResourceDliSqlDatabaseV1(),
+			"huaweicloud_dli_package":                     dli.ResourceDliPackageV2(),
+			"huaweicloud_dli_queue":                       dli.ResourceDliQueue(),
+			"huaweicloud_dli_spark_job":                   dli.ResourceDliSparkJobV2(),
+			"huaweicloud_dli_sql_job":                     dli.ResourceSqlJob(),
+			"huaweicloud_dli_table":                       dli.ResourceDliTable(),
+			"huaweicloud_dli_flinksql_job":                dli.ResourceFlinkSqlJob(),
+			"huaweicloud_dli_flinkjar_job":                dli.ResourceFlinkJarJob(),
+			"huaweicloud_dli_permission":                  dli.ResourceDliPermission(),
+			"huaweicloud_dli_datasource_connection":       dli.ResourceDliDatasourceConnection(),
+			"huaweicloud_dli_elastic_resource_pool":       dli.ResourceDliElasticResourcePool(),
+			"huaweicloud_dli_elastic_resource_pool_queue": dli.ResourceDliElasticResourcePoolQueue(),
+
+			"huaweicloud_dms_kafka_user":                 dms.ResourceDmsKafkaUser(),
+			"huaweicloud_dms_kafka_permissions":          dms.ResourceDmsKafkaPermissions(),
+			"huaweicloud_dms_kafka_instance":             dms.ResourceDmsKafkaInstance(),
+			"huaweicloud_dms_kafka_topic":                dms.ResourceDmsKafkaTopic(),
+			"huaweicloud_dms_kafka_consumer_group":       dms.ResourceDmsKafkaConsumerGroup(),
+			"huaweicloud_dms_kafka_message_offset_reset": dms.ResourceDmsKafkaMessageOffsetReset(),
+			"huaweicloud_dms_kafka_partition_reassign":   dms.ResourceDmsKafkaPartitionReassign(),
+			"huaweicloud_dms_kafka_smart_connect":        dms.ResourceDmsKafkaSmartConnect(),
+			"huaweicloud_dms_kafka_smart_connect_task":   dms.ResourceDmsKafkaSmartConnectTask(),
+			"huaweicloud_dms_rabbitmq_instance":          dms.ResourceDmsRabbitmqInstance(),
+			"huaweicloud_dms_rabbitmq_vhost":             dms.ResourceDmsRabbitMQVhost(),
+			"huaweicloud_dms_rabbitmq_exchange":          dms.ResourceDmsRabbitMQExchange(),
+			"huaweicloud_dms_rabbitmq_queue":             dms.ResourceDmsRabbitMQQueue(),
+			"huaweicloud_dms_rabbitmq_binding":           dms.ResourceDmsRabbitMQBinding(),
+			"huaweicloud_dms_rabbitmq_user":              dms.ResourceDmsRabbitMQUser(),
+
+			"huaweicloud_dms_rocketmq_instance":       dms.ResourceDmsRocketMQInstance(),
+			"huaweicloud_dms_rocketmq_topic":          dms.ResourceDmsRocketMQTopic(),
+			"huaweicloud_dms_rocketmq_consumer_group": dms.ResourceDmsRocketMQConsumerGroup(),
+			"huaweicloud_dms_rocketmq_user":           dms.ResourceDmsRocketMQUser(),
+
+			"huaweicloud_dns_custom_line": dns.ResourceCustomLine(),
+			"huaweicloud_dns_ptrrecord":   ResourceDNSPtrRecordV2(),
+			"huaweicloud_dns_recordset":   ResourceDNSRecordSetV2(),
+			"huaweicloud_dns_zone":        ResourceDNSZoneV2(),
+
+			"huaweicloud_drs_job":                drs.ResourceDrsJob(),
+			"huaweicloud_dws_cluster":            dws.ResourceDwsCluster(),
+			"huaweicloud_dws_event_subscription": dws.ResourceDwsEventSubscription(),
+			"huaweicloud_dws_logical_cluster":    dws.ResourceDwsLogicalCluster(),
+			"huaweicloud_dws_snapshot":           dws.ResourceDwsSnapshot(),
+			"huaweicloud_dws_snapshot_policy":    dws.ResourceDwsSnapshotPolicy(),
 
 			"huaweicloud_elb_certificate":     elb.ResourceCertificateV3(),
 			"huaweicloud_elb_l7policy":        elb.ResourceL7PolicyV3(),
@@ -697,6 +735,7 @@ func Provider() *schema.Provider {
 			"huaweicloud_elb_ipgroup":         elb.ResourceIpGroupV3(),
 			"huaweicloud_elb_pool":            elb.ResourcePoolV3(),
 			"huaweicloud_elb_member":          elb.ResourceMemberV3(),
+			"huaweicloud_elb_members":         elb.ResourceMembersV3(),
 			"huaweicloud_elb_logtank":         elb.ResourceLogTank(),
 			"huaweicloud_elb_security_policy": elb.ResourceSecurityPolicy(),
 
@@ -715,16 +754,27 @@ func Provider() *schema.Provider {
 			"huaweicloud_fgs_function":   fgs.ResourceFgsFunctionV2(),
 			"huaweicloud_fgs_trigger":    fgs.ResourceFunctionGraphTrigger(),
 
-			"huaweicloud_ga_accelerator": ga.ResourceAccelerator(),
-			"huaweicloud_ga_listener":    ga.ResourceListener(),
-
-			"huaweicloud_gaussdb_cassandra_instance": gaussdb.ResourceGeminiDBInstanceV3(),
-			"huaweicloud_gaussdb_mysql_instance":     gaussdb.ResourceGaussDBInstance(),
-			"huaweicloud_gaussdb_mysql_proxy":        gaussdb.ResourceGaussDBProxy(),
-			"huaweicloud_gaussdb_opengauss_instance": gaussdb.ResourceOpenGaussInstance(),
-			"huaweicloud_gaussdb_redis_instance":     gaussdb.ResourceGaussRedisInstanceV3(),
-			"huaweicloud_gaussdb_influx_instance":    gaussdb.ResourceGaussDBInfluxInstanceV3(),
-			"huaweicloud_gaussdb_mongo_instance":     gaussdb.ResourceGaussDBMongoInstanceV3(),
+			"huaweicloud_ga_accelerator":    ga.ResourceAccelerator(),
+			"huaweicloud_ga_address_group":  ga.ResourceIpAddressGroup(),
+			"huaweicloud_ga_endpoint":       ga.ResourceEndpoint(),
+			"huaweicloud_ga_endpoint_group": ga.ResourceEndpointGroup(),
+			"huaweicloud_ga_health_check":   ga.ResourceHealthCheck(),
+			"huaweicloud_ga_listener":       ga.ResourceListener(),
+
+			"huaweicloud_gaussdb_cassandra_instance":       gaussdb.ResourceGeminiDBInstanceV3(),
+			"huaweicloud_gaussdb_mysql_account":            gaussdb.ResourceGaussDBMysqlAccount(),
+			"huaweicloud_gaussdb_mysql_database":           gaussdb.ResourceGaussDBMysqlDatabase(),
+			"huaweicloud_gaussdb_mysql_instance":           gaussdb.ResourceGaussDBInstance(),
+			"huaweicloud_gaussdb_mysql_proxy":              gaussdb.ResourceGaussDBProxy(),
+			"huaweicloud_gaussdb_mysql_parameter_template": gaussdb.ResourceGaussDBMysqlTemplate(),
+			"huaweicloud_gaussdb_mysql_sql_control_rule":   gaussdb.ResourceGaussDBMysqlSqlControlRule(),
+			"huaweicloud_gaussdb_nosql_account":            gaussdb.ResourceGaussDBNoSQLAccount(),
+			"huaweicloud_gaussdb_nosql_backup":             gaussdb.ResourceGaussDBNoSQLBackup(),
+			"huaweicloud_gaussdb_nosql_database":           gaussdb.ResourceGaussDBNoSQLDatabase(),
+			"huaweicloud_gaussdb_opengauss_instance":       gaussdb.ResourceOpenGaussInstance(),
+			"huaweicloud_gaussdb_redis_instance":           gaussdb.ResourceGaussRedisInstanceV3(),
+			"huaweicloud_gaussdb_influx_instance":          gaussdb.ResourceGaussDBInfluxInstanceV3(),
+			"huaweicloud_gaussdb_mongo_instance":           gaussdb.ResourceGaussDBMongoInstanceV3(),
 
 			"huaweicloud_ges_graph": ResourceGesGraphV1(),
 
@@ -794,7 +844,10 @@ func Provider() *schema.Provider {
 			"huaweicloud_modelarts_notebook":               modelarts.ResourceNotebook(),
 			"huaweicloud_modelarts_notebook_mount_storage": modelarts.ResourceNotebookMountStorage(),
 
-			"huaweicloud_dataarts_studio_instance": dataarts.ResourceStudioInstance(),
+			"huaweicloud_dataarts_factory_job":            dataarts.ResourceFactoryJob(),
+			"huaweicloud_dataarts_studio_instance":        dataarts.ResourceStudioInstance(),
+			"huaweicloud_dataarts_studio_data_connection": dataarts.ResourceStudioDataConnection(),
+			"huaweicloud_dataarts_studio_workspace":       dataarts.ResourceStudioWorkspace(),
 
 			"huaweicloud_mpc_transcoding_template":       mpc.ResourceTranscodingTemplate(),
 			"huaweicloud_mpc_transcoding_template_group": mpc.ResourceTranscodingTemplateGroup(),
@@ -802,9 +855,13 @@ func Provider() *schema.Provider {
 			"huaweicloud_mrs_cluster": ResourceMRSClusterV1(),
 			"huaweicloud_mrs_job":     ResourceMRSJobV1(),
 
-			"huaweicloud_nat_dnat_rule": ResourceNatDnatRuleV2(),
-			"huaweicloud_nat_gateway":   ResourceNatGatewayV2(),
-			"huaweicloud_nat_snat_rule": ResourceNatSnatRuleV2(),
+			"huaweicloud_nat_dnat_rule":          ResourceNatDnatRuleV2(),
+			"huaweicloud_nat_gateway":            ResourceNatGatewayV2(),
+			"huaweicloud_nat_snat_rule":          ResourceNatSnatRuleV2(),
+			"huaweicloud_nat_private_dnat_rule":  nat.ResourcePrivateDnatRule(),
+			"huaweicloud_nat_private_gateway":    nat.ResourcePrivateGateway(),
+			"huaweicloud_nat_private_snat_rule":  nat.ResourcePrivateSnatRule(),
+			"huaweicloud_nat_private_transit_ip": nat.ResourcePrivateTransitIp(),
 
 			"huaweicloud_network_acl":              ResourceNetworkACL(),
 			"huaweicloud_network_acl_rule":         ResourceNetworkACLRule(),
@@ -820,13 +877,17 @@ func Provider() *schema.Provider {
 
 			"huaweicloud_oms_migration_task": oms.ResourceMigrationTask(),
 
-			"huaweicloud_rds_account":               rds.ResourceRdsAccount(),
-			"huaweicloud_rds_database":              rds.ResourceRdsDatabase(),
-			"huaweicloud_rds_database_privilege":    rds.ResourceRdsDatabasePrivilege(),
-			"huaweicloud_rds_instance":              rds.ResourceRdsInstance(),
-			"huaweicloud_rds_parametergroup":        rds.ResourceRdsConfiguration(),
-			"huaweicloud_rds_read_replica_instance": rds.ResourceRdsReadReplicaInstance(),
-			"huaweicloud_rds_backup":                rds.ResourceBackup(),
+			"huaweicloud_rds_account":                      rds.ResourceRdsAccount(),
+			"huaweicloud_rds_database":                     rds.ResourceRdsDatabase(),
+			"huaweicloud_rds_database_privilege":           rds.ResourceRdsDatabasePrivilege(),
+			"huaweicloud_rds_instance":                     rds.ResourceRdsInstance(),
+			"huaweicloud_rds_parametergroup":               rds.ResourceRdsConfiguration(),
+			"huaweicloud_rds_read_replica_instance":        rds.ResourceRdsReadReplicaInstance(),
+			"huaweicloud_rds_backup":                       rds.ResourceBackup(),
+			"huaweicloud_rds_cross_region_backup_strategy": rds.ResourceCrossRegionBackupStrategy(),
+			"huaweicloud_rds_dr_relationship":              rds.ResourceDrRelationship(),
+			"huaweicloud_rds_dr_switchover":                rds.ResourceDrSwitchover(),
+			"huaweicloud_rds_mysql_proxy":                  rds.ResourceMysqlProxy(),
 
 			"huaweicloud_servicestage_application":                 servicestage.ResourceApplication(),
 			"huaweicloud_servicestage_component_instance":          servicestage.ResourceComponentInstance(),
@@ -83,11 +83,31 @@ func ResourceDNSRecordSetV2() *schema.Resource {
 				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"line_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 1000),
+			},
 			"tags": tagsSchema(),
 		},
 	}
 }
 
+// isDNSRecordSetLineConfigured returns whether the line-based resolution (v2.1 API) is required,
+// which is only supported by the public zone.
+func isDNSRecordSetLineConfigured(d *schema.ResourceData) bool {
+	_, lineOk := d.GetOk("line_id")
+	_, weightOk := d.GetOkExists("weight")
+	return lineOk || weightOk
+}
+
 func resourceDNSRecordSetV2Create(d *schema.ResourceData, meta interface{}) error {
 	zoneID := d.Get("zone_id").(string)
 	dnsClient, zoneType, err := chooseDNSClientbyZoneID(d, zoneID, meta)
@@ -112,8 +132,16 @@ func resourceDNSRecordSetV2Create(d *schema.ResourceData, meta interface{}) erro
 		MapValueSpecs(d),
 	}
 
-	logp.Printf("[DEBUG] Create Options: %#v", createOpts)
-	n, err := recordsets.Create(dnsClient, zoneID, createOpts).Extract()
+	var n *recordsets.RecordSet
+	if isDNSRecordSetLineConfigured(d) {
+		if zoneType != "public" {
+			return fmtp.Errorf("the line_id and weight are only supported by the public zone")
+		}
+		n, err = createDNSRecordSetWithLine(dnsClient, zoneID, d)
+	} else {
+		logp.Printf("[DEBUG] Create Options: %#v", createOpts)
+		n, err = recordsets.Create(dnsClient, zoneID, createOpts).Extract()
+	}
 	if err != nil {
 		return fmtp.Errorf("Error creating HuaweiCloud DNS record set: %s", err)
 	}
@@ -187,6 +215,17 @@ func resourceDNSRecordSetV2Read(d *schema.ResourceData, meta interface{}) error
 	d.Set("region", GetRegion(d, config))
 	d.Set("zone_id", zoneID)
 
+	// the resolution line and weight can only be queried through the v2.1 API of the public zone
+	if zoneType == "public" {
+		lineInfo, err := getDNSRecordSetWithLine(dnsClient, zoneID, recordsetID)
+		if err != nil {
+			logp.Printf("[WARN] Error fetching line of DNS record set (%s): %s", recordsetID, err)
+		} else {
+			d.Set("line_id", utils.PathSearch("line", lineInfo, nil))
+			d.Set("weight", utils.PathSearch("weight", lineInfo, nil))
+		}
+	}
+
 	// save tags
 	resourceType, err := utils.GetDNSRecordSetTagType(zoneType)
 	if err != nil {
@@ -216,7 +255,33 @@ func resourceDNSRecordSetV2Update(d *schema.ResourceData, meta interface{}) erro
 		return err
 	}
 
-	if d.HasChanges("description", "ttl", "records") {
+	if d.HasChange("weight") {
+		if zoneType != "public" {
+			return fmtp.Errorf("the weight is only supported by the public zone")
+		}
+
+		logp.Printf("[DEBUG] Updating weight of record set %s", recordsetID)
+		if err = updateDNSRecordSetWithLine(dnsClient, zoneID, recordsetID, d); err != nil {
+			return fmtp.Errorf("Error updating HuaweiCloud DNS record set: %s", err)
+		}
+
+		logp.Printf("[DEBUG] Waiting for DNS record set (%s) to update", recordsetID)
+		stateConf := &resource.StateChangeConf{
+			Target:     []string{"ACTIVE"},
+			Pending:    []string{"PENDING"},
+			Refresh:    waitForDNSRecordSet(dnsClient, zoneID, recordsetID),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmtp.Errorf(
+				"Error waiting for record set (%s) to become ACTIVE for updation: %s",
+				recordsetID, err)
+		}
+	} else if d.HasChanges("description", "ttl", "records") {
 		var updateOpts recordsets.UpdateOpts
 		if d.HasChange("ttl") {
 			updateOpts.TTL = d.Get("ttl").(int)
@@ -311,6 +376,82 @@ func resourceDNSRecordSetV2Delete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+func buildDNSRecordSetWithLineBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        d.Get("name"),
+		"type":        d.Get("type"),
+		"description": d.Get("description"),
+		"ttl":         d.Get("ttl"),
+		"records":     utils.ExpandToStringList(d.Get("records").([]interface{})),
+		"line":        utils.ValueIngoreEmpty(d.Get("line_id")),
+		"weight":      utils.ValueIngoreEmpty(d.Get("weight")),
+	}
+}
+
+// createDNSRecordSetWithLine creates a record set with the resolution line and weight through the v2.1 API.
+func createDNSRecordSetWithLine(client *golangsdk.ServiceClient, zoneID string, d *schema.ResourceData) (*recordsets.RecordSet, error) {
+	createPath := client.Endpoint + "v2.1/zones/{zone_id}/recordsets"
+	createPath = strings.ReplaceAll(createPath, "{zone_id}", zoneID)
+
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{202},
+		JSONBody:         utils.RemoveNil(buildDNSRecordSetWithLineBodyParams(d)),
+	}
+	logp.Printf("[DEBUG] Create Options: %#v", createOpt.JSONBody)
+	createResp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	createRespBody, err := utils.FlattenResponse(createResp)
+	if err != nil {
+		return nil, err
+	}
+
+	id := utils.PathSearch("id", createRespBody, "").(string)
+	if id == "" {
+		return nil, fmtp.Errorf("unable to find the record set ID from the API response")
+	}
+	return &recordsets.RecordSet{ID: id}, nil
+}
+
+// getDNSRecordSetWithLine queries the record set through the v2.1 API, the response contains the line and weight.
+func getDNSRecordSetWithLine(client *golangsdk.ServiceClient, zoneID, recordsetID string) (interface{}, error) {
+	getPath := client.Endpoint + "v2.1/zones/{zone_id}/recordsets/{recordset_id}"
+	getPath = strings.ReplaceAll(getPath, "{zone_id}", zoneID)
+	getPath = strings.ReplaceAll(getPath, "{recordset_id}", recordsetID)
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+func updateDNSRecordSetWithLine(client *golangsdk.ServiceClient, zoneID, recordsetID string, d *schema.ResourceData) error {
+	updatePath := client.Endpoint + "v2.1/zones/{zone_id}/recordsets/{recordset_id}"
+	updatePath = strings.ReplaceAll(updatePath, "{zone_id}", zoneID)
+	updatePath = strings.ReplaceAll(updatePath, "{recordset_id}", recordsetID)
+
+	bodyParams := buildDNSRecordSetWithLineBodyParams(d)
+	// the line can not be changed after the record set is created, and the weight can be updated to 0
+	delete(bodyParams, "line")
+	bodyParams["weight"] = d.Get("weight")
+
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{202},
+		JSONBody:         utils.RemoveNil(bodyParams),
+	}
+	_, err := client.Request("PUT", updatePath, &updateOpt)
+	return err
+}
+
 func parseStatus(rawStatus string) string {
 	splits := strings.Split(rawStatus, "_")
 	// rawStatus maybe one of PENDING_CREATE, PENDING_UPDATE, PENDING_DELETE, ACTIVE, or ERROR
@@ -115,6 +115,46 @@ func TestAccDNSV2RecordSet_private(t *testing.T) {
 	})
 }
 
+func TestAccDNSV2RecordSet_line(t *testing.T) {
+	var recordset recordsets.RecordSet
+	zoneName := randomZoneName()
+	lineName := fmt.Sprintf("tf_test_%s", acctest.RandString(5))
+	resourceName := "huaweicloud_dns_recordset.recordset_1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDNS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2RecordSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSV2RecordSet_line(zoneName, lineName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2RecordSetExists(resourceName, &recordset),
+					resource.TestCheckResourceAttr(resourceName, "name", zoneName),
+					resource.TestCheckResourceAttr(resourceName, "line_id", "Dianxin"),
+					resource.TestCheckResourceAttr(resourceName, "weight", "1"),
+					resource.TestCheckResourceAttr("huaweicloud_dns_recordset.recordset_2", "weight", "3"),
+					resource.TestCheckResourceAttrPair("huaweicloud_dns_recordset.recordset_3", "line_id",
+						"huaweicloud_dns_custom_line.test", "id"),
+				),
+			},
+			{
+				Config: testAccDNSV2RecordSet_line(zoneName, lineName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2RecordSetExists(resourceName, &recordset),
+					resource.TestCheckResourceAttr(resourceName, "line_id", "Dianxin"),
+					resource.TestCheckResourceAttr(resourceName, "weight", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckDNSV2RecordSetDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*config.Config)
 	dnsClient, err := config.DnsV2Client(HW_REGION_NAME)
@@ -289,3 +329,43 @@ resource "huaweicloud_dns_recordset" "recordset_1" {
 }
 `, zoneName, zoneName)
 }
+
+func testAccDNSV2RecordSet_line(zoneName, lineName string, weight int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_dns_recordset" "recordset_1" {
+  zone_id = huaweicloud_dns_zone.zone_1.id
+  name    = "%[2]s"
+  type    = "A"
+  ttl     = 300
+  records = ["10.1.0.10"]
+  line_id = "Dianxin"
+  weight  = %[4]d
+}
+
+resource "huaweicloud_dns_recordset" "recordset_2" {
+  zone_id = huaweicloud_dns_zone.zone_1.id
+  name    = "%[2]s"
+  type    = "A"
+  ttl     = 300
+  records = ["10.1.0.11"]
+  line_id = "Dianxin"
+  weight  = 3
+}
+
+resource "huaweicloud_dns_custom_line" "test" {
+  name        = "%[3]s"
+  ip_segments = ["100.100.100.100-100.100.100.101"]
+}
+
+resource "huaweicloud_dns_recordset" "recordset_3" {
+  zone_id = huaweicloud_dns_zone.zone_1.id
+  name    = "%[2]s"
+  type    = "A"
+  ttl     = 300
+  records = ["10.1.0.12"]
+  line_id = huaweicloud_dns_custom_line.test.id
+}
+`, testAccDNSV2RecordSet_base(zoneName), zoneName, lineName, weight)
+}
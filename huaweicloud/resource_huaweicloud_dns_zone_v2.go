@@ -1,6 +1,7 @@
 package huaweicloud
 
 import (
+	"strings"
 	"time"
 
 	"github.com/chnsz/golangsdk"
@@ -101,6 +102,10 @@ func ResourceDNSZoneV2() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"dnssec_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"tags": tagsSchema(),
 		},
 	}
@@ -286,6 +291,15 @@ func resourceDNSZoneV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("zone_type", zoneInfo.ZoneType)
 	d.Set("enterprise_project_id", zoneInfo.EnterpriseProjectID)
 
+	// DNSSEC is only available for the public zone
+	if zoneInfo.ZoneType == "public" {
+		if dnssecStatus, err := getDNSZoneDnssecStatus(dnsClient, d.Id()); err == nil {
+			d.Set("dnssec_status", dnssecStatus)
+		} else {
+			logp.Printf("[WARN] Error fetching DNSSEC configuration of HuaweiCloud DNS zone: %s", err)
+		}
+	}
+
 	// save tags
 	if resourceType, err := utils.GetDNSZoneTagType(zoneInfo.ZoneType); err == nil {
 		resourceTags, err := tags.Get(dnsClient, resourceType, d.Id()).Extract()
@@ -482,6 +496,26 @@ func resourceDNSZoneV2Delete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+func getDNSZoneDnssecStatus(client *golangsdk.ServiceClient, zoneID string) (string, error) {
+	getPath := client.Endpoint + "v2/zones/{zone_id}/dnssec"
+	getPath = strings.ReplaceAll(getPath, "{zone_id}", zoneID)
+
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return "", err
+	}
+
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return "", err
+	}
+	return utils.PathSearch("status", getRespBody, "").(string), nil
+}
+
 func waitForDNSZone(dnsClient *golangsdk.ServiceClient, zoneId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		zone, err := zones.Get(dnsClient, zoneId).Extract()
@@ -59,6 +59,11 @@ var (
 
 	HW_DLI_FLINK_JAR_OBS_PATH = os.Getenv("HW_DLI_FLINK_JAR_OBS_PATH")
 
+	HW_CSS_PLUGIN_PACKAGE_PATH = os.Getenv("HW_CSS_PLUGIN_PACKAGE_PATH") // Local path of the custom plugin package
+
+	HW_DATAARTS_INSTANCE_ID  = os.Getenv("HW_DATAARTS_INSTANCE_ID")
+	HW_DATAARTS_WORKSPACE_ID = os.Getenv("HW_DATAARTS_WORKSPACE_ID")
+
 	HW_GITHUB_REPO_HOST      = os.Getenv("HW_GITHUB_REPO_HOST")      // Repository host (Github, Gitlab, Gitee)
 	HW_GITHUB_PERSONAL_TOKEN = os.Getenv("HW_GITHUB_PERSONAL_TOKEN") // Personal access token (Github, Gitlab, Gitee)
 	HW_GITHUB_REPO_PWD       = os.Getenv("HW_GITHUB_REPO_PWD")       // Repository password (DevCloud, BitBucket)
@@ -99,6 +104,15 @@ var (
 	HW_RF_TEMPLATE_ARCHIVE_URI = os.Getenv("HW_RF_TEMPLATE_ARCHIVE_URI")
 	// The OBS address where the variable archive corresponding to the HCL/JSON template is located.
 	HW_RF_VARIABLES_ARCHIVE_URI = os.Getenv("HW_RF_VARIABLES_ARCHIVE_URI")
+
+	HW_DDM_FLAVOR_ID = os.Getenv("HW_DDM_FLAVOR_ID") // The flavor ID of the DDM instance nodes.
+	HW_DDM_ENGINE_ID = os.Getenv("HW_DDM_ENGINE_ID") // The engine ID of the DDM instance.
+
+	// The ID of the DWS cluster which has a ring not belonging to any logical cluster.
+	HW_DWS_CLUSTER_ID = os.Getenv("HW_DWS_CLUSTER_ID")
+	// The hosts of the free ring in JSON format, e.g. '[{"host_name":"xxx","back_ip":"192.168.0.10","cpu_cores":8,
+	// "memory":64,"disk_size":1024}]'.
+	HW_DWS_RING_HOSTS = os.Getenv("HW_DWS_RING_HOSTS")
 )
 
 // TestAccProviders is a static map containing only the main provider instance.
@@ -317,6 +331,27 @@ func TestAccPreCheckDliJarPath(t *testing.T) {
 	}
 }
 
+// lintignore:AT003
+func TestAccPreCheckCssPluginPackage(t *testing.T) {
+	if HW_CSS_PLUGIN_PACKAGE_PATH == "" {
+		t.Skip("HW_CSS_PLUGIN_PACKAGE_PATH must be set for CSS custom plugin acceptance tests.")
+	}
+}
+
+// lintignore:AT003
+func TestAccPreCheckDataArtsInstanceID(t *testing.T) {
+	if HW_DATAARTS_INSTANCE_ID == "" {
+		t.Skip("HW_DATAARTS_INSTANCE_ID must be set for DataArts Studio workspace acceptance tests.")
+	}
+}
+
+// lintignore:AT003
+func TestAccPreCheckDataArtsWorkspaceID(t *testing.T) {
+	if HW_DATAARTS_WORKSPACE_ID == "" {
+		t.Skip("HW_DATAARTS_WORKSPACE_ID must be set for DataArts Factory acceptance tests.")
+	}
+}
+
 // lintignore:AT003
 func TestAccPreCheckRepoTokenAuth(t *testing.T) {
 	if HW_GITHUB_REPO_HOST == "" || HW_GITHUB_PERSONAL_TOKEN == "" {
@@ -440,3 +475,17 @@ func TestAccPreCheckRfArchives(t *testing.T) {
 		t.Skip("Skip the archive URI parameters acceptance test for RF resource stack.")
 	}
 }
+
+// lintignore:AT003
+func TestAccPreCheckDDM(t *testing.T) {
+	if HW_DDM_FLAVOR_ID == "" || HW_DDM_ENGINE_ID == "" {
+		t.Skip("HW_DDM_FLAVOR_ID and HW_DDM_ENGINE_ID must be set for DDM acceptance tests.")
+	}
+}
+
+// lintignore:AT003
+func TestAccPreCheckDwsLogicalCluster(t *testing.T) {
+	if HW_DWS_CLUSTER_ID == "" || HW_DWS_RING_HOSTS == "" {
+		t.Skip("HW_DWS_CLUSTER_ID and HW_DWS_RING_HOSTS must be set for DWS logical cluster acceptance tests.")
+	}
+}
@@ -0,0 +1,54 @@
+package cdm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccResourceCdmJobAction_basic(t *testing.T) {
+	resourceName := "huaweicloud_cdm_job_action.test"
+	name := acceptance.RandomAccResourceName()
+	bucketName := acceptance.RandomAccResourceNameWithDash()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCdmJobAction_basic(name, bucketName, "start"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action", "start"),
+					resource.TestCheckResourceAttrPair(resourceName, "job_name", "huaweicloud_cdm_job.test", "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+			{
+				Config: testAccCdmJobAction_basic(name, bucketName, "stop"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action", "stop"),
+					resource.TestCheckResourceAttrSet(resourceName, "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCdmJobAction_basic(name, bucketName, action string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_cdm_job_action" "test" {
+  cluster_id = huaweicloud_cdm_cluster.test.id
+  job_name   = huaweicloud_cdm_job.test.name
+  action     = "%s"
+}
+`, testAccCdmJob_incremental(name, bucketName, acceptance.HW_ACCESS_KEY, acceptance.HW_SECRET_KEY,
+		"2024-01-01 00:00:00"), action)
+}
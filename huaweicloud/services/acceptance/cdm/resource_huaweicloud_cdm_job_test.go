@@ -2,6 +2,7 @@ package cdm
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/chnsz/golangsdk/openstack/cdm/v1/job"
@@ -63,9 +64,59 @@ func TestAccResourceCdmJob_basic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"auto_start"},
+			},
+		},
+	})
+}
+
+func TestAccResourceCdmJob_incremental(t *testing.T) {
+	var obj job.JobCreateOpts
+	resourceName := "huaweicloud_cdm_job.test"
+	name := acceptance.RandomAccResourceName()
+	bucketName := acceptance.RandomAccResourceNameWithDash()
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getCdmJobResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCdmJob_incremental(name, bucketName, acceptance.HW_ACCESS_KEY, acceptance.HW_SECRET_KEY,
+					"2024-01-01 00:00:00"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "auto_start", "false"),
+					resource.TestCheckResourceAttr(resourceName, "config.0.scheduler_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "config.0.scheduler_cycle_type", "day"),
+					resource.TestCheckResourceAttr(resourceName, "config.0.retry_type", "RETRY_TRIPLE"),
+					resource.TestCheckResourceAttr(resourceName, "incremental_migration.0.time_filter_start",
+						"2024-01-01 00:00:00"),
+					resource.TestCheckResourceAttr(resourceName, "incremental_migration.0.time_filter_end",
+						"${dateformat(yyyy-MM-dd HH:mm:ss)}"),
+					resource.TestCheckNoResourceAttr(resourceName, "source_job_config.minTime"),
+				),
+			},
+			{
+				Config: testAccCdmJob_incremental(name, bucketName, acceptance.HW_ACCESS_KEY, acceptance.HW_SECRET_KEY,
+					"${dateformat(yyyy-MM-dd HH:mm:ss, -1, DAY)}"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "incremental_migration.0.time_filter_start",
+						"${dateformat(yyyy-MM-dd HH:mm:ss, -1, DAY)}"),
+				),
 			},
 		},
 	})
@@ -154,3 +205,79 @@ resource "huaweicloud_cdm_job" "test" {
 }
 `, clusterConfig, bucketName, bucketName, name, ak, sk, name)
 }
+
+func testAccCdmJob_incremental(name, bucketName, ak, sk, startTime string) string {
+	clusterConfig := testAccCdmCluster_basic(name)
+
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_obs_bucket" "input" {
+  bucket        = "%[2]s-input"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "huaweicloud_obs_bucket" "output" {
+  bucket        = "%[2]s-output"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "huaweicloud_cdm_link" "test" {
+  name       = "%[3]s"
+  connector  = "obs-connector"
+  cluster_id = huaweicloud_cdm_cluster.test.id
+  enabled    = true
+
+  config = {
+    "storageType" = "OBS"
+    "server"      = trimprefix(huaweicloud_obs_bucket.output.bucket_domain_name, "${huaweicloud_obs_bucket.output.bucket}.")
+    "port"        = "443"
+  }
+
+  access_key = "%[4]s"
+  secret_key = "%[5]s"
+}
+
+resource "huaweicloud_cdm_job" "test" {
+  name       = "%[3]s"
+  job_type   = "NORMAL_JOB"
+  cluster_id = huaweicloud_cdm_cluster.test.id
+  auto_start = false
+
+  source_connector = "obs-connector"
+  source_link_name = huaweicloud_cdm_link.test.name
+  source_job_config = {
+    "bucketName"      = huaweicloud_obs_bucket.input.bucket
+    "inputDirectory"  = "/"
+    "inputFormat"     = "BINARY_FILE"
+    "fromCompression" = "NONE"
+    "fromFileOpType"  = "DO_NOTHING"
+    "filterType"      = "NONE"
+  }
+
+  incremental_migration {
+    time_filter_start = "%[6]s"
+    time_filter_end   = "$${dateformat(yyyy-MM-dd HH:mm:ss)}"
+  }
+
+  destination_connector = "obs-connector"
+  destination_link_name = huaweicloud_cdm_link.test.name
+  destination_job_config = {
+    "bucketName"          = huaweicloud_obs_bucket.output.bucket
+    "outputDirectory"     = "/"
+    "outputFormat"        = "BINARY_FILE"
+    "duplicateFileOpType" = "SKIP"
+  }
+
+  config {
+    retry_type           = "RETRY_TRIPLE"
+    scheduler_enabled    = true
+    scheduler_cycle_type = "day"
+    scheduler_cycle      = 1
+    scheduler_start_date = "2024-01-01 02:00:00"
+  }
+}
+`, clusterConfig, bucketName, name, ak, sk, strings.ReplaceAll(startTime, "${", "$${"))
+}
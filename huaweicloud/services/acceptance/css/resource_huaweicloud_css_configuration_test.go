@@ -0,0 +1,97 @@
+package css
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// getCssApiResource queries the CSS API, the path is relative to the endpoint and starts with the API version.
+func getCssApiResource(cfg *config.Config, path string) (interface{}, error) {
+	client, err := cfg.NewServiceClient("css", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CSS client: %s", err)
+	}
+
+	getPath := client.Endpoint + strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+func getCssConfigurationResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getCssApiResource(cfg, fmt.Sprintf("v1.0/{project_id}/clusters/%s/ymls/template", state.Primary.ID))
+}
+
+func TestAccCssConfiguration_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_css_configuration.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getCssConfigurationResourceFunc,
+	)
+
+	// The parameters are kept after the resource is destroyed, so the destruction is not checked.
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssConfiguration_basic(name, "100"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "cluster_id", "huaweicloud_css_cluster.test", "id"),
+					resource.TestCheckResourceAttr(rName, "parameters.thread_pool.force_merge.size", "1"),
+					resource.TestCheckResourceAttr(rName, "parameters.http.cors.max-age", "100"),
+				),
+			},
+			{
+				Config: testAccCssConfiguration_basic(name, "200"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "parameters.http.cors.max-age", "200"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCssConfiguration_basic(name, maxAge string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_css_configuration" "test" {
+  cluster_id = huaweicloud_css_cluster.test.id
+
+  parameters = {
+    "thread_pool.force_merge.size" = "1"
+    "http.cors.max-age"            = "%s"
+  }
+}
+`, testAccCssCluster_basic(name, 1, 1, "value"), maxAge)
+}
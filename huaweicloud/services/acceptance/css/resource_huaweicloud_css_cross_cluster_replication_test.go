@@ -0,0 +1,99 @@
+package css
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getCssCrossClusterReplicationResourceFunc(cfg *config.Config,
+	state *terraform.ResourceState) (interface{}, error) {
+	clusterId := state.Primary.Attributes["cluster_id"]
+	respBody, err := getCssApiResource(cfg, fmt.Sprintf("v1.0/{project_id}/clusters/%s/ccr/rules", clusterId))
+	if err != nil {
+		return nil, err
+	}
+
+	expression := fmt.Sprintf("rules[?name=='%s']|[0]", state.Primary.Attributes["name"])
+	rule := utils.PathSearch(expression, respBody, nil)
+	if rule == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return rule, nil
+}
+
+func TestAccCssCrossClusterReplication_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_css_cross_cluster_replication.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getCssCrossClusterReplicationResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssCrossClusterReplication_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "cluster_id", "huaweicloud_css_cluster.follower", "id"),
+					resource.TestCheckResourceAttrPair(rName, "leader_cluster_id", "huaweicloud_css_cluster.test", "id"),
+					resource.TestCheckResourceAttr(rName, "indices.#", "2"),
+					resource.TestCheckResourceAttr(rName, "indices.1", "logs-*"),
+					resource.TestCheckResourceAttrSet(rName, "status"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCssCrossClusterReplication_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_css_cluster" "follower" {
+  name           = "%[2]s-follower"
+  engine_version = "7.10.2"
+
+  ess_node_config {
+    flavor          = "ess.spec-4u8g"
+    instance_number = 1
+    volume {
+      volume_type = "HIGH"
+      size        = 40
+    }
+  }
+
+  availability_zone = data.huaweicloud_availability_zones.test.names[0]
+  security_group_id = huaweicloud_networking_secgroup.test.id
+  subnet_id         = huaweicloud_vpc_subnet.test.id
+  vpc_id            = huaweicloud_vpc.test.id
+}
+
+resource "huaweicloud_css_cross_cluster_replication" "test" {
+  cluster_id        = huaweicloud_css_cluster.follower.id
+  name              = "%[2]s"
+  leader_cluster_id = huaweicloud_css_cluster.test.id
+  indices           = ["orders", "logs-*"]
+}
+`, testAccCssCluster_basic(name, 1, 1, "value"), name)
+}
@@ -0,0 +1,98 @@
+package css
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getCssLogSettingResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	respBody, err := getCssApiResource(cfg, fmt.Sprintf("v1.0/{project_id}/clusters/%s/logs/settings",
+		state.Primary.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	if !utils.PathSearch("logConfiguration.logSwitch", respBody, false).(bool) {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return respBody, nil
+}
+
+func TestAccCssLogSetting_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_css_log_setting.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getCssLogSettingResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssLogSetting_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "bucket", "huaweicloud_obs_bucket.cssObs", "bucket"),
+					resource.TestCheckResourceAttr(rName, "base_path", "css/log"),
+					resource.TestCheckResourceAttr(rName, "auto_backup_enabled", "false"),
+				),
+			},
+			{
+				Config: testAccCssLogSetting_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "base_path", "css/log_update"),
+					resource.TestCheckResourceAttr(rName, "period", "00:00 GMT+08:00"),
+					resource.TestCheckResourceAttr(rName, "auto_backup_enabled", "true"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCssLogSetting_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_css_log_setting" "test" {
+  cluster_id = huaweicloud_css_cluster.test.id
+  agency     = "css_obs_agency"
+  bucket     = huaweicloud_obs_bucket.cssObs.bucket
+  base_path  = "css/log"
+}
+`, testAccCssCluster_basic(name, 1, 1, "value"))
+}
+
+func testAccCssLogSetting_update(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_css_log_setting" "test" {
+  cluster_id = huaweicloud_css_cluster.test.id
+  agency     = "css_obs_agency"
+  bucket     = huaweicloud_obs_bucket.cssObs.bucket
+  base_path  = "css/log_update"
+  period     = "00:00 GMT+08:00"
+}
+`, testAccCssCluster_basic(name, 1, 1, "value"))
+}
@@ -0,0 +1,90 @@
+package css
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getCssPluginResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	clusterId := state.Primary.Attributes["cluster_id"]
+	respBody, err := getCssApiResource(cfg, fmt.Sprintf("v1.0/{project_id}/clusters/%s/plugins", clusterId))
+	if err != nil {
+		return nil, err
+	}
+
+	expression := fmt.Sprintf("plugins[?name=='%s']|[0]", state.Primary.Attributes["name"])
+	plugin := utils.PathSearch(expression, respBody, nil)
+	if plugin == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return plugin, nil
+}
+
+func TestAccCssPlugin_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_css_plugin.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getCssPluginResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+			acceptance.TestAccPreCheckCssPluginPackage(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCssPlugin_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "cluster_id", "huaweicloud_css_cluster.test", "id"),
+					resource.TestCheckResourceAttr(rName, "name", "terraform-test-plugin"),
+					resource.TestCheckResourceAttrSet(rName, "status"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"bucket_name", "object", "restart_mode",
+				},
+			},
+		},
+	})
+}
+
+func testAccCssPlugin_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_obs_bucket_object" "plugin" {
+  bucket = huaweicloud_obs_bucket.cssObs.bucket
+  key    = "plugins/terraform-test-plugin.zip"
+  source = "%s"
+}
+
+resource "huaweicloud_css_plugin" "test" {
+  cluster_id  = huaweicloud_css_cluster.test.id
+  name        = "terraform-test-plugin"
+  bucket_name = huaweicloud_obs_bucket.cssObs.bucket
+  object      = huaweicloud_obs_bucket_object.plugin.key
+}
+`, testAccCssCluster_basic(name, 1, 1, "value"), acceptance.HW_CSS_PLUGIN_PACKAGE_PATH)
+}
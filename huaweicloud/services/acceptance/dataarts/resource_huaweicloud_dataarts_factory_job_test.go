@@ -0,0 +1,163 @@
+package dataarts
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getFactoryJobResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	headers := map[string]string{"workspace": state.Primary.Attributes["workspace_id"]}
+	return getDataArtsApiResource(cfg, "dataarts_dlf", "v1/{project_id}/jobs/"+state.Primary.ID, headers)
+}
+
+func TestAccResourceFactoryJob_basic(t *testing.T) {
+	var obj interface{}
+	resourceName := "huaweicloud_dataarts_factory_job.test"
+	name := acceptance.RandomAccResourceName()
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getFactoryJobResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDataArtsWorkspaceID(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFactoryJob_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "process_type", "BATCH"),
+					resource.TestCheckResourceAttr(resourceName, "nodes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "nodes.1.pre_node_names.0", "start"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.type", "EXECUTE_ONCE"),
+					resource.TestCheckResourceAttr(resourceName, "params.0.name", "biz_date"),
+				),
+			},
+			{
+				Config: testAccFactoryJob_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "nodes.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "nodes.2.pre_node_names.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "nodes.2.retry_times", "3"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.type", "CRON"),
+					resource.TestCheckResourceAttr(resourceName, "schedule.0.cron.0.expression", "0 0 2 * * ?"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWorkspaceSubResourceImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccFactoryJob_basic(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_dataarts_factory_job" "test" {
+  workspace_id = "%s"
+  name         = "%s"
+
+  nodes {
+    name = "start"
+    type = "Dummy"
+
+    location {
+      x = 10
+      y = 10
+    }
+  }
+  nodes {
+    name           = "end"
+    type           = "Dummy"
+    pre_node_names = ["start"]
+
+    location {
+      x = 10
+      y = 100
+    }
+  }
+
+  schedule {
+    type = "EXECUTE_ONCE"
+  }
+
+  params {
+    name  = "biz_date"
+    value = "#{DateUtil.format(Job.planTime,\"yyyy-MM-dd\")}"
+  }
+}
+`, acceptance.HW_DATAARTS_WORKSPACE_ID, name)
+}
+
+func testAccFactoryJob_update(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_dataarts_factory_job" "test" {
+  workspace_id = "%s"
+  name         = "%s"
+
+  nodes {
+    name = "start"
+    type = "Dummy"
+
+    location {
+      x = 10
+      y = 10
+    }
+  }
+  nodes {
+    name           = "branch"
+    type           = "Dummy"
+    pre_node_names = ["start"]
+
+    location {
+      x = 100
+      y = 100
+    }
+  }
+  nodes {
+    name           = "end"
+    type           = "Dummy"
+    pre_node_names = ["start", "branch"]
+    retry_times    = 3
+    retry_interval = 120
+    fail_policy    = "FAIL_CHILD"
+
+    location {
+      x = 10
+      y = 200
+    }
+  }
+
+  schedule {
+    type = "CRON"
+
+    cron {
+      start_time = "2024-01-01T00:00:00+08"
+      expression = "0 0 2 * * ?"
+    }
+  }
+
+  params {
+    name  = "biz_date"
+    value = "#{DateUtil.format(Job.planTime,\"yyyy-MM-dd\")}"
+  }
+}
+`, acceptance.HW_DATAARTS_WORKSPACE_ID, name)
+}
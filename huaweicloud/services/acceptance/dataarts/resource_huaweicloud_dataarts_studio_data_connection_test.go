@@ -0,0 +1,82 @@
+package dataarts
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getDataConnectionResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	headers := map[string]string{"workspace": state.Primary.Attributes["workspace_id"]}
+	return getDataArtsApiResource(cfg, "dataarts_dlf", "v1/{project_id}/data-connections/"+state.Primary.ID, headers)
+}
+
+func TestAccResourceDataConnection_basic(t *testing.T) {
+	var obj interface{}
+	resourceName := "huaweicloud_dataarts_studio_data_connection.test"
+	name := acceptance.RandomAccResourceName()
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDataConnectionResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDataArtsWorkspaceID(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataConnection_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "type", "DLI"),
+				),
+			},
+			{
+				Config: testAccDataConnection_basic(name + "_update"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", name+"_update"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccWorkspaceSubResourceImportStateIdFunc(resourceName),
+				ImportStateVerifyIgnore: []string{"config"},
+			},
+		},
+	})
+}
+
+func testAccWorkspaceSubResourceImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["workspace_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccDataConnection_basic(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_dataarts_studio_data_connection" "test" {
+  workspace_id = "%s"
+  name         = "%s"
+  type         = "DLI"
+}
+`, acceptance.HW_DATAARTS_WORKSPACE_ID, name)
+}
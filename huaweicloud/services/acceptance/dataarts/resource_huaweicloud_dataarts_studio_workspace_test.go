@@ -0,0 +1,125 @@
+package dataarts
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// getDataArtsApiResource queries the DataArts Studio API, the path is relative to the endpoint and starts with the
+// API version.
+func getDataArtsApiResource(cfg *config.Config, service, path string, headers map[string]string) (interface{}, error) {
+	client, err := cfg.NewServiceClient(service, acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DataArts Studio client: %s", err)
+	}
+
+	moreHeaders := map[string]string{"Content-Type": "application/json"}
+	for k, v := range headers {
+		if v != "" {
+			moreHeaders[k] = v
+		}
+	}
+	getPath := client.Endpoint + strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		MoreHeaders: moreHeaders,
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+func getWorkspaceResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	headers := map[string]string{"instance": state.Primary.Attributes["instance_id"]}
+	respBody, err := getDataArtsApiResource(cfg, "dataarts", "v1/{project_id}/workspaces/"+state.Primary.ID, headers)
+	if err != nil {
+		return nil, err
+	}
+
+	workspace := utils.PathSearch("data", respBody, nil)
+	if workspace == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return workspace, nil
+}
+
+func TestAccResourceWorkspace_basic(t *testing.T) {
+	var obj interface{}
+	resourceName := "huaweicloud_dataarts_studio_workspace.test"
+	name := acceptance.RandomAccResourceName()
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getWorkspaceResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDataArtsInstanceID(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspace_basic(name, "created by acc test"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", "created by acc test"),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_by"),
+				),
+			},
+			{
+				Config: testAccWorkspace_basic(name+"_update", ""),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", name+"_update"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccWorkspaceImportStateIdFunc(resourceName),
+			},
+		},
+	})
+}
+
+func testAccWorkspaceImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", resourceName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["instance_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccWorkspace_basic(name, description string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_dataarts_studio_workspace" "test" {
+  instance_id = "%s"
+  name        = "%s"
+  description = "%s"
+}
+`, acceptance.HW_DATAARTS_INSTANCE_ID, name, description)
+}
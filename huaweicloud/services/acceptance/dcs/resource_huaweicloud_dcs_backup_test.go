@@ -0,0 +1,106 @@
+package dcs
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// getDcsInstanceRecordFunc returns the function to query the backup or restore record of the DCS instance.
+func getDcsInstanceRecordFunc(recordType string) func(*config.Config, *terraform.ResourceState) (interface{}, error) {
+	return func(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+		client, err := cfg.DcsV2Client(acceptance.HW_REGION_NAME)
+		if err != nil {
+			return nil, fmt.Errorf("error creating DCS client: %s", err)
+		}
+
+		instanceId := state.Primary.Attributes["instance_id"]
+		recordId := strings.TrimPrefix(state.Primary.ID, instanceId+"/")
+		getPath := client.Endpoint + "v2/{project_id}/instances/{instance_id}/" + recordType + "s?limit=100&offset=1"
+		getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+		getPath = strings.ReplaceAll(getPath, "{instance_id}", instanceId)
+		getOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes: []int{
+				200,
+			},
+		}
+		getResp, err := client.Request("GET", getPath, &getOpt)
+		if err != nil {
+			return nil, err
+		}
+		getRespBody, err := utils.FlattenResponse(getResp)
+		if err != nil {
+			return nil, err
+		}
+
+		expression := fmt.Sprintf("%[1]s_record_response[?%[1]s_id=='%[2]s' && status!='deleted']|[0]", recordType,
+			recordId)
+		record := utils.PathSearch(expression, getRespBody, nil)
+		if record == nil {
+			return nil, golangsdk.ErrDefault404{}
+		}
+		return record, nil
+	}
+}
+
+func TestAccDcsBackup_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dcs_backup.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDcsInstanceRecordFunc("backup"),
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDcsBackup_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id", "huaweicloud_dcs_instance.instance_1", "id"),
+					resource.TestCheckResourceAttr(rName, "description", "created by acc test"),
+					resource.TestCheckResourceAttr(rName, "backup_format", "rdb"),
+					resource.TestCheckResourceAttr(rName, "type", "manual"),
+					resource.TestCheckResourceAttr(rName, "status", "succeed"),
+					resource.TestCheckResourceAttrSet(rName, "backup_id"),
+					resource.TestCheckResourceAttrSet(rName, "name"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testDcsBackup_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dcs_backup" "test" {
+  instance_id   = huaweicloud_dcs_instance.instance_1.id
+  description   = "created by acc test"
+  backup_format = "rdb"
+}
+`, testAccDcsV1Instance_basic(name))
+}
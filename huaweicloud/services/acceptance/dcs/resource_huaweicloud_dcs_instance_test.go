@@ -79,6 +79,91 @@ func TestAccDcsInstances_basic(t *testing.T) {
 	})
 }
 
+func TestAccDcsInstances_parametersAndReplicas(t *testing.T) {
+	var instance instances.DcsInstance
+	var instanceName = acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_dcs_instance.instance_1"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&instance,
+		getDcsResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDcsInstance_parameters(instanceName, "r2", "volatile-lru", "100"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "flavor", "redis.ha.xu1.large.r2.1"),
+					resource.TestCheckResourceAttr(resourceName, "replica_count", "2"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.maxmemory-policy", "volatile-lru"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.timeout", "100"),
+					resource.TestCheckResourceAttr(resourceName, "restart_required", "false"),
+				),
+			},
+			{
+				Config: testAccDcsInstance_parameters(instanceName, "r3", "allkeys-lru", "200"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "flavor", "redis.ha.xu1.large.r3.1"),
+					resource.TestCheckResourceAttr(resourceName, "replica_count", "3"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.maxmemory-policy", "allkeys-lru"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.timeout", "200"),
+				),
+			},
+			{
+				Config: testAccDcsInstance_parameters(instanceName, "r2", "allkeys-lru", "200"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "flavor", "redis.ha.xu1.large.r2.1"),
+					resource.TestCheckResourceAttr(resourceName, "replica_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDcsInstances_shards(t *testing.T) {
+	var instance instances.DcsInstance
+	var instanceName = acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_dcs_instance.instance_1"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&instance,
+		getDcsResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDcsInstance_cluster(instanceName, 24),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "capacity", "24"),
+					resource.TestCheckResourceAttr(resourceName, "sharding_count", "3"),
+				),
+			},
+			{
+				Config: testAccDcsInstance_cluster(instanceName, 48),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "capacity", "48"),
+					resource.TestCheckResourceAttr(resourceName, "sharding_count", "6"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDcsInstances_withEpsId(t *testing.T) {
 	var instance instances.DcsInstance
 	var instanceName = fmt.Sprintf("dcs_instance_%s", acctest.RandString(5))
@@ -327,6 +412,61 @@ resource "huaweicloud_dcs_instance" "instance_1" {
 }`, instanceName)
 }
 
+func testAccDcsInstance_parameters(instanceName, replica, policy, timeout string) string {
+	return fmt.Sprintf(`
+data "huaweicloud_availability_zones" "test" {}
+
+data "huaweicloud_vpc" "test" {
+  name = "vpc-default"
+}
+
+data "huaweicloud_vpc_subnet" "test" {
+  name = "subnet-default"
+}
+
+resource "huaweicloud_dcs_instance" "instance_1" {
+  name               = "%[1]s"
+  engine_version     = "5.0"
+  password           = "Huawei_test"
+  engine             = "Redis"
+  capacity           = 1
+  vpc_id             = data.huaweicloud_vpc.test.id
+  subnet_id          = data.huaweicloud_vpc_subnet.test.id
+  availability_zones = [data.huaweicloud_availability_zones.test.names[0]]
+  flavor             = "redis.ha.xu1.large.%[2]s.1"
+
+  parameters = {
+    maxmemory-policy = "%[3]s"
+    timeout          = "%[4]s"
+  }
+}`, instanceName, replica, policy, timeout)
+}
+
+func testAccDcsInstance_cluster(instanceName string, capacity int) string {
+	return fmt.Sprintf(`
+data "huaweicloud_availability_zones" "test" {}
+
+data "huaweicloud_vpc" "test" {
+  name = "vpc-default"
+}
+
+data "huaweicloud_vpc_subnet" "test" {
+  name = "subnet-default"
+}
+
+resource "huaweicloud_dcs_instance" "instance_1" {
+  name               = "%[1]s"
+  engine_version     = "5.0"
+  password           = "Huawei_test"
+  engine             = "Redis"
+  capacity           = %[2]d
+  vpc_id             = data.huaweicloud_vpc.test.id
+  subnet_id          = data.huaweicloud_vpc_subnet.test.id
+  availability_zones = [data.huaweicloud_availability_zones.test.names[0]]
+  flavor             = "redis.cluster.xu1.large.r2.%[2]d"
+}`, instanceName, capacity)
+}
+
 func testAccDcsV1Instance_epsId(instanceName string) string {
 	return fmt.Sprintf(`
 data "huaweicloud_availability_zones" "test" {}
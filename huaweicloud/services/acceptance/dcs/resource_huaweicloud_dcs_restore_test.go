@@ -0,0 +1,46 @@
+package dcs
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDcsRestore_basic(t *testing.T) {
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dcs_restore.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDcsRestore_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(rName, "instance_id", "huaweicloud_dcs_instance.instance_1", "id"),
+					resource.TestCheckResourceAttrPair(rName, "backup_id", "huaweicloud_dcs_backup.test", "backup_id"),
+					resource.TestCheckResourceAttr(rName, "description", "restored by acc test"),
+					resource.TestCheckResourceAttr(rName, "status", "succeed"),
+					resource.TestCheckResourceAttrSet(rName, "restore_id"),
+				),
+			},
+		},
+	})
+}
+
+func testDcsRestore_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dcs_restore" "test" {
+  instance_id = huaweicloud_dcs_instance.instance_1.id
+  backup_id   = huaweicloud_dcs_backup.test.backup_id
+  description = "restored by acc test"
+}
+`, testDcsBackup_basic(name))
+}
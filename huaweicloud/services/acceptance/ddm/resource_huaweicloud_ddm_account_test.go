@@ -0,0 +1,95 @@
+package ddm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getDdmAccountResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getDdmResource(cfg,
+		fmt.Sprintf("v1/{project_id}/instances/%s/users?limit=128", state.Primary.Attributes["instance_id"]),
+		fmt.Sprintf("users[?name=='%s']|[0]", state.Primary.Attributes["name"]))
+}
+
+func TestAccDdmAccount_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceNameWithDash()
+	rName := "huaweicloud_ddm_account.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDdmAccountResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDDM(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDdmAccount_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", "test_account"),
+					resource.TestCheckResourceAttr(rName, "permissions.#", "2"),
+					resource.TestCheckResourceAttr(rName, "description", "created by acc test"),
+					resource.TestCheckResourceAttr(rName, "schemas.#", "1"),
+				),
+			},
+			{
+				Config: testDdmAccount_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "permissions.#", "4"),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+					resource.TestCheckResourceAttr(rName, "schemas.#", "0"),
+				),
+			},
+			{
+				ResourceName:            rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testDdmAccount_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_ddm_account" "test" {
+  instance_id = huaweicloud_ddm_instance.test.id
+  name        = "test_account"
+  password    = "Test@12345678"
+  permissions = ["SELECT", "INSERT"]
+  description = "created by acc test"
+  schemas     = [huaweicloud_ddm_schema.test.name]
+}
+`, testDdmSchema_basic(name))
+}
+
+func testDdmAccount_update(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_ddm_account" "test" {
+  instance_id = huaweicloud_ddm_instance.test.id
+  name        = "test_account"
+  password    = "Test@87654321"
+  permissions = ["SELECT", "INSERT", "UPDATE", "DELETE"]
+}
+`, testDdmSchema_basic(name))
+}
@@ -0,0 +1,158 @@
+package ddm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// getDdmResource sends a GET request of the DDM and returns the object matched the JMESPath expression.
+func getDdmResource(cfg *config.Config, path, expression string) (interface{}, error) {
+	client, err := cfg.NewServiceClient("ddm", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DDM client: %s", err)
+	}
+
+	getPath := client.Endpoint + path
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return nil, err
+	}
+
+	result := utils.PathSearch(expression, getRespBody, nil)
+	if result == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return result, nil
+}
+
+func getDdmInstanceResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getDdmResource(cfg, "v1/{project_id}/instances/"+state.Primary.ID, "@")
+}
+
+func TestAccDdmInstance_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceNameWithDash()
+	updateName := acceptance.RandomAccResourceNameWithDash()
+	rName := "huaweicloud_ddm_instance.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDdmInstanceResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDDM(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDdmInstance_basic(name, name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "node_num", "2"),
+					resource.TestCheckResourceAttr(rName, "nodes.#", "2"),
+					resource.TestCheckResourceAttr(rName, "status", "RUNNING"),
+					resource.TestCheckResourceAttrPair(rName, "vpc_id", "huaweicloud_vpc.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "security_group_id",
+						"huaweicloud_networking_secgroup.test", "id"),
+					resource.TestCheckResourceAttrSet(rName, "access_ip"),
+				),
+			},
+			{
+				Config: testDdmInstance_basic(name, updateName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", updateName),
+					resource.TestCheckResourceAttr(rName, "node_num", "3"),
+					resource.TestCheckResourceAttr(rName, "nodes.#", "3"),
+					resource.TestCheckResourceAttrPair(rName, "security_group_id",
+						"huaweicloud_networking_secgroup.update", "id"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{"flavor_id", "engine_id", "availability_zones", "param_group_id",
+					"time_zone", "admin_user", "admin_password", "delete_rds_data"},
+			},
+		},
+	})
+}
+
+func testDdmInstance_base(name string) string {
+	return fmt.Sprintf(`
+data "huaweicloud_availability_zones" "test" {}
+
+resource "huaweicloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "huaweicloud_vpc_subnet" "test" {
+  name       = "%[1]s"
+  cidr       = "192.168.0.0/24"
+  gateway_ip = "192.168.0.1"
+  vpc_id     = huaweicloud_vpc.test.id
+}
+
+resource "huaweicloud_networking_secgroup" "test" {
+  name = "%[1]s"
+}
+
+resource "huaweicloud_networking_secgroup" "update" {
+  name = "%[1]s-update"
+}
+`, name)
+}
+
+func testDdmInstance_basic(name, instanceName string, nodeNum int) string {
+	secGroup := "test"
+	if nodeNum > 2 {
+		secGroup = "update"
+	}
+
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_ddm_instance" "test" {
+  name               = "%[2]s"
+  flavor_id          = "%[3]s"
+  node_num           = %[4]d
+  engine_id          = "%[5]s"
+  vpc_id             = huaweicloud_vpc.test.id
+  subnet_id          = huaweicloud_vpc_subnet.test.id
+  security_group_id  = huaweicloud_networking_secgroup.%[6]s.id
+  availability_zones = [data.huaweicloud_availability_zones.test.names[0]]
+  admin_user         = "root"
+  admin_password     = "Test@12345678"
+}
+`, testDdmInstance_base(name), instanceName, acceptance.HW_DDM_FLAVOR_ID, nodeNum, acceptance.HW_DDM_ENGINE_ID,
+		secGroup)
+}
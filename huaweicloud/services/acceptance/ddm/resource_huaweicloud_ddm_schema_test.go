@@ -0,0 +1,105 @@
+package ddm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getDdmSchemaResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getDdmResource(cfg, fmt.Sprintf("v1/{project_id}/instances/%s/databases/%s",
+		state.Primary.Attributes["instance_id"], state.Primary.Attributes["name"]), "database")
+}
+
+func TestAccDdmSchema_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceNameWithDash()
+	rName := "huaweicloud_ddm_schema.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDdmSchemaResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDDM(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDdmSchema_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id", "huaweicloud_ddm_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "name", "test_schema"),
+					resource.TestCheckResourceAttr(rName, "shard_mode", "cluster"),
+					resource.TestCheckResourceAttr(rName, "shard_number", "8"),
+					resource.TestCheckResourceAttr(rName, "shards.#", "8"),
+					resource.TestCheckResourceAttrPair(rName, "shards.0.rds_id", "huaweicloud_rds_instance.test", "id"),
+				),
+			},
+			{
+				ResourceName:            rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"data_nodes", "delete_rds_data"},
+			},
+		},
+	})
+}
+
+func testDdmSchema_base(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_rds_instance" "test" {
+  name              = "%[2]s"
+  flavor            = "rds.mysql.n1.large.2"
+  security_group_id = huaweicloud_networking_secgroup.test.id
+  subnet_id         = huaweicloud_vpc_subnet.test.id
+  vpc_id            = huaweicloud_vpc.test.id
+  availability_zone = [data.huaweicloud_availability_zones.test.names[0]]
+
+  db {
+    password = "Test@12345678"
+    type     = "MySQL"
+    version  = "5.7"
+  }
+
+  volume {
+    type = "CLOUDSSD"
+    size = 40
+  }
+}
+`, testDdmInstance_basic(name, name, 2), name)
+}
+
+func testDdmSchema_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_ddm_schema" "test" {
+  instance_id     = huaweicloud_ddm_instance.test.id
+  name            = "test_schema"
+  shard_mode      = "cluster"
+  shard_number    = 8
+  delete_rds_data = true
+
+  data_nodes {
+    id             = huaweicloud_rds_instance.test.id
+    admin_user     = "root"
+    admin_password = "Test@12345678"
+  }
+}
+`, testDdmSchema_base(name))
+}
@@ -195,6 +195,63 @@ func TestAccDDSV3Instance_prePaid(t *testing.T) {
 	})
 }
 
+func TestAccDDSV3Instance_replicaSet(t *testing.T) {
+	var instance instances.InstanceResponse
+	rName := acceptance.RandomAccResourceName()
+	resourceName := "huaweicloud_dds_instance.instance"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&instance,
+		getDdsResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDDSInstanceV3Config_replicaSet(rName, 0, "huaweicloud_dds_parameter_template.test.id"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "mode", "ReplicaSet"),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.id",
+						"huaweicloud_dds_parameter_template.test", "id"),
+				),
+			},
+			{
+				Config: testAccDDSInstanceV3Config_replicaSet(rName, 1, "huaweicloud_dds_parameter_template.update.id"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "configuration.0.id",
+						"huaweicloud_dds_parameter_template.update", "id"),
+					resource.TestCheckResourceAttr(resourceName, "flavor.#", "2"),
+					testAccCheckDDSV3InstanceReadonlyNodes(&instance, 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDDSV3InstanceReadonlyNodes(instance *instances.InstanceResponse, num int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		count := 0
+		for _, group := range instance.Groups {
+			for _, node := range group.Nodes {
+				if node.Role == "ReadOnly" {
+					count++
+				}
+			}
+		}
+		if count != num {
+			return fmt.Errorf("the number of read-only nodes expect %d, but got %d", num, count)
+		}
+		return nil
+	}
+}
+
 func testAccCheckDDSV3InstanceFlavor(instance *instances.InstanceResponse, groupType, key string, v interface{}) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		if key == "num" {
@@ -682,3 +739,68 @@ resource "huaweicloud_dds_instance" "instance" {
   }
 }`, testAccDDSInstanceV3Config_Base(rName), rName)
 }
+
+// testAccDDSInstanceV3Config_replicaSet appends the readonly flavor only when readonlyNum is greater than 0.
+func testAccDDSInstanceV3Config_replicaSet(rName string, readonlyNum int, templateID string) string {
+	var readonlyFlavor string
+	if readonlyNum > 0 {
+		readonlyFlavor = fmt.Sprintf(`
+  flavor {
+    type      = "readonly"
+    num       = %d
+    spec_code = "dds.mongodb.c6.large.4.readonly"
+  }`, readonlyNum)
+	}
+
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_dds_parameter_template" "test" {
+  name         = "%[2]s"
+  node_type    = "replica"
+  node_version = "4.0"
+
+  parameter_values = {
+    connPoolMaxConnsPerHost = 500
+  }
+}
+
+resource "huaweicloud_dds_parameter_template" "update" {
+  name         = "%[2]s-update"
+  node_type    = "replica"
+  node_version = "4.0"
+
+  parameter_values = {
+    connPoolMaxConnsPerHost = 800
+  }
+}
+
+resource "huaweicloud_dds_instance" "instance" {
+  name              = "%[2]s"
+  availability_zone = data.huaweicloud_availability_zones.test.names[0]
+  vpc_id            = data.huaweicloud_vpc.test.id
+  subnet_id         = data.huaweicloud_vpc_subnet.test.id
+  security_group_id = huaweicloud_networking_secgroup.secgroup_acc.id
+  password          = "Terraform@123"
+  mode              = "ReplicaSet"
+
+  datastore {
+    type           = "DDS-Community"
+    version        = "4.0"
+    storage_engine = "wiredTiger"
+  }
+
+  flavor {
+    type      = "replica"
+    num       = 1
+    storage   = "ULTRAHIGH"
+    size      = 30
+    spec_code = "dds.mongodb.c6.large.4.repset"
+  }%[3]s
+
+  configuration {
+    type = "replica"
+    id   = %[4]s
+  }
+}`, testAccDDSInstanceV3Config_Base(rName), rName, readonlyFlavor, templateID)
+}
@@ -0,0 +1,103 @@
+package dds
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getDdsParameterTemplateResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("dds", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DDS Client: %s", err)
+	}
+
+	getParameterTemplatePath := client.Endpoint + "v3/{project_id}/configurations/{config_id}"
+	getParameterTemplatePath = strings.ReplaceAll(getParameterTemplatePath, "{project_id}", client.ProjectID)
+	getParameterTemplatePath = strings.ReplaceAll(getParameterTemplatePath, "{config_id}", state.Primary.ID)
+	getParameterTemplateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getParameterTemplateResp, err := client.Request("GET", getParameterTemplatePath, &getParameterTemplateOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving DDS parameter template: %s", err)
+	}
+	return utils.FlattenResponse(getParameterTemplateResp)
+}
+
+func TestAccDdsParameterTemplate_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dds_parameter_template.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDdsParameterTemplateResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDdsParameterTemplate_basic(name, "test description", 500),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "description", "test description"),
+					resource.TestCheckResourceAttr(rName, "node_type", "mongos"),
+					resource.TestCheckResourceAttr(rName, "node_version", "4.0"),
+					resource.TestCheckResourceAttr(rName, "parameter_values.connPoolMaxConnsPerHost", "500"),
+					resource.TestCheckResourceAttrSet(rName, "parameters.#"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+				),
+			},
+			{
+				Config: testDdsParameterTemplate_basic(name+"-update", "", 800),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name+"-update"),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+					resource.TestCheckResourceAttr(rName, "parameter_values.connPoolMaxConnsPerHost", "800"),
+				),
+			},
+			{
+				ResourceName:            rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"node_type", "parameter_values"},
+			},
+		},
+	})
+}
+
+func testDdsParameterTemplate_basic(name, description string, maxConns int) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_dds_parameter_template" "test" {
+  name         = "%s"
+  description  = "%s"
+  node_type    = "mongos"
+  node_version = "4.0"
+
+  parameter_values = {
+    connPoolMaxConnsPerHost = %d
+  }
+}
+`, name, description, maxConns)
+}
@@ -0,0 +1,148 @@
+package dli
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getDliDatasourceConnectionResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	respBody, err := getDliApiResource(cfg, "v2.0/{project_id}/datasource/enhanced-connections/"+state.Primary.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if utils.PathSearch("status", respBody, "").(string) == "DELETED" {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return respBody, nil
+}
+
+func TestAccDliDatasourceConnection_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dli_datasource_connection.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDliDatasourceConnectionResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDliDatasourceConnection_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttrPair(rName, "vpc_id", "huaweicloud_vpc.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "subnet_id", "huaweicloud_vpc_subnet.test", "id"),
+					resource.TestCheckResourceAttr(rName, "queues.#", "0"),
+					resource.TestCheckResourceAttr(rName, "hosts.#", "1"),
+					resource.TestCheckResourceAttr(rName, "hosts.0.name", "rds-host"),
+					resource.TestCheckResourceAttr(rName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(rName, "tags.foo", "bar"),
+				),
+			},
+			{
+				Config: testDliDatasourceConnection_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "queues.#", "1"),
+					resource.TestCheckResourceAttr(rName, "hosts.#", "2"),
+					resource.TestCheckResourceAttr(rName, "hosts.1.name", "kafka-host"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"route_table_id",
+				},
+			},
+		},
+	})
+}
+
+func testDliDatasourceConnection_base(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "huaweicloud_vpc_subnet" "test" {
+  name       = "%[1]s"
+  cidr       = "192.168.0.0/24"
+  vpc_id     = huaweicloud_vpc.test.id
+  gateway_ip = "192.168.0.1"
+}
+
+resource "huaweicloud_dli_queue" "test" {
+  name       = replace("%[1]s", "-", "_")
+  queue_type = "sql"
+  cu_count   = 16
+  vpc_cidr   = "172.16.0.0/18"
+}
+`, name)
+}
+
+func testDliDatasourceConnection_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dli_datasource_connection" "test" {
+  name      = "%s"
+  vpc_id    = huaweicloud_vpc.test.id
+  subnet_id = huaweicloud_vpc_subnet.test.id
+
+  hosts {
+    name = "rds-host"
+    ip   = "192.168.0.10"
+  }
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, testDliDatasourceConnection_base(name), name)
+}
+
+func testDliDatasourceConnection_update(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dli_datasource_connection" "test" {
+  name      = "%s"
+  vpc_id    = huaweicloud_vpc.test.id
+  subnet_id = huaweicloud_vpc_subnet.test.id
+  queues    = [huaweicloud_dli_queue.test.name]
+
+  hosts {
+    name = "rds-host"
+    ip   = "192.168.0.10"
+  }
+  hosts {
+    name = "kafka-host"
+    ip   = "192.168.0.20"
+  }
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, testDliDatasourceConnection_base(name), name)
+}
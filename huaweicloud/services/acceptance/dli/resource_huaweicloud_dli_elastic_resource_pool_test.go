@@ -0,0 +1,207 @@
+package dli
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// getDliApiResource queries the DLI API, the path is relative to the endpoint and starts with the API version.
+func getDliApiResource(cfg *config.Config, path string) (interface{}, error) {
+	client, err := cfg.NewServiceClient("dli", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DLI client: %s", err)
+	}
+
+	getPath := client.Endpoint + strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+func getDliElasticResourcePoolResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	respBody, err := getDliApiResource(cfg, "v3/{project_id}/elastic-resource-pools?name="+state.Primary.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	expression := fmt.Sprintf("elastic_resource_pools[?elastic_resource_pool_name=='%s' && status!='DELETED']|[0]",
+		state.Primary.ID)
+	pool := utils.PathSearch(expression, respBody, nil)
+	if pool == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return pool, nil
+}
+
+func TestAccDliElasticResourcePool_basic(t *testing.T) {
+	var obj interface{}
+
+	name := strings.ReplaceAll(acceptance.RandomAccResourceName(), "-", "_")
+	rName := "huaweicloud_dli_elastic_resource_pool.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDliElasticResourcePoolResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDliElasticResourcePool_basic(name, 64, "created by terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "min_cu", "64"),
+					resource.TestCheckResourceAttr(rName, "max_cu", "64"),
+					resource.TestCheckResourceAttr(rName, "description", "created by terraform"),
+					resource.TestCheckResourceAttr(rName, "status", "AVAILABLE"),
+					resource.TestCheckResourceAttrSet(rName, "cidr"),
+					resource.TestCheckResourceAttr(rName, "tags.foo", "bar"),
+				),
+			},
+			{
+				Config: testDliElasticResourcePool_basic(name, 128, ""),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "max_cu", "128"),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testDliElasticResourcePool_basic(name string, maxCu int, description string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_dli_elastic_resource_pool" "test" {
+  name        = "%s"
+  min_cu      = 64
+  max_cu      = %d
+  description = "%s"
+
+  tags = {
+    foo = "bar"
+  }
+}
+`, name, maxCu, description)
+}
+
+func getDliElasticResourcePoolQueueResourceFunc(cfg *config.Config,
+	state *terraform.ResourceState) (interface{}, error) {
+	poolName := state.Primary.Attributes["elastic_resource_pool_name"]
+	queueName := state.Primary.Attributes["queue_name"]
+	getPath := fmt.Sprintf("v3/{project_id}/elastic-resource-pools/%s/queues?queue_name=%s", poolName, queueName)
+	respBody, err := getDliApiResource(cfg, getPath)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := utils.PathSearch(fmt.Sprintf("queues[?queue_name=='%s']|[0]", queueName), respBody, nil)
+	if queue == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return queue, nil
+}
+
+func TestAccDliElasticResourcePoolQueue_basic(t *testing.T) {
+	var obj interface{}
+
+	name := strings.ReplaceAll(acceptance.RandomAccResourceName(), "-", "_")
+	rName := "huaweicloud_dli_elastic_resource_pool_queue.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDliElasticResourcePoolQueueResourceFunc,
+	)
+
+	// The queue can not be removed from the pool, so the destruction is not checked.
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDliElasticResourcePoolQueue_basic(name, 16),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "elastic_resource_pool_name",
+						"huaweicloud_dli_elastic_resource_pool.test", "name"),
+					resource.TestCheckResourceAttrPair(rName, "queue_name", "huaweicloud_dli_queue.test", "name"),
+					resource.TestCheckResourceAttr(rName, "scaling_policies.#", "2"),
+					resource.TestCheckResourceAttr(rName, "scaling_policies.1.impact_start_time", "08:00"),
+					resource.TestCheckResourceAttr(rName, "scaling_policies.1.max_cu", "16"),
+				),
+			},
+			{
+				Config: testDliElasticResourcePoolQueue_basic(name, 32),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "scaling_policies.1.max_cu", "32"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testDliElasticResourcePoolQueue_basic(name string, maxCu int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dli_queue" "test" {
+  name       = "%s"
+  queue_type = "sql"
+  cu_count   = 16
+}
+
+resource "huaweicloud_dli_elastic_resource_pool_queue" "test" {
+  elastic_resource_pool_name = huaweicloud_dli_elastic_resource_pool.test.name
+  queue_name                 = huaweicloud_dli_queue.test.name
+
+  scaling_policies {
+    priority          = 1
+    impact_start_time = "00:00"
+    impact_stop_time  = "08:00"
+    min_cu            = 16
+    max_cu            = 16
+  }
+  scaling_policies {
+    priority          = 10
+    impact_start_time = "08:00"
+    impact_stop_time  = "00:00"
+    min_cu            = 16
+    max_cu            = %d
+  }
+}
+`, testDliElasticResourcePool_basic(name, 64, ""), name, maxCu)
+}
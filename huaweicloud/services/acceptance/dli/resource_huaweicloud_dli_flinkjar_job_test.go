@@ -235,3 +235,88 @@ resource "huaweicloud_dli_flinkjar_job" "test" {
 }
 `, ak, sk, jarObsPath, bucketName, name, name, name, region, name)
 }
+
+func TestAccResourceDliFlinkJarJob_savepoint(t *testing.T) {
+	var obj flinkjob.CreateJarJobOpts
+	resourceName := "huaweicloud_dli_flinkjar_job.test"
+	name := acceptance.RandomAccResourceName()
+	bucketName := acceptance.RandomAccResourceNameWithDash()
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDliFlinkJarJobResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDliJarPath(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlinkJarJobResource_savepoint(name, bucketName, acceptance.HW_DLI_FLINK_JAR_OBS_PATH, 1),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "status", "job_running"),
+					resource.TestCheckResourceAttr(resourceName, "savepoint_on_update", "true"),
+					resource.TestCheckResourceAttr(resourceName, "destroy_action", "stop_with_savepoint"),
+					resource.TestCheckResourceAttr(resourceName, "checkpoint_directory",
+						fmt.Sprintf("obs://%s/jobs/checkpoint/", bucketName)),
+				),
+			},
+			{
+				Config: testAccFlinkJarJobResource_savepoint(name, bucketName, acceptance.HW_DLI_FLINK_JAR_OBS_PATH, 2),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "status", "job_running"),
+					resource.TestCheckResourceAttr(resourceName, "parallel_num", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"savepoint_on_update", "destroy_action", "destroy_savepoint_path",
+				},
+			},
+		},
+	})
+}
+
+func testAccFlinkJarJobResource_savepoint(name, bucketName, jarObsPath string, parallelNum int) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_obs_bucket" "test" {
+  bucket        = "%[2]s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "huaweicloud_dli_package" "test" {
+  group_name  = "jarPackage"
+  type        = "jar"
+  object_path = "%[3]s"
+}
+
+resource "huaweicloud_dli_queue" "test" {
+  name       = "%[1]s"
+  cu_count   = 16
+  queue_type = "general"
+}
+
+resource "huaweicloud_dli_flinkjar_job" "test" {
+  name                   = "%[1]s"
+  queue_name             = huaweicloud_dli_queue.test.name
+  entrypoint             = "${huaweicloud_dli_package.test.group_name}/${huaweicloud_dli_package.test.object_name}"
+  obs_bucket             = huaweicloud_obs_bucket.test.bucket
+  checkpoint_path        = "obs://${huaweicloud_obs_bucket.test.bucket}/jobs/checkpoint/"
+  parallel_num           = %[4]d
+  savepoint_on_update    = true
+  destroy_action         = "stop_with_savepoint"
+  destroy_savepoint_path = "obs://${huaweicloud_obs_bucket.test.bucket}/savepoints/%[1]s"
+}
+`, name, bucketName, jarObsPath, parallelNum)
+}
@@ -58,7 +58,7 @@ func TestAccResourceDliFlinkJob_basic(t *testing.T) {
 	})
 }
 
-func testAccFlinkJobResource_basic(name string, region string) string {
+func testAccFlinkJobResource_base(name string, region string) string {
 	return fmt.Sprintf(`
 variable "sql" {
   type    = string
@@ -137,6 +137,12 @@ resource "huaweicloud_dis_stream" "stream_output" {
   csv_delimiter   = ","
 
 }
+`, region, name, region, name, region, name, name, name)
+}
+
+func testAccFlinkJobResource_basic(name string, region string) string {
+	return fmt.Sprintf(`
+%s
 
 resource "huaweicloud_dli_flinksql_job" "test" {
   name = "%s"
@@ -147,5 +153,72 @@ resource "huaweicloud_dli_flinksql_job" "test" {
     huaweicloud_dis_stream.stream_output,
   ]
 }
-`, region, name, region, name, region, name, name, name, name)
+`, testAccFlinkJobResource_base(name, region), name)
+}
+
+func TestAccResourceDliFlinkJob_savepoint(t *testing.T) {
+	var obj flinkjob.CreateSqlJobOpts
+	resourceName := "huaweicloud_dli_flinksql_job.test"
+	name := acceptance.RandomAccResourceName()
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&obj,
+		getDliFlinkSqlJobResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFlinkJobResource_savepoint(name, acceptance.HW_REGION_NAME, 2),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "status", "job_running"),
+					resource.TestCheckResourceAttr(resourceName, "savepoint_on_update", "true"),
+					resource.TestCheckResourceAttr(resourceName, "destroy_action", "stop_with_savepoint"),
+					resource.TestCheckResourceAttrSet(resourceName, "checkpoint_directory"),
+				),
+			},
+			{
+				Config: testAccFlinkJobResource_savepoint(name, acceptance.HW_REGION_NAME, 4),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "status", "job_running"),
+					resource.TestCheckResourceAttr(resourceName, "parallel_number", "4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFlinkJobResource_savepoint(name string, region string, parallelNumber int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_obs_bucket" "test" {
+  bucket        = "%[2]s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "huaweicloud_dli_flinksql_job" "test" {
+  name                   = "%[2]s"
+  type                   = "flink_sql_job"
+  sql                    = var.sql
+  parallel_number        = %[3]d
+  checkpoint_enabled     = true
+  obs_bucket             = huaweicloud_obs_bucket.test.bucket
+  savepoint_on_update    = true
+  destroy_action         = "stop_with_savepoint"
+  destroy_savepoint_path = "obs://${huaweicloud_obs_bucket.test.bucket}/savepoints/%[2]s"
+
+  depends_on = [
+    huaweicloud_dis_stream.stream_input,
+    huaweicloud_dis_stream.stream_output,
+  ]
+}
+`, testAccFlinkJobResource_base(name, region), name, parallelNumber)
 }
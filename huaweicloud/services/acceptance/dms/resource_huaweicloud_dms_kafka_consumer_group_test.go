@@ -0,0 +1,72 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getKafkaConsumerGroupResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getDmsInstanceSubResource(cfg, state, "groups/"+state.Primary.Attributes["name"])
+}
+
+func TestAccDmsKafkaConsumerGroup_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceNameWithDash()
+	rName := "huaweicloud_dms_kafka_consumer_group.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getKafkaConsumerGroupResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaConsumerGroup_basic(name, "created by acc test"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id",
+						"huaweicloud_dms_kafka_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "description", "created by acc test"),
+					resource.TestCheckResourceAttr(rName, "state", "EMPTY"),
+				),
+			},
+			{
+				Config: testAccDmsKafkaConsumerGroup_basic(name, ""),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDmsKafkaConsumerGroup_basic(name, description string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_kafka_consumer_group" "test" {
+  instance_id = huaweicloud_dms_kafka_instance.test.id
+  name        = "%s"
+  description = "%s"
+}
+`, testAccKafkaInstance_basic(name), name, description)
+}
@@ -0,0 +1,52 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDmsKafkaMessageOffsetReset_basic(t *testing.T) {
+	name := acceptance.RandomAccResourceNameWithDash()
+	rName := "huaweicloud_dms_kafka_message_offset_reset.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaMessageOffsetReset_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(rName, "group",
+						"huaweicloud_dms_kafka_consumer_group.test", "name"),
+					resource.TestCheckResourceAttrPair(rName, "topic",
+						"huaweicloud_dms_kafka_topic.test", "name"),
+					resource.TestCheckResourceAttr(rName, "partition", "-1"),
+					resource.TestCheckResourceAttr(rName, "message_offset", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDmsKafkaMessageOffsetReset_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_kafka_topic" "test" {
+  instance_id = huaweicloud_dms_kafka_instance.test.id
+  name        = "%s"
+  partitions  = 3
+}
+
+resource "huaweicloud_dms_kafka_message_offset_reset" "test" {
+  instance_id    = huaweicloud_dms_kafka_instance.test.id
+  group          = huaweicloud_dms_kafka_consumer_group.test.name
+  topic          = huaweicloud_dms_kafka_topic.test.name
+  message_offset = 0
+}
+`, testAccDmsKafkaConsumerGroup_basic(name, "created by acc test"), name)
+}
@@ -0,0 +1,87 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDmsKafkaPartitionReassign_basic(t *testing.T) {
+	name := acceptance.RandomAccResourceNameWithDash()
+	rName := "huaweicloud_dms_kafka_partition_reassign.test"
+	instanceName := "huaweicloud_dms_kafka_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaPartitionReassign_basic(name, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(rName, "instance_id", instanceName, "id"),
+					resource.TestCheckResourceAttr(rName, "status", "SUCCESS"),
+					resource.TestCheckResourceAttrSet(rName, "task_id"),
+				),
+			},
+			{
+				// Adding a broker triggers the reassignment again.
+				Config: testAccDmsKafkaPartitionReassign_basic(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(rName, "reassignments.0.brokers.#", instanceName, "broker_num"),
+					resource.TestCheckResourceAttr(rName, "status", "SUCCESS"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDmsKafkaPartitionReassign_basic(name string, addedBrokers int) string {
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_dms_kafka_flavors" "test" {
+  type = "cluster"
+}
+
+locals {
+  versions   = data.huaweicloud_dms_kafka_flavors.test.versions
+  flavor     = data.huaweicloud_dms_kafka_flavors.test.flavors[0]
+  broker_num = local.flavor.properties[0].min_broker + %d
+}
+
+resource "huaweicloud_dms_kafka_instance" "test" {
+  name              = "%s"
+  vpc_id            = huaweicloud_vpc.test.id
+  network_id        = huaweicloud_vpc_subnet.test.id
+  security_group_id = huaweicloud_networking_secgroup.test.id
+
+  flavor_id          = local.flavor.id
+  storage_spec_code  = local.flavor.ios[0].storage_spec_code
+  availability_zones = local.flavor.ios[0].availability_zones
+  engine_version     = element(local.versions, length(local.versions)-1)
+  storage_space      = local.broker_num * local.flavor.properties[0].min_storage_per_node
+  broker_num         = local.broker_num
+}
+
+resource "huaweicloud_dms_kafka_topic" "test" {
+  instance_id = huaweicloud_dms_kafka_instance.test.id
+  name        = "%s"
+  partitions  = 6
+  replicas    = 3
+}
+
+resource "huaweicloud_dms_kafka_partition_reassign" "test" {
+  instance_id = huaweicloud_dms_kafka_instance.test.id
+  throttle    = 10000
+
+  reassignments {
+    topic              = huaweicloud_dms_kafka_topic.test.name
+    brokers            = range(huaweicloud_dms_kafka_instance.test.broker_num)
+    replication_factor = 3
+  }
+}
+`, testAccKafkaInstance_base(name), addedBrokers, name, name)
+}
@@ -0,0 +1,107 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getKafkaSmartConnectTaskResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getDmsInstanceSubResource(cfg, state, "connector/tasks/"+state.Primary.ID)
+}
+
+func TestAccDmsKafkaSmartConnectTask_obsSink(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceNameWithDash()
+	rName := "huaweicloud_dms_kafka_smart_connect_task.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getKafkaSmartConnectTaskResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckOBS(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaSmartConnectTask_obsSink(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "task_name", name),
+					resource.TestCheckResourceAttr(rName, "topics.0", name),
+					resource.TestCheckResourceAttr(rName, "sink_type", "OBS_SINK"),
+					resource.TestCheckResourceAttr(rName, "status", "RUNNING"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccKafkaSmartConnectTaskImportStateFunc(rName),
+				ImportStateVerifyIgnore: []string{
+					"start_later", "sink_task",
+				},
+			},
+		},
+	})
+}
+
+func testAccKafkaSmartConnectTaskImportStateFunc(rName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[rName]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found", rName)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["instance_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccDmsKafkaSmartConnectTask_obsSink(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_obs_bucket" "test" {
+  bucket        = "%s"
+  acl           = "private"
+  force_destroy = true
+}
+
+resource "huaweicloud_dms_kafka_topic" "test" {
+  instance_id = huaweicloud_dms_kafka_instance.test.id
+  name        = "%s"
+  partitions  = 3
+}
+
+resource "huaweicloud_dms_kafka_smart_connect_task" "test" {
+  instance_id = huaweicloud_dms_kafka_smart_connect.test.instance_id
+  task_name   = "%s"
+  topics      = [huaweicloud_dms_kafka_topic.test.name]
+  sink_type   = "OBS_SINK"
+
+  sink_task {
+    consumer_strategy     = "earliest"
+    deliver_time_interval = 300
+    access_key            = "%s"
+    secret_key            = "%s"
+    obs_bucket_name       = huaweicloud_obs_bucket.test.bucket
+    obs_path              = "kafka"
+    partition_format      = "yyyy/MM/dd/HH/mm"
+    record_delimiter      = ";"
+    store_keys            = false
+  }
+}
+`, testAccDmsKafkaSmartConnect_basic(name), name, name, name, acceptance.HW_ACCESS_KEY, acceptance.HW_SECRET_KEY)
+}
@@ -0,0 +1,72 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/dms/v2/kafka/instances"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getKafkaSmartConnectResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.DmsV2Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating HuaweiCloud DMS client(V2): %s", err)
+	}
+
+	instance, err := instances.Get(client, state.Primary.Attributes["instance_id"]).Extract()
+	if err != nil {
+		return nil, err
+	}
+	if !instance.ConnectorEnalbe || instance.ConnectorID != state.Primary.ID {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return instance, nil
+}
+
+func TestAccDmsKafkaSmartConnect_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceNameWithDash()
+	rName := "huaweicloud_dms_kafka_smart_connect.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getKafkaSmartConnectResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDmsKafkaSmartConnect_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id",
+						"huaweicloud_dms_kafka_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "node_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDmsKafkaSmartConnect_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_kafka_smart_connect" "test" {
+  instance_id = huaweicloud_dms_kafka_instance.test.id
+  bandwidth   = huaweicloud_dms_kafka_instance.test.bandwidth
+  node_count  = 2
+}
+`, testAccKafkaInstance_basic(name))
+}
@@ -0,0 +1,75 @@
+package dms
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getRabbitMQBindingResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	attrs := state.Primary.Attributes
+	return getRabbitMQVhostSubResource(cfg, attrs["instance_id"], attrs["vhost"],
+		"exchanges/"+url.PathEscape(attrs["exchange"])+"/binding?limit=100",
+		fmt.Sprintf("items[?destination_type=='%s'&&destination=='%s'&&routing_key=='%s']|[0]",
+			attrs["destination_type"], attrs["destination"], attrs["routing_key"]))
+}
+
+func TestAccDmsRabbitMQBinding_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dms_rabbitmq_binding.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getRabbitMQBindingResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDmsRabbitMQBinding_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "exchange",
+						"huaweicloud_dms_rabbitmq_exchange.test", "name"),
+					resource.TestCheckResourceAttr(rName, "destination_type", "queue"),
+					resource.TestCheckResourceAttrPair(rName, "destination",
+						"huaweicloud_dms_rabbitmq_queue.test", "name"),
+					resource.TestCheckResourceAttr(rName, "routing_key", "order"),
+					resource.TestCheckResourceAttrSet(rName, "properties_key"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testDmsRabbitMQBinding_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_rabbitmq_binding" "test" {
+  instance_id      = huaweicloud_dms_rabbitmq_instance.test.id
+  vhost            = huaweicloud_dms_rabbitmq_vhost.test.name
+  exchange         = huaweicloud_dms_rabbitmq_exchange.test.name
+  destination_type = "queue"
+  destination      = huaweicloud_dms_rabbitmq_queue.test.name
+  routing_key      = "order"
+}
+`, testDmsRabbitMQQueue_basic(name))
+}
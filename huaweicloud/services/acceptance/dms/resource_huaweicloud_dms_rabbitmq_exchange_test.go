@@ -0,0 +1,69 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getRabbitMQExchangeResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getRabbitMQVhostSubResource(cfg, state.Primary.Attributes["instance_id"],
+		state.Primary.Attributes["vhost"], "exchanges?limit=100",
+		fmt.Sprintf("items[?name=='%s']|[0]", state.Primary.Attributes["name"]))
+}
+
+func TestAccDmsRabbitMQExchange_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dms_rabbitmq_exchange.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getRabbitMQExchangeResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDmsRabbitMQExchange_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "vhost", "huaweicloud_dms_rabbitmq_vhost.test", "name"),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "type", "direct"),
+					resource.TestCheckResourceAttr(rName, "durable", "true"),
+					resource.TestCheckResourceAttr(rName, "auto_delete", "false"),
+					resource.TestCheckResourceAttr(rName, "internal", "false"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testDmsRabbitMQExchange_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_rabbitmq_exchange" "test" {
+  instance_id = huaweicloud_dms_rabbitmq_instance.test.id
+  vhost       = huaweicloud_dms_rabbitmq_vhost.test.name
+  name        = "%s"
+  type        = "direct"
+}
+`, testDmsRabbitMQVhost_basic(name), name)
+}
@@ -0,0 +1,75 @@
+package dms
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getRabbitMQQueueResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getRabbitMQVhostSubResource(cfg, state.Primary.Attributes["instance_id"],
+		state.Primary.Attributes["vhost"], "queues/"+url.PathEscape(state.Primary.Attributes["name"]), "@")
+}
+
+func TestAccDmsRabbitMQQueue_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dms_rabbitmq_queue.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getRabbitMQQueueResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDmsRabbitMQQueue_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "vhost", "huaweicloud_dms_rabbitmq_vhost.test", "name"),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "durable", "true"),
+					resource.TestCheckResourceAttrPair(rName, "dead_letter_exchange",
+						"huaweicloud_dms_rabbitmq_exchange.test", "name"),
+					resource.TestCheckResourceAttr(rName, "dead_letter_routing_key", "dead"),
+					resource.TestCheckResourceAttr(rName, "message_ttl", "60000"),
+					resource.TestCheckResourceAttr(rName, "lazy_mode", "lazy"),
+					resource.TestCheckResourceAttr(rName, "messages", "0"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testDmsRabbitMQQueue_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_rabbitmq_queue" "test" {
+  instance_id             = huaweicloud_dms_rabbitmq_instance.test.id
+  vhost                   = huaweicloud_dms_rabbitmq_vhost.test.name
+  name                    = "%s"
+  dead_letter_exchange    = huaweicloud_dms_rabbitmq_exchange.test.name
+  dead_letter_routing_key = "dead"
+  message_ttl             = 60000
+  lazy_mode               = "lazy"
+}
+`, testDmsRabbitMQExchange_basic(name), name)
+}
@@ -0,0 +1,111 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getRabbitMQUserResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	respBody, err := getDmsInstanceSubResource(cfg, state, "users?limit=100")
+	if err != nil {
+		return nil, err
+	}
+
+	expression := fmt.Sprintf("users[?access_key=='%s']|[0]", state.Primary.Attributes["access_key"])
+	user := utils.PathSearch(expression, respBody, nil)
+	if user == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return user, nil
+}
+
+func TestAccDmsRabbitMQUser_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dms_rabbitmq_user.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getRabbitMQUserResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDmsRabbitMQUser_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "access_key", name),
+					resource.TestCheckResourceAttr(rName, "vhosts.#", "1"),
+					resource.TestCheckResourceAttr(rName, "vhosts.0.conf", ".*"),
+				),
+			},
+			{
+				Config: testDmsRabbitMQUser_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "vhosts.#", "1"),
+					resource.TestCheckResourceAttr(rName, "vhosts.0.conf", ""),
+					resource.TestCheckResourceAttr(rName, "vhosts.0.read", ".*"),
+				),
+			},
+			{
+				ResourceName:            rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_key"},
+			},
+		},
+	})
+}
+
+func testDmsRabbitMQUser_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_rabbitmq_user" "test" {
+  instance_id = huaweicloud_dms_rabbitmq_instance.test.id
+  access_key  = "%s"
+  secret_key  = "Test@12345678"
+
+  vhosts {
+    vhost = huaweicloud_dms_rabbitmq_vhost.test.name
+    conf  = ".*"
+    write = ".*"
+    read  = ".*"
+  }
+}
+`, testDmsRabbitMQVhost_basic(name), name)
+}
+
+func testDmsRabbitMQUser_update(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_rabbitmq_user" "test" {
+  instance_id = huaweicloud_dms_rabbitmq_instance.test.id
+  access_key  = "%s"
+  secret_key  = "Test@87654321"
+
+  vhosts {
+    vhost = huaweicloud_dms_rabbitmq_vhost.test.name
+    conf  = ""
+    write = ""
+    read  = ".*"
+  }
+}
+`, testDmsRabbitMQVhost_basic(name), name)
+}
@@ -0,0 +1,107 @@
+package dms
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// getRabbitMQVhostSubResource queries the RabbitMQ management API under the virtual host and returns the object
+// matched the JMESPath expression.
+func getRabbitMQVhostSubResource(cfg *config.Config, instanceId, vhost, subPath,
+	expression string) (interface{}, error) {
+	client, err := cfg.NewServiceClient("dms", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DMS client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{instance_id}", instanceId)
+	if vhost != "" {
+		getPath += "/" + strings.ReplaceAll(vhost, "/", "__F_SLASH__")
+	}
+	if subPath != "" {
+		getPath += "/" + subPath
+	}
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return nil, err
+	}
+
+	result := utils.PathSearch(expression, getRespBody, nil)
+	if result == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return result, nil
+}
+
+func getRabbitMQVhostResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getRabbitMQVhostSubResource(cfg, state.Primary.Attributes["instance_id"], "", "",
+		fmt.Sprintf("items[?name=='%s']|[0]", state.Primary.Attributes["name"]))
+}
+
+func TestAccDmsRabbitMQVhost_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dms_rabbitmq_vhost.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getRabbitMQVhostResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDmsRabbitMQVhost_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id",
+						"huaweicloud_dms_rabbitmq_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "name", "/"+name),
+					resource.TestCheckResourceAttrSet(rName, "tracing"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testDmsRabbitMQVhost_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_dms_rabbitmq_vhost" "test" {
+  instance_id = huaweicloud_dms_rabbitmq_instance.test.id
+  name        = "/%[2]s"
+}
+`, testAccDmsRabbitmqInstance_basic(name), name)
+}
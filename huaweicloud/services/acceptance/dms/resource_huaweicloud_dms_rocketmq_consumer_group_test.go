@@ -0,0 +1,102 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getRocketMQConsumerGroupResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getDmsInstanceSubResource(cfg, state, "groups/"+state.Primary.Attributes["name"])
+}
+
+func TestAccDmsRocketMQConsumerGroup_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dms_rocketmq_consumer_group.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getRocketMQConsumerGroupResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDmsRocketMQConsumerGroup_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id",
+						"huaweicloud_dms_rocketmq_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "brokers.0", "broker-0"),
+					resource.TestCheckResourceAttr(rName, "retry_max_times", "3"),
+					resource.TestCheckResourceAttr(rName, "broadcast", "true"),
+					resource.TestCheckResourceAttr(rName, "enabled", "true"),
+					resource.TestCheckResourceAttr(rName, "description", "created by acc test"),
+				),
+			},
+			{
+				Config: testDmsRocketMQConsumerGroup_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "retry_max_times", "5"),
+					resource.TestCheckResourceAttr(rName, "broadcast", "false"),
+					resource.TestCheckResourceAttr(rName, "enabled", "false"),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testDmsRocketMQConsumerGroup_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_rocketmq_consumer_group" "test" {
+  instance_id     = huaweicloud_dms_rocketmq_instance.test.id
+  name            = "%s"
+  retry_max_times = 3
+  broadcast       = true
+  description     = "created by acc test"
+
+  brokers = [
+    "broker-0",
+  ]
+}
+`, testDmsRocketMQInstance_basic(name, name), name)
+}
+
+func testDmsRocketMQConsumerGroup_update(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_rocketmq_consumer_group" "test" {
+  instance_id     = huaweicloud_dms_rocketmq_instance.test.id
+  name            = "%s"
+  retry_max_times = 5
+  broadcast       = false
+  enabled         = false
+
+  brokers = [
+    "broker-0",
+  ]
+}
+`, testDmsRocketMQInstance_basic(name, name), name)
+}
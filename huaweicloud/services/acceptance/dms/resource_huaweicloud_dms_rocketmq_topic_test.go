@@ -0,0 +1,101 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getRocketMQTopicResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getDmsInstanceSubResource(cfg, state, "topics/"+state.Primary.Attributes["name"])
+}
+
+func TestAccDmsRocketMQTopic_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dms_rocketmq_topic.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getRocketMQTopicResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDmsRocketMQTopic_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id",
+						"huaweicloud_dms_rocketmq_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "permission", "all"),
+					resource.TestCheckResourceAttr(rName, "brokers.0", "broker-0"),
+					resource.TestCheckResourceAttr(rName, "total_read_queue_num", "3"),
+					resource.TestCheckResourceAttr(rName, "total_write_queue_num", "3"),
+				),
+			},
+			{
+				Config: testDmsRocketMQTopic_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "permission", "sub"),
+					resource.TestCheckResourceAttr(rName, "total_read_queue_num", "5"),
+					resource.TestCheckResourceAttr(rName, "total_write_queue_num", "5"),
+				),
+			},
+			{
+				ResourceName:            rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"queue_num", "queues"},
+			},
+		},
+	})
+}
+
+func testDmsRocketMQTopic_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_rocketmq_topic" "test" {
+  instance_id = huaweicloud_dms_rocketmq_instance.test.id
+  name        = "%s"
+  queue_num   = 3
+  permission  = "all"
+
+  brokers = [
+    "broker-0",
+  ]
+}
+`, testDmsRocketMQInstance_basic(name, name), name)
+}
+
+func testDmsRocketMQTopic_update(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_rocketmq_topic" "test" {
+  instance_id           = huaweicloud_dms_rocketmq_instance.test.id
+  name                  = "%s"
+  queue_num             = 3
+  permission            = "sub"
+  total_read_queue_num  = 5
+  total_write_queue_num = 5
+
+  brokers = [
+    "broker-0",
+  ]
+}
+`, testDmsRocketMQInstance_basic(name, name), name)
+}
@@ -0,0 +1,137 @@
+package dms
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getRocketMQUserResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getDmsInstanceSubResource(cfg, state, "users/"+state.Primary.Attributes["access_key"])
+}
+
+func TestAccDmsRocketMQUser_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dms_rocketmq_user.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getRocketMQUserResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDmsRocketMQUser_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "access_key", name),
+					resource.TestCheckResourceAttr(rName, "white_remote_address", "10.10.10.10"),
+					resource.TestCheckResourceAttr(rName, "admin", "false"),
+					resource.TestCheckResourceAttr(rName, "default_topic_perm", "DENY"),
+					resource.TestCheckResourceAttr(rName, "default_group_perm", "DENY"),
+					resource.TestCheckResourceAttr(rName, "topic_perms.#", "1"),
+					resource.TestCheckResourceAttr(rName, "group_perms.#", "1"),
+				),
+			},
+			{
+				Config: testDmsRocketMQUser_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "white_remote_address", ""),
+					resource.TestCheckResourceAttr(rName, "default_topic_perm", "SUB"),
+					resource.TestCheckResourceAttr(rName, "default_group_perm", "SUB"),
+					resource.TestCheckResourceAttr(rName, "topic_perms.#", "0"),
+					resource.TestCheckResourceAttr(rName, "group_perms.#", "0"),
+				),
+			},
+			{
+				ResourceName:            rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_key"},
+			},
+		},
+	})
+}
+
+func testDmsRocketMQUser_base(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_dms_rocketmq_instance" "test" {
+  name               = "%[2]s"
+  engine_version     = "4.8.0"
+  storage_space      = 600
+  vpc_id             = huaweicloud_vpc.test.id
+  subnet_id          = huaweicloud_vpc_subnet.test.id
+  security_group_id  = huaweicloud_networking_secgroup.test.id
+  availability_zones = [data.huaweicloud_availability_zones.test.names[0]]
+  flavor_id          = "c6.4u8g.cluster"
+  storage_spec_code  = "dms.physical.storage.high.v2"
+  broker_num         = 1
+  enable_acl         = true
+}
+
+resource "huaweicloud_dms_rocketmq_topic" "test" {
+  instance_id = huaweicloud_dms_rocketmq_instance.test.id
+  name        = "%[2]s"
+  queue_num   = 3
+}
+
+resource "huaweicloud_dms_rocketmq_consumer_group" "test" {
+  instance_id = huaweicloud_dms_rocketmq_instance.test.id
+  name        = "%[2]s"
+}
+`, testAccDmsRocketmqInstance_Base(name), name)
+}
+
+func testDmsRocketMQUser_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_rocketmq_user" "test" {
+  instance_id          = huaweicloud_dms_rocketmq_instance.test.id
+  access_key           = "%s"
+  secret_key           = "Test@12345678"
+  white_remote_address = "10.10.10.10"
+  default_topic_perm   = "DENY"
+  default_group_perm   = "DENY"
+
+  topic_perms {
+    name = huaweicloud_dms_rocketmq_topic.test.name
+    perm = "PUB|SUB"
+  }
+
+  group_perms {
+    name = huaweicloud_dms_rocketmq_consumer_group.test.name
+    perm = "SUB"
+  }
+}
+`, testDmsRocketMQUser_base(name), name)
+}
+
+func testDmsRocketMQUser_update(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dms_rocketmq_user" "test" {
+  instance_id        = huaweicloud_dms_rocketmq_instance.test.id
+  access_key         = "%s"
+  secret_key         = "Test@87654321"
+  default_topic_perm = "SUB"
+  default_group_perm = "SUB"
+}
+`, testDmsRocketMQUser_base(name), name)
+}
@@ -0,0 +1,113 @@
+package dns
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getCustomLineResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("dns", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DNS Client: %s", err)
+	}
+
+	getCustomLinePath := client.Endpoint + "v2.1/customlines?line_id={line_id}"
+	getCustomLinePath = strings.ReplaceAll(getCustomLinePath, "{line_id}", state.Primary.ID)
+
+	getCustomLineOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getCustomLineResp, err := client.Request("GET", getCustomLinePath, &getCustomLineOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving DNS custom line: %s", err)
+	}
+
+	getCustomLineRespBody, err := utils.FlattenResponse(getCustomLineResp)
+	if err != nil {
+		return nil, err
+	}
+
+	line := utils.PathSearch(fmt.Sprintf("lines[?line_id=='%s']|[0]", state.Primary.ID), getCustomLineRespBody, nil)
+	if line == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return line, nil
+}
+
+func TestAccCustomLine_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dns_custom_line.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getCustomLineResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testCustomLine_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "description", "created by acc test"),
+					resource.TestCheckResourceAttr(rName, "ip_segments.#", "1"),
+					resource.TestCheckResourceAttr(rName, "ip_segments.0", "100.100.100.100-100.100.100.101"),
+					resource.TestCheckResourceAttr(rName, "status", "ACTIVE"),
+				),
+			},
+			{
+				Config: testCustomLine_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name+"_update"),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+					resource.TestCheckResourceAttr(rName, "ip_segments.#", "2"),
+					resource.TestCheckResourceAttr(rName, "ip_segments.1", "100.100.100.110-100.100.100.120"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCustomLine_basic(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_dns_custom_line" "test" {
+  name        = "%s"
+  ip_segments = ["100.100.100.100-100.100.100.101"]
+  description = "created by acc test"
+}
+`, name)
+}
+
+func testCustomLine_update(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_dns_custom_line" "test" {
+  name        = "%s_update"
+  ip_segments = ["100.100.100.100-100.100.100.101", "100.100.100.110-100.100.100.120"]
+}
+`, name)
+}
@@ -109,3 +109,65 @@ resource "huaweicloud_dws_cluster" "test" {
 }
 `, baseResource, rName, numberOfNode, password, publicIpBindType)
 }
+
+func TestAccResourceDWS_parameters(t *testing.T) {
+	var clusterInstance cluster.CreateOpts
+	resourceName := "huaweicloud_dws_cluster.test"
+	name := acceptance.RandomAccResourceName()
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&clusterInstance,
+		getDwsResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDwsCluster_parameters(name, "30min"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "restart_required", "false"),
+				),
+			},
+			{
+				Config: testAccDwsCluster_parameters(name, "60min"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "restart_required", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDwsCluster_parameters(rName, timeout string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dws_cluster" "test" {
+  name              = "%s"
+  node_type         = "dws.m3.xlarge"
+  number_of_node    = 3
+  vpc_id            = huaweicloud_vpc.test.id
+  network_id        = huaweicloud_vpc_subnet.test.id
+  security_group_id = huaweicloud_networking_secgroup.test.id
+  availability_zone = data.huaweicloud_availability_zones.test.names[0]
+  user_name         = "test_cluster_admin"
+  user_pwd          = "cluster123@!"
+
+  parameters {
+    name  = "session_timeout"
+    type  = "cn"
+    value = "%s"
+  }
+
+  restart_for_parameters = true
+}
+`, testAccBaseResource(rName), rName, timeout)
+}
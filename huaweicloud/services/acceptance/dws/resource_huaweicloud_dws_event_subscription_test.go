@@ -0,0 +1,81 @@
+package dws
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getDwsEventSubscriptionResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getDwsApiResource(cfg, "v2/{project_id}/event-subs/"+state.Primary.ID)
+}
+
+func TestAccDwsEventSubscription_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dws_event_subscription.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDwsEventSubscriptionResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDwsEventSubscription_basic(name, "cluster", "normal", true),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "source_type", "cluster"),
+					resource.TestCheckResourceAttr(rName, "severity", "normal"),
+					resource.TestCheckResourceAttr(rName, "enable", "true"),
+					resource.TestCheckResourceAttrPair(rName, "notification_target",
+						"huaweicloud_smn_topic.test", "topic_urn"),
+				),
+			},
+			{
+				Config: testDwsEventSubscription_basic(name, "backup", "warning", false),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "source_type", "backup"),
+					resource.TestCheckResourceAttr(rName, "severity", "warning"),
+					resource.TestCheckResourceAttr(rName, "enable", "false"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testDwsEventSubscription_basic(name, sourceType, severity string, enable bool) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_smn_topic" "test" {
+  name = "%[1]s"
+}
+
+resource "huaweicloud_dws_event_subscription" "test" {
+  name                     = "%[1]s"
+  notification_target      = huaweicloud_smn_topic.test.topic_urn
+  notification_target_name = huaweicloud_smn_topic.test.name
+  source_type              = "%[2]s"
+  severity                 = "%[3]s"
+  enable                   = %[4]t
+  time_zone                = "GMT+08:00"
+}
+`, name, sourceType, severity, enable)
+}
@@ -0,0 +1,99 @@
+package dws
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getDwsLogicalClusterResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	clusterId := state.Primary.Attributes["cluster_id"]
+	respBody, err := getDwsApiResource(cfg, fmt.Sprintf("v2/{project_id}/clusters/%s/logical-clusters", clusterId))
+	if err != nil {
+		return nil, err
+	}
+
+	logicalClusterId := strings.TrimPrefix(state.Primary.ID, clusterId+"/")
+	logicalCluster := utils.PathSearch(fmt.Sprintf("logical_clusters[?logical_cluster_id=='%s']|[0]",
+		logicalClusterId), respBody, nil)
+	if logicalCluster == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return logicalCluster, nil
+}
+
+func TestAccDwsLogicalCluster_basic(t *testing.T) {
+	var obj interface{}
+
+	name := strings.ReplaceAll(acceptance.RandomAccResourceName(), "-", "_")
+	rName := "huaweicloud_dws_logical_cluster.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDwsLogicalClusterResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckDwsLogicalCluster(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDwsLogicalCluster_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "cluster_id", acceptance.HW_DWS_CLUSTER_ID),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "cluster_rings.#", "1"),
+					resource.TestCheckResourceAttrSet(rName, "cluster_rings.0.ring_hosts.0.host_name"),
+					resource.TestCheckResourceAttr(rName, "status", "Normal"),
+					resource.TestCheckResourceAttrSet(rName, "first_logical_cluster"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testDwsLogicalCluster_basic(name string) string {
+	return fmt.Sprintf(`
+locals {
+  ring_hosts = jsondecode(%[1]q)
+}
+
+resource "huaweicloud_dws_logical_cluster" "test" {
+  cluster_id = "%[2]s"
+  name       = "%[3]s"
+
+  cluster_rings {
+    dynamic "ring_hosts" {
+      for_each = local.ring_hosts
+
+      content {
+        host_name = ring_hosts.value.host_name
+        back_ip   = ring_hosts.value.back_ip
+        cpu_cores = ring_hosts.value.cpu_cores
+        memory    = ring_hosts.value.memory
+        disk_size = ring_hosts.value.disk_size
+      }
+    }
+  }
+}
+`, acceptance.HW_DWS_RING_HOSTS, acceptance.HW_DWS_CLUSTER_ID, name)
+}
@@ -0,0 +1,81 @@
+package dws
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/dws/v1/cluster"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getDwsSnapshotPolicyResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	clusterId := state.Primary.Attributes["cluster_id"]
+	respBody, err := getDwsApiResource(cfg, fmt.Sprintf("v1.0/{project_id}/clusters/%s/snapshot-policies", clusterId))
+	if err != nil {
+		return nil, err
+	}
+
+	policyId := strings.TrimPrefix(state.Primary.ID, clusterId+"/")
+	policy := utils.PathSearch(fmt.Sprintf("backup_strategies[?policy_id=='%s']|[0]", policyId), respBody, nil)
+	if policy == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return policy, nil
+}
+
+func TestAccDwsSnapshotPolicy_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dws_snapshot_policy.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDwsSnapshotPolicyResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDwsSnapshotPolicy_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", "policy_full"),
+					resource.TestCheckResourceAttr(rName, "type", "full"),
+					resource.TestCheckResourceAttr(rName, "strategy", "0 8 6 ? * 1"),
+					resource.TestCheckResourceAttr(rName, "keep_days", "7"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testDwsSnapshotPolicy_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dws_snapshot_policy" "test" {
+  cluster_id = huaweicloud_dws_cluster.test.id
+  name       = "policy_full"
+  strategy   = "0 8 6 ? * 1"
+  type       = "full"
+  keep_days  = 7
+}
+`, testAccDwsCluster_basic(name, 3, cluster.PublicBindTypeAuto, "cluster123@!"))
+}
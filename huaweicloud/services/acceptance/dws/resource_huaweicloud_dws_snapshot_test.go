@@ -0,0 +1,141 @@
+package dws
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/dws/v1/cluster"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// getDwsApiResource queries the DWS API, the path is relative to the endpoint and starts with the API version.
+func getDwsApiResource(cfg *config.Config, path string) (interface{}, error) {
+	client, err := cfg.NewServiceClient("dws", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating DWS client: %s", err)
+	}
+
+	getPath := client.Endpoint + strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+func getDwsSnapshotResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getDwsApiResource(cfg, "v1.0/{project_id}/snapshots/"+state.Primary.ID)
+}
+
+func TestAccDwsSnapshot_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dws_snapshot.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDwsSnapshotResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDwsSnapshot_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "description", "created by terraform"),
+					resource.TestCheckResourceAttr(rName, "status", "AVAILABLE"),
+					resource.TestCheckResourceAttrPair(rName, "cluster_id", "huaweicloud_dws_cluster.test", "id"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDwsSnapshot_restore(t *testing.T) {
+	var clusterInstance cluster.CreateOpts
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_dws_cluster.restore"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&clusterInstance,
+		getDwsResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDwsSnapshot_restore(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name+"-restore"),
+					resource.TestCheckResourceAttr(rName, "number_of_node", "3"),
+					resource.TestCheckResourceAttr(rName, "status", "AVAILABLE"),
+				),
+			},
+		},
+	})
+}
+
+func testDwsSnapshot_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dws_snapshot" "test" {
+  cluster_id  = huaweicloud_dws_cluster.test.id
+  name        = "%s"
+  description = "created by terraform"
+}
+`, testAccDwsCluster_basic(name, 3, cluster.PublicBindTypeAuto, "cluster123@!"), name)
+}
+
+func testDwsSnapshot_restore(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_dws_cluster" "restore" {
+  name              = "%s-restore"
+  node_type         = "dws.m3.xlarge"
+  number_of_node    = 3
+  vpc_id            = huaweicloud_vpc.test.id
+  network_id        = huaweicloud_vpc_subnet.test.id
+  security_group_id = huaweicloud_networking_secgroup.test.id
+  availability_zone = data.huaweicloud_availability_zones.test.names[0]
+  user_name         = "test_cluster_admin"
+  user_pwd          = "cluster123@!"
+
+  restore {
+    snapshot_id = huaweicloud_dws_snapshot.test.id
+  }
+}
+`, testDwsSnapshot_basic(name), name)
+}
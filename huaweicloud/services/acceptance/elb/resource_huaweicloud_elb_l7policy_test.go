@@ -44,6 +44,87 @@ func TestAccElbV3L7Policy_basic(t *testing.T) {
 	})
 }
 
+func TestAccElbV3L7Policy_advanced(t *testing.T) {
+	var l7Policy l7policies.L7Policy
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "huaweicloud_elb_l7policy.test"
+	redirectName := "huaweicloud_elb_l7policy.redirect"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckElbV3L7PolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckElbV3L7PolicyConfig_advanced(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckElbV3L7PolicyExists(resourceName, &l7Policy),
+					resource.TestCheckResourceAttr(resourceName, "action", "FIXED_RESPONSE"),
+					resource.TestCheckResourceAttr(resourceName, "priority", "10"),
+					resource.TestCheckResourceAttr(resourceName, "fixed_response_config.0.status_code", "200"),
+					resource.TestCheckResourceAttr(resourceName, "fixed_response_config.0.content_type", "text/plain"),
+					resource.TestCheckResourceAttr(resourceName, "fixed_response_config.0.message_body", "maintenance"),
+					resource.TestCheckResourceAttr(resourceName,
+						"fixed_response_config.0.insert_headers_config.0.configs.#", "1"),
+					resource.TestCheckResourceAttr(redirectName, "action", "REDIRECT_TO_URL"),
+					resource.TestCheckResourceAttr(redirectName, "redirect_url_config.0.status_code", "301"),
+					resource.TestCheckResourceAttr(redirectName, "redirect_url_config.0.protocol", "HTTPS"),
+				),
+			},
+			{
+				Config: testAccCheckElbV3L7PolicyConfig_advancedUpdate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckElbV3L7PolicyExists(resourceName, &l7Policy),
+					resource.TestCheckResourceAttr(resourceName, "priority", "20"),
+					resource.TestCheckResourceAttr(resourceName, "fixed_response_config.0.status_code", "503"),
+					resource.TestCheckResourceAttr(resourceName, "fixed_response_config.0.content_type",
+						"application/json"),
+					resource.TestCheckResourceAttr(redirectName, "redirect_url_config.0.status_code", "302"),
+					resource.TestCheckResourceAttr(redirectName, "redirect_url_config.0.path", "/new"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccElbV3L7Policy_weightedPools(t *testing.T) {
+	var l7Policy l7policies.L7Policy
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "huaweicloud_elb_l7policy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckElbV3L7PolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckElbV3L7PolicyConfig_weightedPools(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckElbV3L7PolicyExists(resourceName, &l7Policy),
+					resource.TestCheckResourceAttr(resourceName, "action", "REDIRECT_TO_POOL"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_pools_config.#", "2"),
+					resource.TestCheckResourceAttr(resourceName,
+						"redirect_pools_extend_config.0.rewrite_url_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName,
+						"redirect_pools_extend_config.0.rewrite_url_config.0.path", "/v2"),
+					resource.TestCheckResourceAttr(resourceName,
+						"redirect_pools_extend_config.0.remove_headers_config.0.configs.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccCheckElbV3L7PolicyDestroy(s *terraform.State) error {
 	config := acceptance.TestAccProvider.Meta().(*config.Config)
 	lbClient, err := config.ElbV3Client(acceptance.HW_REGION_NAME)
@@ -144,3 +225,148 @@ resource "huaweicloud_elb_l7policy" "test" {
 }
 `, rName, rName, rName, rName)
 }
+
+func testAccElbV3L7Policy_advancedBase(rName string) string {
+	return fmt.Sprintf(`
+data "huaweicloud_vpc_subnet" "test" {
+  name = "subnet-default"
+}
+
+data "huaweicloud_availability_zones" "test" {}
+
+resource "huaweicloud_elb_loadbalancer" "test" {
+  name            = "%[1]s"
+  ipv4_subnet_id  = data.huaweicloud_vpc_subnet.test.ipv4_subnet_id
+  ipv6_network_id = data.huaweicloud_vpc_subnet.test.id
+
+  availability_zone = [
+    data.huaweicloud_availability_zones.test.names[0]
+  ]
+}
+
+resource "huaweicloud_elb_listener" "test" {
+  name            = "%[1]s"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = huaweicloud_elb_loadbalancer.test.id
+
+  advanced_forwarding_enabled = true
+}
+
+resource "huaweicloud_elb_pool" "test" {
+  count = 2
+
+  name            = "%[1]s-${count.index}"
+  protocol        = "HTTP"
+  lb_method       = "LEAST_CONNECTIONS"
+  loadbalancer_id = huaweicloud_elb_loadbalancer.test.id
+}
+`, rName)
+}
+
+func testAccCheckElbV3L7PolicyConfig_advanced(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_elb_l7policy" "test" {
+  name        = "%[2]s"
+  listener_id = huaweicloud_elb_listener.test.id
+  action      = "FIXED_RESPONSE"
+  priority    = 10
+
+  fixed_response_config {
+    status_code  = "200"
+    content_type = "text/plain"
+    message_body = "maintenance"
+
+    insert_headers_config {
+      configs {
+        key        = "X-Maintenance"
+        value_type = "USER_DEFINED"
+        value      = "true"
+      }
+    }
+  }
+}
+
+resource "huaweicloud_elb_l7policy" "redirect" {
+  name        = "%[2]s-redirect"
+  listener_id = huaweicloud_elb_listener.test.id
+  action      = "REDIRECT_TO_URL"
+  priority    = 30
+
+  redirect_url_config {
+    status_code = "301"
+    protocol    = "HTTPS"
+    port        = "443"
+  }
+}
+`, testAccElbV3L7Policy_advancedBase(rName), rName)
+}
+
+func testAccCheckElbV3L7PolicyConfig_advancedUpdate(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_elb_l7policy" "test" {
+  name        = "%[2]s"
+  listener_id = huaweicloud_elb_listener.test.id
+  action      = "FIXED_RESPONSE"
+  priority    = 20
+
+  fixed_response_config {
+    status_code  = "503"
+    content_type = "application/json"
+    message_body = "{\"message\": \"maintenance\"}"
+  }
+}
+
+resource "huaweicloud_elb_l7policy" "redirect" {
+  name        = "%[2]s-redirect"
+  listener_id = huaweicloud_elb_listener.test.id
+  action      = "REDIRECT_TO_URL"
+  priority    = 30
+
+  redirect_url_config {
+    status_code = "302"
+    protocol    = "HTTPS"
+    port        = "443"
+    path        = "/new"
+  }
+}
+`, testAccElbV3L7Policy_advancedBase(rName), rName)
+}
+
+func testAccCheckElbV3L7PolicyConfig_weightedPools(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_elb_l7policy" "test" {
+  name        = "%[2]s"
+  listener_id = huaweicloud_elb_listener.test.id
+
+  redirect_pools_config {
+    pool_id = huaweicloud_elb_pool.test[0].id
+    weight  = 80
+  }
+  redirect_pools_config {
+    pool_id = huaweicloud_elb_pool.test[1].id
+    weight  = 20
+  }
+
+  redirect_pools_extend_config {
+    rewrite_url_enabled = true
+
+    rewrite_url_config {
+      path = "/v2"
+    }
+
+    remove_headers_config {
+      configs {
+        key = "X-Debug"
+      }
+    }
+  }
+}
+`, testAccElbV3L7Policy_advancedBase(rName), rName)
+}
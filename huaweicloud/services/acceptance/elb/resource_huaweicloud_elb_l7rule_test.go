@@ -55,6 +55,45 @@ func TestAccElbV3L7Rule_basic(t *testing.T) {
 	})
 }
 
+func TestAccElbV3L7Rule_conditions(t *testing.T) {
+	var l7rule l7rules.Rule
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	resourceName := "huaweicloud_elb_l7rule.l7rule_1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckElbV3L7RuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckElbV3L7RuleConfig_conditions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckElbV3L7RuleExists(resourceName, &l7rule),
+					resource.TestCheckResourceAttr(resourceName, "type", "HEADER"),
+					resource.TestCheckResourceAttr(resourceName, "compare_type", "EQUAL_TO"),
+					resource.TestCheckResourceAttr(resourceName, "conditions.#", "1"),
+					resource.TestCheckResourceAttr("huaweicloud_elb_l7rule.method", "type", "METHOD"),
+					resource.TestCheckResourceAttr("huaweicloud_elb_l7rule.method", "conditions.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckElbV3L7RuleConfig_conditionsUpdate(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckElbV3L7RuleExists(resourceName, &l7rule),
+					resource.TestCheckResourceAttr(resourceName, "conditions.#", "2"),
+					resource.TestCheckResourceAttr("huaweicloud_elb_l7rule.method", "conditions.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccELBL7RuleImportStateIdFunc(),
+			},
+		},
+	})
+}
+
 func testAccCheckElbV3L7RuleDestroy(s *terraform.State) error {
 	config := acceptance.TestAccProvider.Meta().(*config.Config)
 	lbClient, err := config.ElbV3Client(acceptance.HW_REGION_NAME)
@@ -222,3 +261,76 @@ resource "huaweicloud_elb_l7rule" "l7rule_1" {
 }
 `, testAccCheckElbV3L7RuleConfig(rName))
 }
+
+func testAccCheckElbV3L7RuleConfig_conditionsBase(rName string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_elb_l7policy" "test" {
+  name             = "%[2]s"
+  listener_id      = huaweicloud_elb_listener.test.id
+  redirect_pool_id = huaweicloud_elb_pool.test[0].id
+}
+`, testAccElbV3L7Policy_advancedBase(rName), rName)
+}
+
+func testAccCheckElbV3L7RuleConfig_conditions(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_elb_l7rule" "l7rule_1" {
+  l7policy_id  = huaweicloud_elb_l7policy.test.id
+  type         = "HEADER"
+  compare_type = "EQUAL_TO"
+
+  conditions {
+    key   = "X-Canary"
+    value = "true"
+  }
+}
+
+resource "huaweicloud_elb_l7rule" "method" {
+  l7policy_id  = huaweicloud_elb_l7policy.test.id
+  type         = "METHOD"
+  compare_type = "EQUAL_TO"
+
+  conditions {
+    value = "GET"
+  }
+  conditions {
+    value = "POST"
+  }
+}
+`, testAccCheckElbV3L7RuleConfig_conditionsBase(rName))
+}
+
+func testAccCheckElbV3L7RuleConfig_conditionsUpdate(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_elb_l7rule" "l7rule_1" {
+  l7policy_id  = huaweicloud_elb_l7policy.test.id
+  type         = "HEADER"
+  compare_type = "EQUAL_TO"
+
+  conditions {
+    key   = "X-Canary"
+    value = "true"
+  }
+  conditions {
+    key   = "X-Canary"
+    value = "yes"
+  }
+}
+
+resource "huaweicloud_elb_l7rule" "method" {
+  l7policy_id  = huaweicloud_elb_l7policy.test.id
+  type         = "METHOD"
+  compare_type = "EQUAL_TO"
+
+  conditions {
+    value = "GET"
+  }
+}
+`, testAccCheckElbV3L7RuleConfig_conditionsBase(rName))
+}
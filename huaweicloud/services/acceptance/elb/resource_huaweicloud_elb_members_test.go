@@ -0,0 +1,162 @@
+package elb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/elb/v3/pools"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getELBMembersResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.ElbV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ELB client: %s", err)
+	}
+
+	allPages, err := pools.ListMembers(client, state.Primary.ID, pools.ListMembersOpts{}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+	members, err := pools.ExtractMembers(allPages)
+	if err != nil {
+		return nil, err
+	}
+	if len(members) == 0 {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return members, nil
+}
+
+func TestAccElbV3Members_basic(t *testing.T) {
+	var members []pools.Member
+	rName := acceptance.RandomAccResourceNameWithDash()
+	resourceName := "huaweicloud_elb_members.test"
+
+	rc := acceptance.InitResourceCheck(
+		resourceName,
+		&members,
+		getELBMembersResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccElbV3MembersConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(resourceName, "pool_id", "huaweicloud_elb_pool.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "3"),
+				),
+			},
+			{
+				Config: testAccElbV3MembersConfig_update(rName),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(resourceName, "members.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "members.*", map[string]string{
+						"address": "192.168.0.10",
+						"weight":  "0",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "members.*", map[string]string{
+						"address": "192.168.0.13",
+						"weight":  "20",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccElbV3MembersConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "huaweicloud_vpc_subnet" "test" {
+  name = "subnet-default"
+}
+
+data "huaweicloud_availability_zones" "test" {}
+
+resource "huaweicloud_elb_loadbalancer" "test" {
+  name            = "%[1]s"
+  ipv4_subnet_id  = data.huaweicloud_vpc_subnet.test.ipv4_subnet_id
+  ipv6_network_id = data.huaweicloud_vpc_subnet.test.id
+
+  availability_zone = [
+    data.huaweicloud_availability_zones.test.names[0]
+  ]
+}
+
+resource "huaweicloud_elb_listener" "test" {
+  name            = "%[1]s"
+  protocol        = "HTTP"
+  protocol_port   = 8080
+  loadbalancer_id = huaweicloud_elb_loadbalancer.test.id
+}
+
+resource "huaweicloud_elb_pool" "test" {
+  name        = "%[1]s"
+  protocol    = "HTTP"
+  lb_method   = "ROUND_ROBIN"
+  listener_id = huaweicloud_elb_listener.test.id
+}
+`, rName)
+}
+
+func testAccElbV3MembersConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_elb_members" "test" {
+  pool_id = huaweicloud_elb_pool.test.id
+
+  dynamic "members" {
+    for_each = ["192.168.0.10", "192.168.0.11", "192.168.0.12"]
+
+    content {
+      address       = members.value
+      protocol_port = 8080
+      subnet_id     = data.huaweicloud_vpc_subnet.test.ipv4_subnet_id
+      weight        = 10
+    }
+  }
+}
+`, testAccElbV3MembersConfig_base(rName))
+}
+
+func testAccElbV3MembersConfig_update(rName string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_elb_members" "test" {
+  pool_id = huaweicloud_elb_pool.test.id
+
+  members {
+    address       = "192.168.0.10"
+    protocol_port = 8080
+    subnet_id     = data.huaweicloud_vpc_subnet.test.ipv4_subnet_id
+    weight        = 0
+  }
+
+  members {
+    name          = "green"
+    address       = "192.168.0.13"
+    protocol_port = 8080
+    subnet_id     = data.huaweicloud_vpc_subnet.test.ipv4_subnet_id
+    weight        = 20
+  }
+}
+`, testAccElbV3MembersConfig_base(rName))
+}
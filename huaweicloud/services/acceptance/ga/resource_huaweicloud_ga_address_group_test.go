@@ -0,0 +1,136 @@
+package ga
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getIpAddressGroupResourceFunc(config *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	region := acceptance.HW_REGION_NAME
+	// getIpAddressGroup: Query the GA IP Address Group detail
+	var (
+		getIpAddressGroupHttpUrl = "v1/ip-groups/{id}"
+		getIpAddressGroupProduct = "ga"
+	)
+	getIpAddressGroupClient, err := config.NewServiceClient(getIpAddressGroupProduct, region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating IpAddressGroup Client: %s", err)
+	}
+
+	getIpAddressGroupPath := getIpAddressGroupClient.Endpoint + getIpAddressGroupHttpUrl
+	getIpAddressGroupPath = strings.ReplaceAll(getIpAddressGroupPath, "{id}", state.Primary.ID)
+
+	getIpAddressGroupOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getIpAddressGroupResp, err := getIpAddressGroupClient.Request("GET", getIpAddressGroupPath,
+		&getIpAddressGroupOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving IpAddressGroup: %s", err)
+	}
+	return utils.FlattenResponse(getIpAddressGroupResp)
+}
+
+func TestAccIpAddressGroup_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceNameWithDash()
+	rName := "huaweicloud_ga_address_group.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getIpAddressGroupResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testIpAddressGroup_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "description", "terraform test"),
+					resource.TestCheckResourceAttr(rName, "ip_addresses.#", "2"),
+					resource.TestCheckResourceAttr(rName, "listeners.#", "1"),
+					resource.TestCheckResourceAttr(rName, "listeners.0.type", "WHITE"),
+					resource.TestCheckResourceAttrPair(rName, "listeners.0.id", "huaweicloud_ga_listener.test", "id"),
+				),
+			},
+			{
+				Config: testIpAddressGroup_basic_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name+"-update"),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+					resource.TestCheckResourceAttr(rName, "ip_addresses.#", "1"),
+					resource.TestCheckResourceAttr(rName, "ip_addresses.0.cidr", "192.168.2.0/24"),
+					resource.TestCheckResourceAttr(rName, "listeners.0.type", "BLACK"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testIpAddressGroup_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_ga_address_group" "test" {
+  name        = "%s"
+  description = "terraform test"
+
+  ip_addresses {
+    cidr        = "192.168.0.0/24"
+    description = "office"
+  }
+  ip_addresses {
+    cidr = "192.168.1.0/24"
+  }
+
+  listeners {
+    id   = huaweicloud_ga_listener.test.id
+    type = "WHITE"
+  }
+}
+`, testListener_basic(name), name)
+}
+
+func testIpAddressGroup_basic_update(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_ga_address_group" "test" {
+  name = "%s-update"
+
+  ip_addresses {
+    cidr = "192.168.2.0/24"
+  }
+
+  listeners {
+    id   = huaweicloud_ga_listener.test.id
+    type = "BLACK"
+  }
+}
+`, testListener_basic(name), name)
+}
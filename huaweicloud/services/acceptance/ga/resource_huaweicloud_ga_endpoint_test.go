@@ -0,0 +1,128 @@
+package ga
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getEndpointResourceFunc(config *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	region := acceptance.HW_REGION_NAME
+	// getEndpoint: Query the GA Endpoint detail
+	var (
+		getEndpointHttpUrl = "v1/endpoint-groups/{endpoint_group_id}/endpoints/{id}"
+		getEndpointProduct = "ga"
+	)
+	getEndpointClient, err := config.NewServiceClient(getEndpointProduct, region)
+	if err != nil {
+		return nil, fmt.Errorf("error creating Endpoint Client: %s", err)
+	}
+
+	getEndpointPath := getEndpointClient.Endpoint + getEndpointHttpUrl
+	getEndpointPath = strings.ReplaceAll(getEndpointPath, "{endpoint_group_id}",
+		state.Primary.Attributes["endpoint_group_id"])
+	getEndpointPath = strings.ReplaceAll(getEndpointPath, "{id}", state.Primary.ID)
+
+	getEndpointOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getEndpointResp, err := getEndpointClient.Request("GET", getEndpointPath, &getEndpointOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving Endpoint: %s", err)
+	}
+	return utils.FlattenResponse(getEndpointResp)
+}
+
+func TestAccEndpoint_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceNameWithDash()
+	rName := "huaweicloud_ga_endpoint.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getEndpointResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testEndpoint_basic(name, 10),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "resource_type", "EIP"),
+					resource.TestCheckResourceAttr(rName, "weight", "10"),
+					resource.TestCheckResourceAttr(rName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttrPair(rName, "endpoint_group_id",
+						"huaweicloud_ga_endpoint_group.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "resource_id", "huaweicloud_vpc_eip.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "ip_address", "huaweicloud_vpc_eip.test", "address"),
+				),
+			},
+			{
+				Config: testEndpoint_basic(name, 50),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "weight", "50"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testEndpointImportState(rName),
+			},
+		},
+	})
+}
+
+func testEndpointImportState(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource (%s) not found: %s", name, rs)
+		}
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["endpoint_group_id"], rs.Primary.ID), nil
+	}
+}
+
+func testEndpoint_basic(name string, weight int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_vpc_eip" "test" {
+  publicip {
+    type = "5_bgp"
+  }
+
+  bandwidth {
+    share_type  = "PER"
+    size        = 5
+    name        = "%s"
+    charge_mode = "traffic"
+  }
+}
+
+resource "huaweicloud_ga_endpoint" "test" {
+  endpoint_group_id = huaweicloud_ga_endpoint_group.test.id
+  resource_id       = huaweicloud_vpc_eip.test.id
+  ip_address        = huaweicloud_vpc_eip.test.address
+  weight            = %d
+}
+`, testEndpointGroup_basic(name), name, weight)
+}
@@ -0,0 +1,61 @@
+package gaussdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDataSourceGaussDBMysqlTemplateCompare_basic(t *testing.T) {
+	name := acceptance.RandomAccResourceName()
+	dataSource := "data.huaweicloud_gaussdb_mysql_parameter_template_compare.test"
+	dc := acceptance.InitDataSourceCheck(dataSource)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceGaussDBMysqlTemplateCompare_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(dataSource, "differences.#", "1"),
+					resource.TestCheckResourceAttr(dataSource, "differences.0.parameter_name",
+						"auto_increment_increment"),
+					resource.TestCheckResourceAttr(dataSource, "differences.0.source_value", "4"),
+					resource.TestCheckResourceAttr(dataSource, "differences.0.target_value", "8"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceGaussDBMysqlTemplateCompare_basic(name string) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_gaussdb_mysql_parameter_template" "source" {
+  name = "%[1]s-source"
+
+  parameter_values = {
+    auto_increment_increment = "4"
+  }
+}
+
+resource "huaweicloud_gaussdb_mysql_parameter_template" "target" {
+  name = "%[1]s-target"
+
+  parameter_values = {
+    auto_increment_increment = "8"
+  }
+}
+
+data "huaweicloud_gaussdb_mysql_parameter_template_compare" "test" {
+  source_configuration_id = huaweicloud_gaussdb_mysql_parameter_template.source.id
+  target_configuration_id = huaweicloud_gaussdb_mysql_parameter_template.target.id
+}
+`, name)
+}
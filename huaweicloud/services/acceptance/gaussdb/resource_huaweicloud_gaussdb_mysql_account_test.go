@@ -0,0 +1,79 @@
+package gaussdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getGaussDBMysqlAccountResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return listGaussDBMysqlInstanceSubResources(cfg, state.Primary.Attributes["instance_id"], "db-users?limit=100",
+		fmt.Sprintf("users[?name=='%s' && host=='%s']|[0]", state.Primary.Attributes["name"],
+			state.Primary.Attributes["host"]))
+}
+
+func TestAccGaussDBMysqlAccount_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_gaussdb_mysql_account.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getGaussDBMysqlAccountResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testGaussDBMysqlAccount_basic(name, "Test@12345678", "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id",
+						"huaweicloud_gaussdb_mysql_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "name", "test_user"),
+					resource.TestCheckResourceAttr(rName, "host", "10.10.%"),
+					resource.TestCheckResourceAttr(rName, "description", "test description"),
+				),
+			},
+			{
+				Config: testGaussDBMysqlAccount_basic(name, "Test@87654321", "test description update"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "description", "test description update"),
+				),
+			},
+			{
+				ResourceName:            rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testGaussDBMysqlAccount_basic(name, password, description string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_gaussdb_mysql_account" "test" {
+  instance_id = huaweicloud_gaussdb_mysql_instance.test.id
+  name        = "test_user"
+  password    = "%[2]s"
+  host        = "10.10.%%"
+  description = "%[3]s"
+}
+`, testAccGaussDBMysqlInstance_base(name), password, description)
+}
@@ -0,0 +1,144 @@
+package gaussdb
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// listGaussDBMysqlInstanceSubResources queries the first page of the sub-resources (databases, db-users, etc.) of the
+// GaussDB MySQL instance and returns the one matched the JMESPath expression.
+func listGaussDBMysqlInstanceSubResources(cfg *config.Config, instanceID, subPath,
+	expression string) (interface{}, error) {
+	client, err := cfg.NewServiceClient("gaussdb", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating GaussDB Client: %s", err)
+	}
+
+	listPath := client.Endpoint + "v3/{project_id}/instances/{instance_id}/" + subPath
+	listPath = strings.ReplaceAll(listPath, "{project_id}", client.ProjectID)
+	listPath = strings.ReplaceAll(listPath, "{instance_id}", instanceID)
+	listOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	listResp, err := client.Request("GET", listPath, &listOpt)
+	if err != nil {
+		return nil, err
+	}
+	listRespBody, err := utils.FlattenResponse(listResp)
+	if err != nil {
+		return nil, err
+	}
+
+	result := utils.PathSearch(expression, listRespBody, nil)
+	if result == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return result, nil
+}
+
+func getGaussDBMysqlDatabaseResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return listGaussDBMysqlInstanceSubResources(cfg, state.Primary.Attributes["instance_id"],
+		"databases?limit=100", fmt.Sprintf("databases[?name=='%s']|[0]", state.Primary.Attributes["name"]))
+}
+
+func TestAccGaussDBMysqlDatabase_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_gaussdb_mysql_database.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getGaussDBMysqlDatabaseResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testGaussDBMysqlDatabase_basic(name, "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id",
+						"huaweicloud_gaussdb_mysql_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "name", "test_db"),
+					resource.TestCheckResourceAttr(rName, "character_set", "utf8mb4"),
+					resource.TestCheckResourceAttr(rName, "description", "test description"),
+				),
+			},
+			{
+				Config: testGaussDBMysqlDatabase_basic(name, "test description update"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "description", "test description update"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGaussDBMysqlInstance_base(name string) string {
+	return fmt.Sprintf(`
+data "huaweicloud_networking_secgroup" "test" {
+  name = "default"
+}
+
+resource "huaweicloud_vpc" "test" {
+  name = "%[1]s"
+  cidr = "192.168.0.0/16"
+}
+
+resource "huaweicloud_vpc_subnet" "test" {
+  name       = "%[1]s"
+  vpc_id     = huaweicloud_vpc.test.id
+  gateway_ip = "192.168.0.1"
+  cidr       = "192.168.0.0/24"
+}
+
+resource "huaweicloud_gaussdb_mysql_instance" "test" {
+  name                  = "%[1]s"
+  password              = "Test@12345678"
+  flavor                = "gaussdb.mysql.2xlarge.x86.4"
+  vpc_id                = huaweicloud_vpc.test.id
+  subnet_id             = huaweicloud_vpc_subnet.test.id
+  security_group_id     = data.huaweicloud_networking_secgroup.test.id
+  sql_filter_enabled    = true
+  enterprise_project_id = "0"
+}
+`, name)
+}
+
+func testGaussDBMysqlDatabase_basic(name, description string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_gaussdb_mysql_database" "test" {
+  instance_id   = huaweicloud_gaussdb_mysql_instance.test.id
+  name          = "test_db"
+  character_set = "utf8mb4"
+  description   = "%[2]s"
+}
+`, testAccGaussDBMysqlInstance_base(name), description)
+}
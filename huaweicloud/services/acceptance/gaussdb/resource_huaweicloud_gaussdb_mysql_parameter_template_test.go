@@ -0,0 +1,100 @@
+package gaussdb
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getGaussDBMysqlTemplateResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("gaussdb", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating GaussDB Client: %s", err)
+	}
+
+	getTemplatePath := client.Endpoint + "v3/{project_id}/configurations/{configuration_id}"
+	getTemplatePath = strings.ReplaceAll(getTemplatePath, "{project_id}", client.ProjectID)
+	getTemplatePath = strings.ReplaceAll(getTemplatePath, "{configuration_id}", state.Primary.ID)
+	getTemplateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getTemplateResp, err := client.Request("GET", getTemplatePath, &getTemplateOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving GaussDB MySQL parameter template: %s", err)
+	}
+	return utils.FlattenResponse(getTemplateResp)
+}
+
+func TestAccGaussDBMysqlTemplate_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_gaussdb_mysql_parameter_template.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getGaussDBMysqlTemplateResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testGaussDBMysqlTemplate_basic(name, "test description", 4),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "description", "test description"),
+					resource.TestCheckResourceAttr(rName, "datastore_engine", "gaussdb-mysql"),
+					resource.TestCheckResourceAttr(rName, "datastore_version", "8.0"),
+					resource.TestCheckResourceAttr(rName, "parameter_values.auto_increment_increment", "4"),
+					resource.TestCheckResourceAttrSet(rName, "created_at"),
+				),
+			},
+			{
+				Config: testGaussDBMysqlTemplate_basic(name+"-update", "", 8),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", name+"-update"),
+					resource.TestCheckResourceAttr(rName, "description", ""),
+					resource.TestCheckResourceAttr(rName, "parameter_values.auto_increment_increment", "8"),
+				),
+			},
+			{
+				ResourceName:            rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parameter_values"},
+			},
+		},
+	})
+}
+
+func testGaussDBMysqlTemplate_basic(name, description string, increment int) string {
+	return fmt.Sprintf(`
+resource "huaweicloud_gaussdb_mysql_parameter_template" "test" {
+  name        = "%s"
+  description = "%s"
+
+  parameter_values = {
+    auto_increment_increment = "%d"
+  }
+}
+`, name, description, increment)
+}
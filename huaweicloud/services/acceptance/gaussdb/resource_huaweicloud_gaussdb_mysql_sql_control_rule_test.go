@@ -0,0 +1,81 @@
+package gaussdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getGaussDBMysqlSqlControlRuleResourceFunc(cfg *config.Config,
+	state *terraform.ResourceState) (interface{}, error) {
+	attributes := state.Primary.Attributes
+	return listGaussDBMysqlInstanceSubResources(cfg, attributes["instance_id"],
+		fmt.Sprintf("sql-filter/rules?node_id=%s&sql_type=%s", attributes["node_id"], attributes["sql_type"]),
+		fmt.Sprintf("sql_filter_rules[?sql_type=='%s']|[0].patterns[?pattern=='%s']|[0]", attributes["sql_type"],
+			attributes["pattern"]))
+}
+
+func TestAccGaussDBMysqlSqlControlRule_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_gaussdb_mysql_sql_control_rule.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getGaussDBMysqlSqlControlRuleResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testGaussDBMysqlSqlControlRule_basic(name, 20),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "node_id",
+						"huaweicloud_gaussdb_mysql_instance.test", "nodes.0.id"),
+					resource.TestCheckResourceAttr(rName, "sql_type", "SELECT"),
+					resource.TestCheckResourceAttr(rName, "pattern", "select~from~t1"),
+					resource.TestCheckResourceAttr(rName, "max_concurrency", "20"),
+				),
+			},
+			{
+				Config: testGaussDBMysqlSqlControlRule_basic(name, 30),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "max_concurrency", "30"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testGaussDBMysqlSqlControlRule_basic(name string, maxConcurrency int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_gaussdb_mysql_sql_control_rule" "test" {
+  instance_id     = huaweicloud_gaussdb_mysql_instance.test.id
+  node_id         = huaweicloud_gaussdb_mysql_instance.test.nodes[0].id
+  sql_type        = "SELECT"
+  pattern         = "select~from~t1"
+  max_concurrency = %[2]d
+}
+`, testAccGaussDBMysqlInstance_base(name), maxConcurrency)
+}
@@ -0,0 +1,82 @@
+package gaussdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getGaussDBNoSQLAccountResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	name := state.Primary.Attributes["name"]
+	return getGeminiDBResource(cfg,
+		fmt.Sprintf("v3/{project_id}/instances/%s/db-users?name=%s", state.Primary.Attributes["instance_id"], name),
+		fmt.Sprintf("users[?name=='%s']|[0]", name))
+}
+
+func TestAccGaussDBNoSQLAccount_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_gaussdb_nosql_account.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getGaussDBNoSQLAccountResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testGaussDBNoSQLAccount_basic(name, "Test@12345678", "read"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "name", "test_user"),
+					resource.TestCheckResourceAttr(rName, "roles.#", "1"),
+					resource.TestCheckResourceAttr(rName, "roles.0.name", "read"),
+					resource.TestCheckResourceAttr(rName, "roles.0.db_name", "test_keyspace"),
+				),
+			},
+			{
+				Config: testGaussDBNoSQLAccount_basic(name, "Test@87654321", "readWrite"),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "roles.0.name", "readWrite"),
+				),
+			},
+			{
+				ResourceName:            rName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password"},
+			},
+		},
+	})
+}
+
+func testGaussDBNoSQLAccount_basic(name, password, role string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_gaussdb_nosql_account" "test" {
+  instance_id = huaweicloud_gaussdb_nosql_database.test.instance_id
+  name        = "test_user"
+  password    = "%[2]s"
+
+  roles {
+    name    = "%[3]s"
+    db_name = huaweicloud_gaussdb_nosql_database.test.name
+  }
+}
+`, testGaussDBNoSQLDatabase_basic(name), password, role)
+}
@@ -0,0 +1,103 @@
+package gaussdb
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// getGeminiDBResource sends a GET request of the GeminiDB and returns the object matched the JMESPath expression.
+func getGeminiDBResource(cfg *config.Config, path, expression string) (interface{}, error) {
+	client, err := cfg.GeminiDBV3Client(acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating GeminiDB client: %s", err)
+	}
+
+	getPath := client.Endpoint + path
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return nil, err
+	}
+
+	result := utils.PathSearch(expression, getRespBody, nil)
+	if result == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return result, nil
+}
+
+func getGaussDBNoSQLBackupResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getGeminiDBResource(cfg, fmt.Sprintf("v3/{project_id}/backups?backup_id=%s", state.Primary.ID),
+		"backups|[0]")
+}
+
+func TestAccGaussDBNoSQLBackup_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_gaussdb_nosql_backup.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getGaussDBNoSQLBackupResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testGaussDBNoSQLBackup_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id",
+						"huaweicloud_gaussdb_cassandra_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "name", name),
+					resource.TestCheckResourceAttr(rName, "description", "created by acc test"),
+					resource.TestCheckResourceAttrSet(rName, "status"),
+					resource.TestCheckResourceAttrSet(rName, "begin_time"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testGaussDBNoSQLBackup_basic(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_gaussdb_nosql_backup" "test" {
+  instance_id = huaweicloud_gaussdb_cassandra_instance.test.id
+  name        = "%[2]s"
+  description = "created by acc test"
+}
+`, testAccGeminiDBInstanceConfig_basic(name), name)
+}
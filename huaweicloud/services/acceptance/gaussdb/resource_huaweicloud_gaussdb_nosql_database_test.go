@@ -0,0 +1,66 @@
+package gaussdb
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func getGaussDBNoSQLDatabaseResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	return getGeminiDBResource(cfg,
+		fmt.Sprintf("v3/{project_id}/instances/%s/databases?limit=100", state.Primary.Attributes["instance_id"]),
+		fmt.Sprintf("databases[?name=='%s']|[0]", state.Primary.Attributes["name"]))
+}
+
+func TestAccGaussDBNoSQLDatabase_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_gaussdb_nosql_database.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getGaussDBNoSQLDatabaseResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testGaussDBNoSQLDatabase_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id",
+						"huaweicloud_gaussdb_cassandra_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "name", "test_keyspace"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testGaussDBNoSQLDatabase_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_gaussdb_nosql_database" "test" {
+  instance_id = huaweicloud_gaussdb_cassandra_instance.test.id
+  name        = "test_keyspace"
+}
+`, testAccGeminiDBInstanceConfig_basic(name))
+}
@@ -2,6 +2,7 @@ package gaussdb
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
@@ -78,6 +79,10 @@ func TestAccOpenGaussInstance_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "backup_strategy.0.keep_days", "8"),
 				),
 			},
+			{
+				Config:      testAccOpenGaussInstance_basic(rName, newPassword),
+				ExpectError: regexp.MustCompile("shrinking sharding_num from 2 to 1 is not supported"),
+			},
 		},
 	})
 }
@@ -1235,3 +1235,141 @@ resource "huaweicloud_mapreduce_cluster" "test" {
   }
 }`, testAccMrsMapReduceClusterConfig_base(rName), bName, rName, pwd, pwd)
 }
+
+func TestAccMrsMapReduceCluster_autoScaling(t *testing.T) {
+	var clusterGet cluster.Cluster
+	resourceName := "huaweicloud_mapreduce_cluster.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", acctest.RandString(5))
+	password := fmt.Sprintf("TF%s%s%d", acctest.RandString(10), acctest.RandStringFromCharSet(1, "-_"),
+		acctest.RandIntRange(0, 99))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckMRSV2ClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMrsMapReduceClusterConfig_autoScaling(rName, password, 1, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMRSV2ClusterExists(resourceName, &clusterGet),
+					resource.TestCheckResourceAttr(resourceName, "status", "running"),
+					resource.TestCheckResourceAttr(resourceName,
+						"analysis_task_nodes.0.auto_scaling_policy.0.auto_scaling_enable", "true"),
+					resource.TestCheckResourceAttr(resourceName,
+						"analysis_task_nodes.0.auto_scaling_policy.0.max_capacity", "3"),
+					resource.TestCheckResourceAttr(resourceName,
+						"analysis_task_nodes.0.auto_scaling_policy.0.rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "bootstrap_scripts.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bootstrap_scripts.0.fail_action", "continue"),
+				),
+			},
+			{
+				Config: testAccMrsMapReduceClusterConfig_autoScaling(rName, password, 2, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMRSV2ClusterExists(resourceName, &clusterGet),
+					resource.TestCheckResourceAttr(resourceName, "status", "running"),
+					resource.TestCheckResourceAttr(resourceName,
+						"analysis_task_nodes.0.auto_scaling_policy.0.min_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName,
+						"analysis_task_nodes.0.auto_scaling_policy.0.max_capacity", "5"),
+					resource.TestCheckResourceAttr(resourceName,
+						"analysis_task_nodes.0.auto_scaling_policy.0.resources_plans.0.max_capacity", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMrsMapReduceClusterConfig_autoScaling(rName, pwd string, minCapacity, maxCapacity int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_mapreduce_cluster" "test" {
+  availability_zone  = data.huaweicloud_availability_zones.test.names[0]
+  name               = "%[2]s"
+  type               = "ANALYSIS"
+  version            = "MRS 1.9.2"
+  manager_admin_pass = "%[3]s"
+  node_admin_pass    = "%[3]s"
+  subnet_id          = huaweicloud_vpc_subnet.test.id
+  vpc_id             = huaweicloud_vpc.test.id
+  component_list     = ["Hadoop", "Hive", "Tez"]
+
+  master_nodes {
+    flavor            = "c6.2xlarge.4.linux.bigdata"
+    node_number       = 2
+    root_volume_type  = "SAS"
+    root_volume_size  = 600
+    data_volume_type  = "SAS"
+    data_volume_size  = 600
+    data_volume_count = 1
+  }
+  analysis_core_nodes {
+    flavor            = "c6.2xlarge.4.linux.bigdata"
+    node_number       = 2
+    root_volume_type  = "SAS"
+    root_volume_size  = 600
+    data_volume_type  = "SAS"
+    data_volume_size  = 600
+    data_volume_count = 1
+  }
+  analysis_task_nodes {
+    flavor            = "c6.2xlarge.4.linux.bigdata"
+    node_number       = 1
+    root_volume_type  = "SAS"
+    root_volume_size  = 600
+    data_volume_type  = "SAS"
+    data_volume_size  = 600
+    data_volume_count = 1
+
+    auto_scaling_policy {
+      auto_scaling_enable = true
+      min_capacity        = %[4]d
+      max_capacity        = %[5]d
+
+      resources_plans {
+        start_time   = "09:00"
+        end_time     = "18:00"
+        min_capacity = %[4]d
+        max_capacity = %[5]d
+      }
+
+      rules {
+        name               = "scale_out_on_memory"
+        adjustment_type    = "scale_out"
+        cool_down_minutes  = 20
+        scaling_adjustment = 1
+
+        trigger {
+          metric_name         = "YARNMemoryAvailablePercentage"
+          metric_value        = "25"
+          comparison_operator = "LT"
+          evaluation_periods  = 3
+        }
+      }
+      rules {
+        name               = "scale_in_on_memory"
+        adjustment_type    = "scale_in"
+        cool_down_minutes  = 20
+        scaling_adjustment = 1
+
+        trigger {
+          metric_name         = "YARNMemoryAvailablePercentage"
+          metric_value        = "70"
+          comparison_operator = "GT"
+          evaluation_periods  = 3
+        }
+      }
+    }
+  }
+
+  bootstrap_scripts {
+    name                   = "install_tools"
+    uri                    = "s3a://bootstrap/presto/presto-install.sh"
+    parameters             = "dualroles"
+    nodes                  = ["master_node_default_group", "core_node_analysis_group"]
+    fail_action            = "continue"
+    before_component_start = true
+  }
+}`, testAccMrsMapReduceClusterConfig_base(rName), rName, pwd, minCapacity, maxCapacity)
+}
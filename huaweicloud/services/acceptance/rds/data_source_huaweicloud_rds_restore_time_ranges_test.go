@@ -0,0 +1,46 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDatasourceRestoreTimeRanges_basic(t *testing.T) {
+	rName := "data.huaweicloud_rds_restore_time_ranges.test"
+	dc := acceptance.InitDataSourceCheck(rName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatasourceRestoreTimeRanges_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					dc.CheckResourceExists(),
+					resource.TestCheckResourceAttrSet(rName, "restore_time.#"),
+					resource.TestCheckResourceAttrSet(rName, "restore_time.0.start_time"),
+					resource.TestCheckResourceAttrSet(rName, "restore_time.0.end_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDatasourceRestoreTimeRanges_basic() string {
+	backupConfig := testBackup_basic(acceptance.RandomAccResourceName())
+	return fmt.Sprintf(`
+%s
+
+data "huaweicloud_rds_restore_time_ranges" "test" {
+  instance_id = huaweicloud_rds_instance.test.id
+
+  depends_on = [
+    huaweicloud_rds_backup.test
+  ]
+}
+`, backupConfig)
+}
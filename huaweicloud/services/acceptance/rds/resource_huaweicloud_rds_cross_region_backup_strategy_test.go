@@ -0,0 +1,110 @@
+package rds
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getCrossRegionBackupStrategyResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("rds", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RDS Client: %s", err)
+	}
+
+	getStrategyPath := client.Endpoint + "v3/{project_id}/instances/{instance_id}/backups/offsite-policy"
+	getStrategyPath = strings.ReplaceAll(getStrategyPath, "{project_id}", client.ProjectID)
+	getStrategyPath = strings.ReplaceAll(getStrategyPath, "{instance_id}", state.Primary.ID)
+	getStrategyOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getStrategyResp, err := client.Request("GET", getStrategyPath, &getStrategyOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving RDS cross-region backup strategy: %s", err)
+	}
+
+	getStrategyRespBody, err := utils.FlattenResponse(getStrategyResp)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := utils.PathSearch("policy_para[?keep_days > `0`] | [0]", getStrategyRespBody, nil)
+	if policy == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return policy, nil
+}
+
+func TestAccCrossRegionBackupStrategy_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_rds_cross_region_backup_strategy.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getCrossRegionBackupStrategyResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckReplication(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testCrossRegionBackupStrategy_basic(name, "auto", 5),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id", "huaweicloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "backup_type", "auto"),
+					resource.TestCheckResourceAttr(rName, "keep_days", "5"),
+					resource.TestCheckResourceAttr(rName, "destination_region", acceptance.HW_DEST_REGION),
+					resource.TestCheckResourceAttr(rName, "destination_project_id", acceptance.HW_DEST_PROJECT_ID),
+				),
+			},
+			{
+				Config: testCrossRegionBackupStrategy_basic(name, "all", 8),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "backup_type", "all"),
+					resource.TestCheckResourceAttr(rName, "keep_days", "8"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCrossRegionBackupStrategy_basic(name, backupType string, keepDays int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_cross_region_backup_strategy" "test" {
+  instance_id            = huaweicloud_rds_instance.test.id
+  backup_type            = "%s"
+  keep_days              = %d
+  destination_region     = "%s"
+  destination_project_id = "%s"
+}
+`, testAccRdsInstance_basic(name, "Huangwei!120521"), backupType, keepDays,
+		acceptance.HW_DEST_REGION, acceptance.HW_DEST_PROJECT_ID)
+}
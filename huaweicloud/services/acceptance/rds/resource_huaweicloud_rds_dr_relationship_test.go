@@ -0,0 +1,152 @@
+package rds
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getDrRelationshipResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("rds", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RDS Client: %s", err)
+	}
+
+	instanceID := state.Primary.Attributes["instance_id"]
+	drInstanceID := state.Primary.Attributes["dr_instance_id"]
+	getDrInfosPath := client.Endpoint + "v3/{project_id}/instances/{instance_id}/disaster-recovery-infos"
+	getDrInfosPath = strings.ReplaceAll(getDrInfosPath, "{project_id}", client.ProjectID)
+	getDrInfosPath = strings.ReplaceAll(getDrInfosPath, "{instance_id}", instanceID)
+	getDrInfosOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getDrInfosResp, err := client.Request("GET", getDrInfosPath, &getDrInfosOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving RDS DR relationship: %s", err)
+	}
+
+	getDrInfosRespBody, err := utils.FlattenResponse(getDrInfosResp)
+	if err != nil {
+		return nil, err
+	}
+
+	drInfo := utils.PathSearch(fmt.Sprintf("instance_dr_infos[?(master_instance_id=='%[1]s'&&slave_instance_id=='%[2]s')"+
+		"||(master_instance_id=='%[2]s'&&slave_instance_id=='%[1]s')] | [0]", instanceID, drInstanceID),
+		getDrInfosRespBody, nil)
+	if drInfo == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return drInfo, nil
+}
+
+func TestAccDrRelationship_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_rds_dr_relationship.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getDrRelationshipResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckReplication(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testDrRelationship_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id", "huaweicloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(rName, "dr_instance_id", "huaweicloud_rds_instance.dr", "id"),
+					resource.TestCheckResourceAttrPair(rName, "master_instance_id", "huaweicloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "dr_region", acceptance.HW_DEST_REGION),
+					resource.TestCheckResourceAttrSet(rName, "status"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testDrRelationship_base(name string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+data "huaweicloud_availability_zones" "dr" {
+  region = "%[2]s"
+}
+
+resource "huaweicloud_vpc" "dr" {
+  region = "%[2]s"
+  name   = "%[3]s-dr"
+  cidr   = "172.16.0.0/16"
+}
+
+resource "huaweicloud_vpc_subnet" "dr" {
+  region     = "%[2]s"
+  name       = "%[3]s-dr"
+  cidr       = "172.16.0.0/24"
+  gateway_ip = "172.16.0.1"
+  vpc_id     = huaweicloud_vpc.dr.id
+}
+
+resource "huaweicloud_networking_secgroup" "dr" {
+  region = "%[2]s"
+  name   = "%[3]s-dr"
+}
+
+resource "huaweicloud_rds_instance" "dr" {
+  region            = "%[2]s"
+  name              = "%[3]s-dr"
+  flavor            = "rds.pg.n1.large.2"
+  availability_zone = [data.huaweicloud_availability_zones.dr.names[0]]
+  security_group_id = huaweicloud_networking_secgroup.dr.id
+  subnet_id         = huaweicloud_vpc_subnet.dr.id
+  vpc_id            = huaweicloud_vpc.dr.id
+
+  db {
+    password = "Huangwei!120521"
+    type     = "PostgreSQL"
+    version  = "12"
+  }
+  volume {
+    type = "CLOUDSSD"
+    size = 50
+  }
+}
+`, testAccRdsInstance_basic(name, "Huangwei!120521"), acceptance.HW_DEST_REGION, name)
+}
+
+func testDrRelationship_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_dr_relationship" "test" {
+  instance_id    = huaweicloud_rds_instance.test.id
+  dr_region      = "%s"
+  dr_instance_id = huaweicloud_rds_instance.dr.id
+}
+`, testDrRelationship_base(name), acceptance.HW_DEST_REGION)
+}
@@ -0,0 +1,49 @@
+package rds
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+)
+
+func TestAccDrSwitchover_basic(t *testing.T) {
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_rds_dr_relationship.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+			acceptance.TestAccPreCheckReplication(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testDrSwitchover_basic(name),
+			},
+			{
+				// refresh the relationship to check the roles exchanged after the switchover
+				Config: testDrSwitchover_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(rName, "master_instance_id", "huaweicloud_rds_instance.dr", "id"),
+					resource.TestCheckResourceAttr("huaweicloud_rds_dr_switchover.test", "type", "switchover"),
+				),
+			},
+		},
+	})
+}
+
+func testDrSwitchover_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_dr_switchover" "test" {
+  region      = "%s"
+  instance_id = huaweicloud_rds_instance.dr.id
+
+  depends_on = [huaweicloud_rds_dr_relationship.test]
+}
+`, testDrRelationship_basic(name), acceptance.HW_DEST_REGION)
+}
@@ -172,6 +172,72 @@ func TestAccRdsInstance_mysql(t *testing.T) {
 	})
 }
 
+func TestAccRdsInstance_restore(t *testing.T) {
+	var instance instances.RdsInstanceResponse
+	name := acceptance.RandomAccResourceName()
+	resourceType := "huaweicloud_rds_instance"
+	resourceName := "huaweicloud_rds_instance.restore"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckRdsInstanceDestroy(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsInstance_restore(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "name", name+"-restore"),
+					resource.TestCheckResourceAttr(resourceName, "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(resourceName, "volume.0.size", "50"),
+					resource.TestCheckResourceAttrPair(resourceName, "restore.0.instance_id",
+						"huaweicloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "restore.0.backup_id",
+						"huaweicloud_rds_backup.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRdsInstance_mysqlConfigurations(t *testing.T) {
+	var instance instances.RdsInstanceResponse
+	name := acceptance.RandomAccResourceName()
+	resourceType := "huaweicloud_rds_instance"
+	resourceName := "huaweicloud_rds_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acceptance.TestAccPreCheck(t) },
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      testAccCheckRdsInstanceDestroy(resourceType),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRdsInstance_mysqlConfigurations(name, "semisync", "UTC+08:00", 5, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "ha_replication_mode", "semisync"),
+					resource.TestCheckResourceAttr(resourceName, "time_zone", "UTC+08:00"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "restart_required", "false"),
+					resource.TestCheckResourceAttr(resourceName, "sql_audit.0.keep_days", "7"),
+					resource.TestCheckResourceAttr(resourceName, "lts_configs.#", "2"),
+				),
+			},
+			{
+				Config: testAccRdsInstance_mysqlConfigurations(name, "async", "UTC+09:00", 10, 14),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdsInstanceExists(resourceName, &instance),
+					resource.TestCheckResourceAttr(resourceName, "ha_replication_mode", "async"),
+					resource.TestCheckResourceAttr(resourceName, "time_zone", "UTC+09:00"),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "restart_required", "false"),
+					resource.TestCheckResourceAttr(resourceName, "sql_audit.0.keep_days", "14"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRdsInstance_sqlserver(t *testing.T) {
 	var instance instances.RdsInstanceResponse
 	name := acceptance.RandomAccResourceName()
@@ -609,3 +675,106 @@ resource "huaweicloud_rds_instance" "test" {
 }
 `, testAccRdsInstance_base(name), name, pwd, isAutoRenew)
 }
+
+func testAccRdsInstance_restore(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_instance" "restore" {
+  name              = "%s-restore"
+  flavor            = "rds.pg.n1.large.2"
+  availability_zone = [data.huaweicloud_availability_zones.test.names[0]]
+  security_group_id = huaweicloud_networking_secgroup.test.id
+  subnet_id         = huaweicloud_vpc_subnet.test.id
+  vpc_id            = huaweicloud_vpc.test.id
+
+  db {
+    password = "Huangwei!120521"
+    type     = "PostgreSQL"
+    version  = "12"
+  }
+  volume {
+    type = "CLOUDSSD"
+    size = 50
+  }
+
+  restore {
+    instance_id = huaweicloud_rds_instance.test.id
+    backup_id   = huaweicloud_rds_backup.test.id
+  }
+}
+`, testBackup_basic(name), name)
+}
+
+func testAccRdsInstance_mysqlConfigurations(name, replicationMode, timeZone string, lockWaitTimeout,
+	auditKeepDays int) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "huaweicloud_lts_group" "test" {
+  group_name  = "%[2]s"
+  ttl_in_days = 1
+}
+
+resource "huaweicloud_lts_stream" "error_log" {
+  group_id    = huaweicloud_lts_group.test.id
+  stream_name = "%[2]s-error"
+}
+
+resource "huaweicloud_lts_stream" "slow_log" {
+  group_id    = huaweicloud_lts_group.test.id
+  stream_name = "%[2]s-slow"
+}
+
+resource "huaweicloud_rds_instance" "test" {
+  name                   = "%[2]s"
+  flavor                 = "rds.mysql.sld4.large.ha"
+  security_group_id      = huaweicloud_networking_secgroup.test.id
+  subnet_id              = huaweicloud_vpc_subnet.test.id
+  vpc_id                 = huaweicloud_vpc.test.id
+  ha_replication_mode    = "%[3]s"
+  time_zone              = "%[4]s"
+  restart_for_parameters = true
+
+  availability_zone = [
+    data.huaweicloud_availability_zones.test.names[0],
+    data.huaweicloud_availability_zones.test.names[3],
+  ]
+
+  db {
+    password = "Huangwei!120521"
+    type     = "MySQL"
+    version  = "5.7"
+  }
+
+  volume {
+    type = "LOCALSSD"
+    size = 40
+  }
+
+  parameters {
+    name  = "innodb_lock_wait_timeout"
+    value = "%[5]d"
+  }
+  parameters {
+    name  = "connect_timeout"
+    value = "10"
+  }
+
+  sql_audit {
+    keep_days = %[6]d
+  }
+
+  lts_configs {
+    log_type      = "error_log"
+    lts_group_id  = huaweicloud_lts_group.test.id
+    lts_stream_id = huaweicloud_lts_stream.error_log.id
+  }
+  lts_configs {
+    log_type      = "slow_log"
+    lts_group_id  = huaweicloud_lts_group.test.id
+    lts_stream_id = huaweicloud_lts_stream.slow_log.id
+  }
+}
+`, testAccRdsInstance_base(name), name, replicationMode, timeZone, lockWaitTimeout, auditKeepDays)
+}
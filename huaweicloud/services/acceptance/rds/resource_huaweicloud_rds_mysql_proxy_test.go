@@ -0,0 +1,179 @@
+package rds
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/services/acceptance"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func getMysqlProxyResourceFunc(cfg *config.Config, state *terraform.ResourceState) (interface{}, error) {
+	client, err := cfg.NewServiceClient("rds", acceptance.HW_REGION_NAME)
+	if err != nil {
+		return nil, fmt.Errorf("error creating RDS Client: %s", err)
+	}
+
+	getProxyPath := client.Endpoint + "v3/{project_id}/instances/{instance_id}/proxy"
+	getProxyPath = strings.ReplaceAll(getProxyPath, "{project_id}", client.ProjectID)
+	getProxyPath = strings.ReplaceAll(getProxyPath, "{instance_id}", state.Primary.ID)
+	getProxyOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getProxyResp, err := client.Request("GET", getProxyPath, &getProxyOpt)
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving RDS MySQL proxy: %s", err)
+	}
+
+	getProxyRespBody, err := utils.FlattenResponse(getProxyResp)
+	if err != nil {
+		return nil, err
+	}
+
+	status := utils.PathSearch("proxy.status", getProxyRespBody, "").(string)
+	if status == "" || status == "shutdown" {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return getProxyRespBody, nil
+}
+
+func TestAccMysqlProxy_basic(t *testing.T) {
+	var obj interface{}
+
+	name := acceptance.RandomAccResourceName()
+	rName := "huaweicloud_rds_mysql_proxy.test"
+
+	rc := acceptance.InitResourceCheck(
+		rName,
+		&obj,
+		getMysqlProxyResourceFunc,
+	)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acceptance.TestAccPreCheck(t)
+		},
+		ProviderFactories: acceptance.TestAccProviderFactories,
+		CheckDestroy:      rc.CheckResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testMysqlProxy_basic(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttrPair(rName, "instance_id", "huaweicloud_rds_instance.test", "id"),
+					resource.TestCheckResourceAttr(rName, "flavor", "rds.proxy.large.2"),
+					resource.TestCheckResourceAttr(rName, "node_num", "2"),
+					resource.TestCheckResourceAttr(rName, "master_node_weight", "50"),
+					resource.TestCheckResourceAttr(rName, "readonly_nodes_weight.#", "1"),
+					resource.TestCheckResourceAttr(rName, "readonly_nodes_weight.0.weight", "50"),
+					resource.TestCheckResourceAttrSet(rName, "address"),
+					resource.TestCheckResourceAttrSet(rName, "port"),
+				),
+			},
+			{
+				Config: testMysqlProxy_update(name),
+				Check: resource.ComposeTestCheckFunc(
+					rc.CheckResourceExists(),
+					resource.TestCheckResourceAttr(rName, "node_num", "3"),
+					resource.TestCheckResourceAttr(rName, "delay_threshold_in_seconds", "60"),
+					resource.TestCheckResourceAttr(rName, "connection_pool_type", "SESSION"),
+					resource.TestCheckResourceAttr(rName, "master_node_weight", "20"),
+					resource.TestCheckResourceAttr(rName, "readonly_nodes_weight.0.weight", "80"),
+				),
+			},
+			{
+				ResourceName:      rName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testMysqlProxy_base(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_instance" "test" {
+  name                = "%[2]s"
+  flavor              = "rds.mysql.n1.large.2.ha"
+  ha_replication_mode = "async"
+  availability_zone   = [
+    data.huaweicloud_availability_zones.test.names[0],
+    data.huaweicloud_availability_zones.test.names[1],
+  ]
+  security_group_id   = huaweicloud_networking_secgroup.test.id
+  vpc_id              = huaweicloud_vpc.test.id
+  subnet_id           = huaweicloud_vpc_subnet.test.id
+
+  db {
+    password = "Huangwei!120521"
+    type     = "MySQL"
+    version  = "8.0"
+    port     = 3306
+  }
+  volume {
+    type = "CLOUDSSD"
+    size = 50
+  }
+}
+
+resource "huaweicloud_rds_read_replica_instance" "test" {
+  name                = "%[2]s-replica"
+  flavor              = "rds.mysql.n1.large.2.rr"
+  primary_instance_id = huaweicloud_rds_instance.test.id
+  availability_zone   = data.huaweicloud_availability_zones.test.names[0]
+
+  volume {
+    type = "CLOUDSSD"
+  }
+}
+`, testAccRdsInstance_base(name), name)
+}
+
+func testMysqlProxy_basic(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_mysql_proxy" "test" {
+  instance_id        = huaweicloud_rds_instance.test.id
+  flavor             = "rds.proxy.large.2"
+  node_num           = 2
+  master_node_weight = 50
+
+  readonly_nodes_weight {
+    id     = huaweicloud_rds_read_replica_instance.test.id
+    weight = 50
+  }
+}
+`, testMysqlProxy_base(name))
+}
+
+func testMysqlProxy_update(name string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "huaweicloud_rds_mysql_proxy" "test" {
+  instance_id                = huaweicloud_rds_instance.test.id
+  flavor                     = "rds.proxy.large.2"
+  node_num                   = 3
+  delay_threshold_in_seconds = 60
+  connection_pool_type       = "SESSION"
+  master_node_weight         = 20
+
+  readonly_nodes_weight {
+    id     = huaweicloud_rds_read_replica_instance.test.id
+    weight = 80
+  }
+}
+`, testMysqlProxy_base(name))
+}
@@ -40,7 +40,7 @@ func TestAccReadReplicaInstance_basic(t *testing.T) {
 					testAccCheckRdsInstanceExists(resourceName, &replica),
 					resource.TestCheckResourceAttr(resourceName, "flavor", "rds.pg.n1.xlarge.2.rr"),
 					resource.TestCheckResourceAttr(resourceName, "volume.0.type", "CLOUDSSD"),
-					resource.TestCheckResourceAttr(resourceName, "volume.0.size", "50"),
+					resource.TestCheckResourceAttr(resourceName, "volume.0.size", "60"),
 					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value"),
 					resource.TestCheckResourceAttr(resourceName, "tags.foo", "bar2"),
 				),
@@ -141,7 +141,8 @@ resource "huaweicloud_rds_read_replica_instance" "test" {
   availability_zone   = data.huaweicloud_availability_zones.test.names[0]
 
   volume {
-	type = "CLOUDSSD"
+    type = "CLOUDSSD"
+    size = 60
   }
 
   tags = {
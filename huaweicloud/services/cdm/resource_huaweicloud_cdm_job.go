@@ -201,6 +201,36 @@ func ResourceCdmJob() *schema.Resource {
 				},
 			},
 
+			"incremental_migration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"where_clause": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"time_filter_start": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"time_filter_end": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+
+			"auto_start": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -226,6 +256,7 @@ func resourceCdmJobCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	buildIncrementalConfigParamter(d, fromConfig)
 
 	toConfig, err := buildConfigParamter(d, "destination_job_config", toJobConfig)
 	if err != nil {
@@ -257,9 +288,11 @@ func resourceCdmJobCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.SetId(fmt.Sprintf("%s/%s", clusterId, rst.Name))
 
-	checkErr := waitingforJobRunning(ctx, client, clusterId, rst.Name, d.Timeout(schema.TimeoutCreate))
-	if checkErr != nil {
-		return diag.FromErr(checkErr)
+	if d.Get("auto_start").(bool) {
+		checkErr := waitingforJobRunning(ctx, client, clusterId, rst.Name, d.Timeout(schema.TimeoutCreate))
+		if checkErr != nil {
+			return diag.FromErr(checkErr)
+		}
 	}
 	return resourceCdmJobRead(ctx, d, meta)
 }
@@ -290,6 +323,8 @@ func resourceCdmJobRead(_ context.Context, d *schema.ResourceData, meta interfac
 	}
 
 	detail := rst.Jobs[0]
+	sourceJobConfig := flattenFromOrToConfig(fromJobConfig, detail.FromConfigValues.Configs)
+	incrementalMigration := flattenIncrementalConfig(d, sourceJobConfig)
 	mErr := multierror.Append(
 		d.Set("region", region),
 		d.Set("name", detail.Name),
@@ -297,10 +332,11 @@ func resourceCdmJobRead(_ context.Context, d *schema.ResourceData, meta interfac
 		d.Set("job_type", detail.JobType),
 		d.Set("source_connector", detail.FromConnectorName),
 		d.Set("source_link_name", detail.FromLinkName),
-		d.Set("source_job_config", flattenFromOrToConfig(fromJobConfig, detail.FromConfigValues.Configs)),
+		d.Set("source_job_config", sourceJobConfig),
 		d.Set("destination_connector", detail.ToConnectorName),
 		d.Set("destination_link_name", detail.ToLinkName),
 		d.Set("destination_job_config", flattenFromOrToConfig(toJobConfig, detail.ToConfigValues.Configs)),
+		d.Set("incremental_migration", incrementalMigration),
 		setConfigtoState(d, detail.DriverConfigValues.Configs),
 		d.Set("status", detail.Status),
 	)
@@ -330,7 +366,7 @@ func resourceCdmJobUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		return common.CheckDeletedDiag(d, parseCdmJobErrorToError404(gErr), "Error retrieving CDM job")
 	}
 
-	if d.HasChanges("source_job_config", "destination_job_config", "config") {
+	if d.HasChanges("source_job_config", "destination_job_config", "config", "incremental_migration") {
 		status := rst.Jobs[0].Status
 		// shutdown job
 		if status == "BOOTING" || status == "RUNNING" {
@@ -344,6 +380,7 @@ func resourceCdmJobUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		buildIncrementalConfigParamter(d, fromConfig)
 
 		toConfig, err := buildConfigParamter(d, "destination_job_config", toJobConfig)
 		if err != nil {
@@ -368,13 +405,15 @@ func resourceCdmJobUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			return fmtp.DiagErrorf("Error update CDM job: %s", uErr)
 		}
 
-		checkErr := waitingforJobRunning(ctx, client, clusterId, jobName, d.Timeout(schema.TimeoutUpdate))
-		if checkErr != nil {
-			return diag.FromErr(checkErr)
+		if d.Get("auto_start").(bool) {
+			checkErr := waitingforJobRunning(ctx, client, clusterId, jobName, d.Timeout(schema.TimeoutUpdate))
+			if checkErr != nil {
+				return diag.FromErr(checkErr)
+			}
 		}
 	}
 
-	return nil
+	return resourceCdmJobRead(ctx, d, meta)
 }
 
 func resourceCdmJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -443,6 +482,70 @@ func buildConfigParamter(d *schema.ResourceData, inputConfigName, outConfigName
 	return &rst, nil
 }
 
+// incrementalConfigKeys is the mapping between the incremental_migration arguments and the source job parameters.
+var incrementalConfigKeys = map[string]string{
+	"where_clause":      "whereClause",
+	"time_filter_start": "minTime",
+	"time_filter_end":   "maxTime",
+}
+
+// buildIncrementalConfigParamter merges the incremental migration parameters into the source job configuration,
+// the parameters with the same names in the source_job_config are overridden.
+func buildIncrementalConfigParamter(d *schema.ResourceData, fromConfig *job.JobConfigs) {
+	params := make(map[string]string)
+	for argName, key := range incrementalConfigKeys {
+		if v, ok := d.GetOk("incremental_migration.0." + argName); ok {
+			params[fmt.Sprintf("%s.%s", fromJobConfig, key)] = v.(string)
+		}
+	}
+	if len(params) < 1 {
+		return
+	}
+	if isTimeFilterConfigured(d.Get("incremental_migration.0").(map[string]interface{})) {
+		params[fmt.Sprintf("%s.%s", fromJobConfig, "useTimeFilter")] = "true"
+	}
+
+	inputs := make([]job.Input, 0, len(fromConfig.Configs[0].Inputs)+len(params))
+	for _, input := range fromConfig.Configs[0].Inputs {
+		if _, ok := params[input.Name]; !ok {
+			inputs = append(inputs, input)
+		}
+	}
+	for name, value := range params {
+		inputs = append(inputs, job.Input{
+			Name:  name,
+			Value: value,
+		})
+	}
+	fromConfig.Configs[0].Inputs = inputs
+}
+
+func isTimeFilterConfigured(incrementalMigration map[string]interface{}) bool {
+	return incrementalMigration["time_filter_start"] != "" || incrementalMigration["time_filter_end"] != ""
+}
+
+// flattenIncrementalConfig moves the incremental migration parameters out of the source job configuration. The
+// parameters are moved only when incremental_migration is configured, so that the jobs which specify them in the
+// source_job_config are not affected.
+func flattenIncrementalConfig(d *schema.ResourceData, sourceJobConfig map[string]interface{}) []map[string]interface{} {
+	if _, ok := d.GetOk("incremental_migration"); !ok {
+		return nil
+	}
+
+	result := make(map[string]interface{})
+	for argName, key := range incrementalConfigKeys {
+		result[argName] = ""
+		if v, ok := sourceJobConfig[key]; ok {
+			result[argName] = v
+			delete(sourceJobConfig, key)
+		}
+	}
+	if isTimeFilterConfigured(result) {
+		delete(sourceJobConfig, "useTimeFilter")
+	}
+	return []map[string]interface{}{result}
+}
+
 func buildDriverConfigParamter(d *schema.ResourceData) job.JobConfigs {
 	throttlingConfigs := buildThrottlingConfigParamter(d)
 	schedulerConfigs := buildSchedulerConfigsParamter(d)
@@ -707,7 +810,7 @@ func waitingforJobRunning(ctx context.Context, client *golangsdk.ServiceClient,
 			return detail, detail.Status, nil
 		},
 		Timeout:      timeout,
-		PollInterval: 20 * timeout,
+		PollInterval: 20 * time.Second,
 		Delay:        20 * time.Second,
 	}
 	_, err := stateConf.WaitForStateContext(ctx)
@@ -717,6 +820,38 @@ func waitingforJobRunning(ctx context.Context, client *golangsdk.ServiceClient,
 	return nil
 }
 
+func waitingForJobStopped(ctx context.Context, client *golangsdk.ServiceClient, clusterId, jobName string,
+	timeout time.Duration) error {
+	stopResp := job.Stop(client, clusterId, jobName)
+	if stopResp.Err != nil {
+		return fmt.Errorf("error stopping CDM job: %s", stopResp.Err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{job.StatusBooting, job.StatusRunning},
+		Target:  []string{"STOPPED"},
+		Refresh: func() (interface{}, string, error) {
+			rst, err := job.Get(client, clusterId, jobName, job.GetJobsOpts{})
+			if err != nil {
+				return nil, "", err
+			}
+			detail := rst.Jobs[0]
+			if detail.Status == job.StatusBooting || detail.Status == job.StatusRunning {
+				return detail, detail.Status, nil
+			}
+			return detail, "STOPPED", nil
+		},
+		Timeout:      timeout,
+		PollInterval: 10 * time.Second,
+		Delay:        10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmt.Errorf("error waiting for CDM job (%s/%s) to be stopped: %s", clusterId, jobName, err)
+	}
+	return nil
+}
+
 func ParseJobInfoFromId(id string) (clusterId, jobName string, err error) {
 	idArrays := strings.SplitN(id, "/", 2)
 	if len(idArrays) != 2 {
@@ -0,0 +1,114 @@
+package cdm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk/openstack/cdm/v1/job"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+const (
+	jobActionStart = "start"
+	jobActionStop  = "stop"
+)
+
+// ResourceCdmJobAction is the impl of huaweicloud_cdm_job_action, which starts or stops a CDM job.
+// The resource ID is in the format of <cluster_id>/<job_name>.
+func ResourceCdmJobAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCdmJobActionCreate,
+		ReadContext:   resourceCdmJobActionRead,
+		DeleteContext: resourceCdmJobActionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"job_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{jobActionStart, jobActionStop}, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCdmJobActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.CdmV11Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CDM v1.1 client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	jobName := d.Get("job_name").(string)
+	if d.Get("action").(string) == jobActionStart {
+		err = waitingforJobRunning(ctx, client, clusterId, jobName, d.Timeout(schema.TimeoutCreate))
+	} else {
+		err = waitingForJobStopped(ctx, client, clusterId, jobName, d.Timeout(schema.TimeoutCreate))
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", clusterId, jobName))
+
+	return resourceCdmJobActionRead(ctx, d, meta)
+}
+
+func resourceCdmJobActionRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.CdmV11Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CDM v1.1 client: %s", err)
+	}
+
+	rst, err := job.Get(client, d.Get("cluster_id").(string), d.Get("job_name").(string), job.GetJobsOpts{})
+	if err != nil {
+		return common.CheckDeletedDiag(d, parseCdmJobErrorToError404(err), "error retrieving CDM job")
+	}
+	if len(rst.Jobs) < 1 {
+		d.SetId("")
+		return nil
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("status", rst.Jobs[0].Status),
+	)
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceCdmJobActionDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
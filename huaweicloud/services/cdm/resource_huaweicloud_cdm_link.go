@@ -143,7 +143,8 @@ func buildLinkConfigParamter(d *schema.ResourceData) (*link.LinkConfigs, error)
 
 	if v, ok := d.GetOk("password"); ok {
 		if connector == link.GenericJdbcConnector || connector == link.HdfsConnector ||
-			connector == link.HbaseConnector || connector == link.SftpConnector ||
+			connector == link.HbaseConnector || connector == link.HiveConnector ||
+			connector == link.SftpConnector || connector == link.FtpConnector ||
 			connector == link.MongodbConnector || connector == link.ElasticsearchConnector {
 			input := link.Input{
 				Name:  fmt.Sprintf("%s%s", configPref, "password"),
@@ -169,11 +170,11 @@ func buildLinkConfigParamter(d *schema.ResourceData) (*link.LinkConfigs, error)
 			connector == link.OpentsdbConnector || connector == link.DmsKafkaConnector {
 			ak := link.Input{
 				Name:  fmt.Sprintf("%s%s", configPref, "ak"),
-				Value: d.Get("ak").(string),
+				Value: d.Get("access_key").(string),
 			}
 			sk := link.Input{
 				Name:  fmt.Sprintf("%s%s", configPref, "sk"),
-				Value: d.Get("sk").(string),
+				Value: v.(string),
 			}
 			configs = append(configs, ak, sk)
 		}
@@ -207,7 +208,7 @@ func resourceCdmLinkRead(_ context.Context, d *schema.ResourceData, meta interfa
 
 	resp, gErr := link.Get(client, clusterId, linkName)
 	if gErr != nil {
-		return common.CheckDeletedDiag(d, err, "Error retrieving CDM link")
+		return common.CheckDeletedDiag(d, gErr, "Error retrieving CDM link")
 	}
 
 	detail := resp.Links[0]
@@ -239,10 +240,10 @@ func setConfigToState(d *schema.ResourceData, configs []link.Configs) error {
 				if v.Value != "" {
 					key := strings.Replace(v.Name, configPref, "", 1)
 
-					if key == "password" {
-						d.Set("password", v.Value)
-					} else if key == "securityKey" || key == "sk" {
-						d.Set("secret_key", v.Value)
+					// The password and the secret key returned by the API are encrypted, keep the values in the
+					// state so that the credentials can be rotated in place.
+					if key == "password" || key == "securityKey" || key == "sk" {
+						continue
 					} else if key == "accessKey" || key == "ak" {
 						d.Set("access_key", v.Value)
 					} else {
@@ -0,0 +1,358 @@
+package css
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	v1 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/css/v1"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/css/v1/model"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+const (
+	cssRestartModeRolling = "rolling"
+	cssRestartModeFull    = "full"
+)
+
+// ResourceCssConfiguration is the impl for huaweicloud_css_configuration resource, which modifies the parameters of
+// the elasticsearch.yml file and restarts the cluster to apply them.
+// The parameters are not reset when the resource is destroyed, destroying the resource only removes it from the state.
+// The resource ID is the cluster ID.
+func ResourceCssConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCssConfigurationCreate,
+		ReadContext:   resourceCssConfigurationRead,
+		UpdateContext: resourceCssConfigurationUpdate,
+		DeleteContext: resourceCssConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCssConfigurationImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the CSS cluster.`,
+			},
+			"parameters": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the parameters of the elasticsearch.yml file.`,
+			},
+			"restart_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      cssRestartModeRolling,
+				ValidateFunc: validation.StringInSlice([]string{cssRestartModeRolling, cssRestartModeFull}, false),
+				Description:  `Specifies how the cluster is restarted to apply the parameters.`,
+			},
+		},
+	}
+}
+
+// restartCssCluster restarts the cluster and waits for the cluster to become available. The rolling restart restarts
+// the nodes one by one, so that the cluster keeps serving during the restart.
+func restartCssCluster(ctx context.Context, cfg *config.Config, region, clusterId, mode string,
+	timeout time.Duration) error {
+	cssV1Client, err := cfg.HcCssV1Client(region)
+	if err != nil {
+		return fmt.Errorf("error creating CSS V1 client: %s", err)
+	}
+
+	if mode == cssRestartModeRolling {
+		client, err := cfg.NewServiceClient("css", region)
+		if err != nil {
+			return fmt.Errorf("error creating CSS client: %s", err)
+		}
+
+		params := map[string]interface{}{
+			"type":  "role",
+			"value": "all",
+		}
+		restartPath := fmt.Sprintf("v1.0/{project_id}/clusters/%s/rolling_restart", clusterId)
+		if _, err = common.RequestApi(client, "POST", restartPath, nil, params); err != nil {
+			return fmt.Errorf("error restarting CSS cluster (%s) in rolling mode: %s", clusterId, err)
+		}
+	} else {
+		_, err = cssV1Client.RestartCluster(&model.RestartClusterRequest{ClusterId: clusterId})
+		if err != nil {
+			return fmt.Errorf("error restarting CSS cluster (%s): %s", clusterId, err)
+		}
+	}
+
+	return checkClusterOperationCompleted(ctx, cssV1Client, clusterId, timeout)
+}
+
+func updateCssConfigurationParameters(ctx context.Context, cssV1Client *v1.CssClient, clusterId string,
+	parameters map[string]interface{}, timeout time.Duration) error {
+	// the task list contains the tasks of the previous modifications, record the latest one to find the new task.
+	jobsResp, err := cssV1Client.ListYmlsJob(&model.ListYmlsJobRequest{ClusterId: clusterId})
+	if err != nil {
+		return fmt.Errorf("error retrieving the parameter configuration tasks of CSS cluster (%s): %s", clusterId, err)
+	}
+	var previousJobId string
+	if jobsResp.ConfigList != nil && len(*jobsResp.ConfigList) > 0 {
+		previousJobId = utils.StringValue((*jobsResp.ConfigList)[0].Id)
+	}
+
+	var ymls interface{} = parameters
+	_, err = cssV1Client.UpdateYmls(&model.UpdateYmlsRequest{
+		ClusterId: clusterId,
+		Body: &model.UpdateYmlsReq{
+			Edit: &model.UpdateYmlsReqEdit{
+				Modify: &model.UpdateYmlsReqEditModify{
+					ElasticsearchYml: &ymls,
+				},
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error modifying the parameters of CSS cluster (%s): %s", clusterId, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"COMPLETED"},
+		Refresh:      cssConfigurationJobRefreshFunc(cssV1Client, clusterId, previousJobId),
+		Timeout:      timeout,
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for the parameters of CSS cluster (%s) to be modified: %s", clusterId, err)
+	}
+	return nil
+}
+
+// cssConfigurationJobRefreshFunc checks the parameter configuration task created after the previous one.
+func cssConfigurationJobRefreshFunc(cssV1Client *v1.CssClient, clusterId,
+	previousJobId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := cssV1Client.ListYmlsJob(&model.ListYmlsJobRequest{ClusterId: clusterId})
+		if err != nil {
+			return nil, "", err
+		}
+		if resp.ConfigList == nil || len(*resp.ConfigList) == 0 {
+			return resp, "PENDING", nil
+		}
+
+		job := (*resp.ConfigList)[0]
+		if utils.StringValue(job.Id) == previousJobId {
+			return job, "PENDING", nil
+		}
+		switch strings.ToLower(utils.StringValue(job.Status)) {
+		case "success":
+			return job, "COMPLETED", nil
+		case "failed":
+			return job, "", fmt.Errorf("the parameter configuration task failed: %s", utils.StringValue(job.FailedMsg))
+		default:
+			return job, "PENDING", nil
+		}
+	}
+}
+
+func resourceCssConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	cssV1Client, err := cfg.HcCssV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CSS V1 client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	// the cluster can not run the operations of the parameters and the plugins in parallel.
+	config.MutexKV.Lock(clusterId)
+	defer config.MutexKV.Unlock(clusterId)
+
+	err = updateCssConfigurationParameters(ctx, cssV1Client, clusterId, d.Get("parameters").(map[string]interface{}),
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(clusterId)
+
+	err = restartCssCluster(ctx, cfg, region, clusterId, d.Get("restart_mode").(string), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCssConfigurationRead(ctx, d, meta)
+}
+
+func resourceCssConfigurationRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	cssV1Client, err := cfg.HcCssV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CSS V1 client: %s", err)
+	}
+
+	resp, err := cssV1Client.ListYmls(&model.ListYmlsRequest{ClusterId: d.Id()})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CSS cluster parameters")
+	}
+
+	var configurations interface{}
+	if resp.Configurations != nil {
+		configurations = *resp.Configurations
+	}
+	// only the parameters managed by the resource are refreshed, the others keep the values of the cluster.
+	parameters := make(map[string]interface{})
+	for key := range d.Get("parameters").(map[string]interface{}) {
+		expression := fmt.Sprintf(`"elasticsearch.yml"."%s".value`, key)
+		if value := utils.PathSearch(expression, configurations, nil); value != nil {
+			parameters[key] = fmt.Sprint(value)
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("cluster_id", d.Id()),
+		d.Set("parameters", parameters),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceCssConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChange("parameters") {
+		return resourceCssConfigurationRead(ctx, d, meta)
+	}
+
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	cssV1Client, err := cfg.HcCssV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CSS V1 client: %s", err)
+	}
+
+	// only the added and changed parameters are modified, the removed ones are reset to the default values.
+	oldRaw, newRaw := d.GetChange("parameters")
+	oldParams := oldRaw.(map[string]interface{})
+	newParams := newRaw.(map[string]interface{})
+	changedParams := make(map[string]interface{})
+	for key, value := range newParams {
+		if oldValue, ok := oldParams[key]; !ok || oldValue != value {
+			changedParams[key] = value
+		}
+	}
+	removedKeys := make([]string, 0)
+	for key := range oldParams {
+		if _, ok := newParams[key]; !ok {
+			removedKeys = append(removedKeys, key)
+		}
+	}
+	if len(removedKeys) > 0 {
+		defaultParams, err := getCssConfigurationDefaultValues(cssV1Client, d.Id(), removedKeys)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for key, value := range defaultParams {
+			changedParams[key] = value
+		}
+	}
+
+	config.MutexKV.Lock(d.Id())
+	defer config.MutexKV.Unlock(d.Id())
+
+	if len(changedParams) > 0 {
+		err = updateCssConfigurationParameters(ctx, cssV1Client, d.Id(), changedParams, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = restartCssCluster(ctx, cfg, region, d.Id(), d.Get("restart_mode").(string),
+			d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCssConfigurationRead(ctx, d, meta)
+}
+
+// getCssConfigurationDefaultValues returns the default values of the parameters, which are used to reset the
+// parameters removed from the resource.
+func getCssConfigurationDefaultValues(cssV1Client *v1.CssClient, clusterId string,
+	keys []string) (map[string]interface{}, error) {
+	resp, err := cssV1Client.ListYmls(&model.ListYmlsRequest{ClusterId: clusterId})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving CSS cluster parameters: %s", err)
+	}
+
+	var configurations interface{}
+	if resp.Configurations != nil {
+		configurations = *resp.Configurations
+	}
+	defaultParams := make(map[string]interface{})
+	for _, key := range keys {
+		expression := fmt.Sprintf(`"elasticsearch.yml"."%s".defaultValue`, key)
+		value := utils.PathSearch(expression, configurations, nil)
+		if value == nil {
+			return nil, fmt.Errorf("the parameter (%s) has no default value and can not be removed", key)
+		}
+		defaultParams[key] = fmt.Sprint(value)
+	}
+	return defaultParams, nil
+}
+
+func resourceCssConfigurationDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+// resourceCssConfigurationImportState imports all the modifiable parameters whose values differ from the default
+// values, since the parameters managed by the resource are unknown.
+func resourceCssConfigurationImportState(_ context.Context, d *schema.ResourceData,
+	meta interface{}) ([]*schema.ResourceData, error) {
+	cfg := meta.(*config.Config)
+	cssV1Client, err := cfg.HcCssV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return nil, fmt.Errorf("error creating CSS V1 client: %s", err)
+	}
+
+	resp, err := cssV1Client.ListYmls(&model.ListYmlsRequest{ClusterId: d.Id()})
+	if err != nil {
+		return nil, fmt.Errorf("error retrieving CSS cluster parameters: %s", err)
+	}
+
+	parameters := make(map[string]interface{})
+	if resp.Configurations != nil {
+		ymls := utils.PathSearch(`"elasticsearch.yml"`, *resp.Configurations, make(map[string]interface{}))
+		for key, item := range ymls.(map[string]interface{}) {
+			value := utils.PathSearch("value", item, nil)
+			if value != nil && value != utils.PathSearch("defaultValue", item, nil) {
+				parameters[key] = fmt.Sprint(value)
+			}
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("cluster_id", d.Id()),
+		d.Set("parameters", parameters),
+		d.Set("restart_mode", cssRestartModeRolling),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
@@ -0,0 +1,148 @@
+package css
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceCssCrossClusterReplication is the impl for huaweicloud_css_cross_cluster_replication resource, which
+// creates a replication rule in the follower cluster to replicate the indices of the leader cluster.
+// The resource ID is in the format of <cluster_id>/<name>, the cluster_id is the ID of the follower cluster.
+func ResourceCssCrossClusterReplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCssCrossClusterReplicationCreate,
+		ReadContext:   resourceCssCrossClusterReplicationRead,
+		DeleteContext: resourceCssCrossClusterReplicationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCssClusterSubResourceImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the follower cluster.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the replication rule.`,
+			},
+			"leader_cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the leader cluster.`,
+			},
+			"indices": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the names or the wildcard patterns of the leader indices to be replicated.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the status of the replication rule.`,
+			},
+		},
+	}
+}
+
+func buildCssReplicationRulesPath(clusterId string) string {
+	return fmt.Sprintf("v1.0/{project_id}/clusters/%s/ccr/rules", clusterId)
+}
+
+func resourceCssCrossClusterReplicationCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("css", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSS client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+	params := map[string]interface{}{
+		"name":              name,
+		"leader_cluster_id": d.Get("leader_cluster_id"),
+		"indices":           utils.ExpandToStringList(d.Get("indices").([]interface{})),
+	}
+	if _, err = common.RequestApi(client, "POST", buildCssReplicationRulesPath(clusterId), nil, params); err != nil {
+		return diag.Errorf("error creating CSS cross-cluster replication rule: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", clusterId, name))
+
+	return resourceCssCrossClusterReplicationRead(ctx, d, meta)
+}
+
+func getCssReplicationRule(client *golangsdk.ServiceClient, clusterId, name string) (interface{}, error) {
+	respBody, err := common.RequestApi(client, "GET", buildCssReplicationRulesPath(clusterId), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := utils.PathSearch(fmt.Sprintf("rules[?name=='%s']|[0]", name), respBody, nil)
+	if rule == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return rule, nil
+}
+
+func resourceCssCrossClusterReplicationRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("css", region)
+	if err != nil {
+		return diag.Errorf("error creating CSS client: %s", err)
+	}
+
+	rule, err := getCssReplicationRule(client, d.Get("cluster_id").(string), d.Get("name").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CSS cross-cluster replication rule")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("leader_cluster_id", utils.PathSearch("leader_cluster_id", rule, nil)),
+		d.Set("indices", utils.PathSearch("indices", rule, nil)),
+		d.Set("status", utils.PathSearch("status", rule, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceCssCrossClusterReplicationDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("css", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSS client: %s", err)
+	}
+
+	deletePath := buildCssReplicationRulesPath(d.Get("cluster_id").(string)) + "/" + d.Get("name").(string)
+	if _, err = common.RequestApi(client, "DELETE", deletePath, nil, nil); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting CSS cross-cluster replication rule")
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package css
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v1 "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/css/v1"
+	"github.com/huaweicloud/huaweicloud-sdk-go-v3/services/css/v1/model"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceCssLogSetting is the impl for huaweicloud_css_log_setting resource, which enables the log backup of the
+// cluster, the logs (including the slow logs) are backed up to the OBS bucket manually or automatically.
+// The resource ID is the cluster ID.
+func ResourceCssLogSetting() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCssLogSettingCreate,
+		ReadContext:   resourceCssLogSettingRead,
+		UpdateContext: resourceCssLogSettingUpdate,
+		DeleteContext: resourceCssLogSettingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCssLogSettingImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the CSS cluster.`,
+			},
+			"agency": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the IAM agency used to access the OBS bucket.`,
+			},
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the OBS bucket where the logs are backed up.`,
+			},
+			"base_path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the storage path of the logs in the OBS bucket.`,
+			},
+			"period": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the daily start time of the automatic log backup, e.g. 00:00 GMT+08:00.`,
+			},
+			"auto_backup_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `Indicates whether the automatic log backup is enabled.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the time when the log setting is updated.`,
+			},
+		},
+	}
+}
+
+func updateCssLogAutoBackupPolicy(cssV1Client *v1.CssClient, clusterId, period string) error {
+	var err error
+	if period != "" {
+		_, err = cssV1Client.StartLogAutoBackupPolicy(&model.StartLogAutoBackupPolicyRequest{
+			ClusterId: clusterId,
+			Body: &model.StartLogAutoBackupPolicyReq{
+				Period: period,
+			},
+		})
+	} else {
+		_, err = cssV1Client.StopLogAutoBackupPolicy(&model.StopLogAutoBackupPolicyRequest{ClusterId: clusterId})
+	}
+	if err != nil {
+		return fmt.Errorf("error updating the automatic log backup policy of CSS cluster (%s): %s", clusterId, err)
+	}
+	return nil
+}
+
+func resourceCssLogSettingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	cssV1Client, err := cfg.HcCssV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSS V1 client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	_, err = cssV1Client.StartLogs(&model.StartLogsRequest{
+		ClusterId: clusterId,
+		Body: &model.StartLogsReq{
+			Agency:      d.Get("agency").(string),
+			LogBucket:   d.Get("bucket").(string),
+			LogBasePath: d.Get("base_path").(string),
+		},
+	})
+	if err != nil {
+		return diag.Errorf("error enabling the log backup of CSS cluster (%s): %s", clusterId, err)
+	}
+	d.SetId(clusterId)
+
+	if period := d.Get("period").(string); period != "" {
+		if err = updateCssLogAutoBackupPolicy(cssV1Client, clusterId, period); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCssLogSettingRead(ctx, d, meta)
+}
+
+func resourceCssLogSettingRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	cssV1Client, err := cfg.HcCssV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating CSS V1 client: %s", err)
+	}
+
+	resp, err := cssV1Client.ShowGetLogSetting(&model.ShowGetLogSettingRequest{ClusterId: d.Id()})
+	if err == nil && (resp.LogConfiguration == nil || resp.LogConfiguration.LogSwitch == nil ||
+		!*resp.LogConfiguration.LogSwitch) {
+		err = golangsdk.ErrDefault404{}
+	}
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CSS log setting")
+	}
+
+	logConfig := resp.LogConfiguration
+	autoEnabled := logConfig.AutoEnable != nil && *logConfig.AutoEnable
+	period := ""
+	if autoEnabled {
+		period = utils.StringValue(logConfig.Period)
+	}
+	var updatedAt string
+	if logConfig.UpdateAt != nil {
+		updatedAt = utils.FormatTimeStampRFC3339(*logConfig.UpdateAt/1000, false)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("cluster_id", d.Id()),
+		d.Set("agency", logConfig.Agency),
+		d.Set("bucket", logConfig.ObsBucket),
+		d.Set("base_path", logConfig.BasePath),
+		d.Set("period", period),
+		d.Set("auto_backup_enabled", autoEnabled),
+		d.Set("updated_at", updatedAt),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceCssLogSettingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	cssV1Client, err := cfg.HcCssV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSS V1 client: %s", err)
+	}
+
+	if d.HasChanges("agency", "bucket", "base_path") {
+		_, err = cssV1Client.UpdateLogSetting(&model.UpdateLogSettingRequest{
+			ClusterId: d.Id(),
+			Body: &model.UpdateLogSettingReq{
+				Agency:      d.Get("agency").(string),
+				LogBucket:   d.Get("bucket").(string),
+				LogBasePath: d.Get("base_path").(string),
+			},
+		})
+		if err != nil {
+			return diag.Errorf("error updating the log setting of CSS cluster (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("period") {
+		if err = updateCssLogAutoBackupPolicy(cssV1Client, d.Id(), d.Get("period").(string)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceCssLogSettingRead(ctx, d, meta)
+}
+
+func resourceCssLogSettingDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	cssV1Client, err := cfg.HcCssV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating CSS V1 client: %s", err)
+	}
+
+	if d.Get("period").(string) != "" {
+		if err = updateCssLogAutoBackupPolicy(cssV1Client, d.Id(), ""); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	_, err = cssV1Client.StopLogs(&model.StopLogsRequest{ClusterId: d.Id()})
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error disabling CSS log backup")
+	}
+
+	return nil
+}
+
+func resourceCssLogSettingImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	return []*schema.ResourceData{d}, d.Set("cluster_id", d.Id())
+}
@@ -0,0 +1,208 @@
+package css
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceCssPlugin is the impl for huaweicloud_css_plugin resource, which installs the plugin to the cluster. The
+// custom plugin is installed from the package in the OBS bucket, and the cluster is restarted to load the plugin.
+// The resource ID is in the format of <cluster_id>/<name>.
+func ResourceCssPlugin() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCssPluginCreate,
+		ReadContext:   resourceCssPluginRead,
+		DeleteContext: resourceCssPluginDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCssClusterSubResourceImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the CSS cluster.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the plugin.`,
+			},
+			"bucket_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"object"},
+				Description:  `Specifies the OBS bucket where the package of the custom plugin is stored.`,
+			},
+			"object": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"bucket_name"},
+				Description:  `Specifies the object path of the custom plugin package in the OBS bucket.`,
+			},
+			"restart_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      cssRestartModeRolling,
+				ValidateFunc: validation.StringInSlice([]string{cssRestartModeRolling, cssRestartModeFull}, false),
+				Description:  `Specifies how the cluster is restarted to load the plugin.`,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the type of the plugin.`,
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the version of the plugin.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the status of the plugin.`,
+			},
+		},
+	}
+}
+
+func buildCssPluginsPath(clusterId string) string {
+	return fmt.Sprintf("v1.0/{project_id}/clusters/%s/plugins", clusterId)
+}
+
+func resourceCssPluginCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("css", region)
+	if err != nil {
+		return diag.Errorf("error creating CSS client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+	// the cluster can not run the plugin operations in parallel.
+	config.MutexKV.Lock(clusterId)
+	defer config.MutexKV.Unlock(clusterId)
+
+	params := map[string]interface{}{
+		"name":   name,
+		"bucket": utils.ValueIngoreEmpty(d.Get("bucket_name")),
+		"path":   utils.ValueIngoreEmpty(d.Get("object")),
+	}
+	if _, err = common.RequestApi(client, "POST", buildCssPluginsPath(clusterId), nil, params); err != nil {
+		return diag.Errorf("error installing plugin (%s) to CSS cluster (%s): %s", name, clusterId, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", clusterId, name))
+
+	err = restartCssCluster(ctx, cfg, region, clusterId, d.Get("restart_mode").(string), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceCssPluginRead(ctx, d, meta)
+}
+
+func getCssPlugin(client *golangsdk.ServiceClient, clusterId, name string) (interface{}, error) {
+	respBody, err := common.RequestApi(client, "GET", buildCssPluginsPath(clusterId), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	plugin := utils.PathSearch(fmt.Sprintf("plugins[?name=='%s']|[0]", name), respBody, nil)
+	if plugin == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return plugin, nil
+}
+
+func resourceCssPluginRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("css", region)
+	if err != nil {
+		return diag.Errorf("error creating CSS client: %s", err)
+	}
+
+	plugin, err := getCssPlugin(client, d.Get("cluster_id").(string), d.Get("name").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving CSS plugin")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("type", utils.PathSearch("type", plugin, nil)),
+		d.Set("version", utils.PathSearch("version", plugin, nil)),
+		d.Set("status", utils.PathSearch("status", plugin, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceCssPluginDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("css", region)
+	if err != nil {
+		return diag.Errorf("error creating CSS client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	config.MutexKV.Lock(clusterId)
+	defer config.MutexKV.Unlock(clusterId)
+
+	deletePath := buildCssPluginsPath(clusterId) + "/" + d.Get("name").(string)
+	if _, err = common.RequestApi(client, "DELETE", deletePath, nil, nil); err != nil {
+		return common.CheckDeletedDiag(d, err, "error uninstalling CSS plugin")
+	}
+
+	// the plugin is unloaded after the cluster is restarted.
+	err = restartCssCluster(ctx, cfg, region, clusterId, d.Get("restart_mode").(string), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+// resourceCssClusterSubResourceImportState imports the resources whose ID is in the format of <cluster_id>/<name>.
+func resourceCssClusterSubResourceImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format of import ID, want '<cluster_id>/<name>', but got '%s'", d.Id())
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("cluster_id", parts[0]),
+		d.Set("name", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
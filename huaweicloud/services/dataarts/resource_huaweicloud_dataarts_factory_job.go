@@ -0,0 +1,454 @@
+package dataarts
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceFactoryJob is the impl of huaweicloud_dataarts_factory_job, which manages the definition of a DataArts
+// Factory job. The nodes of the job form a DAG through the pre_node_names of each node.
+// The resource ID is the job name.
+func ResourceFactoryJob() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFactoryJobCreate,
+		ReadContext:   resourceFactoryJobRead,
+		UpdateContext: resourceFactoryJobUpdate,
+		DeleteContext: resourceFactoryJobDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWorkspaceSubResourceImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"workspace_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the workspace to which the job belongs.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the job.`,
+			},
+			"nodes": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        factoryJobNodeSchema(),
+				Description: `Specifies the nodes of the job.`,
+			},
+			"schedule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Elem:        factoryJobScheduleSchema(),
+				Description: `Specifies the scheduling configuration of the job.`,
+			},
+			"params": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        factoryJobParamSchema(),
+				Description: `Specifies the parameters of the job.`,
+			},
+			"process_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "BATCH",
+				ValidateFunc: validation.StringInSlice([]string{"BATCH", "REAL_TIME"}, false),
+				Description:  `Specifies the processing type of the job.`,
+			},
+			"directory": {
+				Type:     schema.TypeString,
+				Optional: true,
+				// the job without the directory is located in the root directory.
+				DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+					return old == "/" && new == ""
+				},
+				Description: `Specifies the directory where the job is located.`,
+			},
+			"log_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the OBS path where the job logs are stored.`,
+			},
+		},
+	}
+}
+
+func factoryJobNodeSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the name of the node.`,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the type of the node.`,
+			},
+			"location": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"x": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: `Specifies the position of the node on the horizontal axis.`,
+						},
+						"y": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: `Specifies the position of the node on the vertical axis.`,
+						},
+					},
+				},
+				Description: `Specifies the location of the node on the canvas.`,
+			},
+			"pre_node_names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the names of the nodes on which the node depends.`,
+			},
+			"properties": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        factoryJobParamSchema(),
+				Description: `Specifies the properties of the node.`,
+			},
+			"polling_interval": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: `Specifies the interval, in seconds, for checking whether the node execution is complete.`,
+			},
+			"max_execution_time": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: `Specifies the maximum execution time of the node, in minutes.`,
+			},
+			"retry_times": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: `Specifies the number of the retries when the node fails.`,
+			},
+			"retry_interval": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: `Specifies the interval between the retries, in seconds.`,
+			},
+			"fail_policy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"FAIL", "IGNORE", "SUSPEND", "FAIL_CHILD",
+				}, false),
+				Description: `Specifies the policy applied when the node fails.`,
+			},
+		},
+	}
+}
+
+func factoryJobScheduleSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"EXECUTE_ONCE", "CRON", "EVENT"}, false),
+				Description:  `Specifies the scheduling type of the job.`,
+			},
+			"cron": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the start time of the scheduling.`,
+						},
+						"expression": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the cron expression of the scheduling.`,
+						},
+						"end_time": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Specifies the end time of the scheduling.`,
+						},
+						"expression_time_zone": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: `Specifies the time zone of the cron expression.`,
+						},
+						"depend_jobs": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: `Specifies the names of the jobs on which the job depends.`,
+						},
+					},
+				},
+				Description: `Specifies the cron scheduling configuration.`,
+			},
+		},
+	}
+}
+
+func factoryJobParamSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the name of the parameter.`,
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the value of the parameter.`,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `Specifies the type of the parameter.`,
+			},
+		},
+	}
+}
+
+func buildFactoryJobParams(params []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(params))
+	for _, v := range params {
+		param := v.(map[string]interface{})
+		result = append(result, map[string]interface{}{
+			"name":  param["name"],
+			"value": param["value"],
+			"type":  utils.ValueIngoreEmpty(param["type"]),
+		})
+	}
+	return result
+}
+
+func buildFactoryJobNodes(nodes []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(nodes))
+	for _, v := range nodes {
+		node := v.(map[string]interface{})
+		location := node["location"].([]interface{})[0].(map[string]interface{})
+		result = append(result, map[string]interface{}{
+			"name": node["name"],
+			"type": node["type"],
+			"location": map[string]interface{}{
+				"x": location["x"],
+				"y": location["y"],
+			},
+			"preNodeName":      node["pre_node_names"],
+			"properties":       buildFactoryJobParams(node["properties"].([]interface{})),
+			"pollingInterval":  utils.ValueIngoreEmpty(node["polling_interval"]),
+			"maxExecutionTime": utils.ValueIngoreEmpty(node["max_execution_time"]),
+			"retryTimes":       utils.ValueIngoreEmpty(node["retry_times"]),
+			"retryInterval":    utils.ValueIngoreEmpty(node["retry_interval"]),
+			"failPolicy":       utils.ValueIngoreEmpty(node["fail_policy"]),
+		})
+	}
+	return result
+}
+
+func buildFactoryJobSchedule(schedules []interface{}) map[string]interface{} {
+	schedule := schedules[0].(map[string]interface{})
+	result := map[string]interface{}{
+		"type": schedule["type"],
+	}
+
+	if crons := schedule["cron"].([]interface{}); len(crons) > 0 {
+		cron := crons[0].(map[string]interface{})
+		cronParams := map[string]interface{}{
+			"startTime":          cron["start_time"],
+			"endTime":            utils.ValueIngoreEmpty(cron["end_time"]),
+			"expression":         cron["expression"],
+			"expressionTimeZone": utils.ValueIngoreEmpty(cron["expression_time_zone"]),
+		}
+		if dependJobs := cron["depend_jobs"].([]interface{}); len(dependJobs) > 0 {
+			cronParams["dependJobs"] = map[string]interface{}{
+				"jobs": dependJobs,
+			}
+		}
+		result["cron"] = cronParams
+	}
+	return result
+}
+
+func buildFactoryJobCreateParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        d.Get("name"),
+		"nodes":       buildFactoryJobNodes(d.Get("nodes").([]interface{})),
+		"schedule":    buildFactoryJobSchedule(d.Get("schedule").([]interface{})),
+		"params":      buildFactoryJobParams(d.Get("params").([]interface{})),
+		"processType": d.Get("process_type"),
+		"directory":   utils.ValueIngoreEmpty(d.Get("directory")),
+		"logPath":     utils.ValueIngoreEmpty(d.Get("log_path")),
+	}
+}
+
+func resourceFactoryJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dataarts_dlf", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DataArts Factory client: %s", err)
+	}
+
+	_, err = common.RequestApi(client, "POST", "v1/{project_id}/jobs", buildDataArtsWorkspaceHeaders(d),
+		buildFactoryJobCreateParams(d))
+	if err != nil {
+		return diag.Errorf("error creating DataArts Factory job: %s", err)
+	}
+	d.SetId(d.Get("name").(string))
+
+	return resourceFactoryJobRead(ctx, d, meta)
+}
+
+func flattenFactoryJobParams(params []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(params))
+	for _, param := range params {
+		result = append(result, map[string]interface{}{
+			"name":  utils.PathSearch("name", param, nil),
+			"value": utils.PathSearch("value", param, nil),
+			"type":  utils.PathSearch("type", param, nil),
+		})
+	}
+	return result
+}
+
+func flattenFactoryJobNodes(nodes []interface{}) []interface{} {
+	result := make([]interface{}, 0, len(nodes))
+	for _, node := range nodes {
+		result = append(result, map[string]interface{}{
+			"name": utils.PathSearch("name", node, nil),
+			"type": utils.PathSearch("type", node, nil),
+			"location": []interface{}{
+				map[string]interface{}{
+					"x": utils.PathSearch("location.x", node, nil),
+					"y": utils.PathSearch("location.y", node, nil),
+				},
+			},
+			"pre_node_names": utils.PathSearch("preNodeName", node, nil),
+			"properties": flattenFactoryJobParams(
+				utils.PathSearch("properties", node, make([]interface{}, 0)).([]interface{})),
+			"polling_interval":   utils.PathSearch("pollingInterval", node, nil),
+			"max_execution_time": utils.PathSearch("maxExecutionTime", node, nil),
+			"retry_times":        utils.PathSearch("retryTimes", node, nil),
+			"retry_interval":     utils.PathSearch("retryInterval", node, nil),
+			"fail_policy":        utils.PathSearch("failPolicy", node, nil),
+		})
+	}
+	return result
+}
+
+func flattenFactoryJobSchedule(schedule interface{}) []interface{} {
+	if schedule == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{
+		"type": utils.PathSearch("type", schedule, nil),
+	}
+	if cron := utils.PathSearch("cron", schedule, nil); cron != nil {
+		result["cron"] = []interface{}{
+			map[string]interface{}{
+				"start_time":           utils.PathSearch("startTime", cron, nil),
+				"end_time":             utils.PathSearch("endTime", cron, nil),
+				"expression":           utils.PathSearch("expression", cron, nil),
+				"expression_time_zone": utils.PathSearch("expressionTimeZone", cron, nil),
+				"depend_jobs":          utils.PathSearch("dependJobs.jobs", cron, nil),
+			},
+		}
+	}
+	return []interface{}{result}
+}
+
+func resourceFactoryJobRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dataarts_dlf", region)
+	if err != nil {
+		return diag.Errorf("error creating DataArts Factory client: %s", err)
+	}
+
+	job, err := common.RequestApi(client, "GET", "v1/{project_id}/jobs/"+d.Id(), buildDataArtsWorkspaceHeaders(d), nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DataArts Factory job")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", job, nil)),
+		d.Set("nodes", flattenFactoryJobNodes(utils.PathSearch("nodes", job, make([]interface{}, 0)).([]interface{}))),
+		d.Set("schedule", flattenFactoryJobSchedule(utils.PathSearch("schedule", job, nil))),
+		d.Set("params", flattenFactoryJobParams(utils.PathSearch("params", job, make([]interface{}, 0)).([]interface{}))),
+		d.Set("process_type", utils.PathSearch("processType", job, nil)),
+		d.Set("directory", utils.PathSearch("directory", job, nil)),
+		d.Set("log_path", utils.PathSearch("logPath", job, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceFactoryJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dataarts_dlf", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DataArts Factory client: %s", err)
+	}
+
+	_, err = common.RequestApi(client, "PUT", "v1/{project_id}/jobs/"+d.Id(), buildDataArtsWorkspaceHeaders(d),
+		buildFactoryJobCreateParams(d))
+	if err != nil {
+		return diag.Errorf("error updating DataArts Factory job (%s): %s", d.Id(), err)
+	}
+
+	return resourceFactoryJobRead(ctx, d, meta)
+}
+
+func resourceFactoryJobDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dataarts_dlf", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DataArts Factory client: %s", err)
+	}
+
+	_, err = common.RequestApi(client, "DELETE", "v1/{project_id}/jobs/"+d.Id(), buildDataArtsWorkspaceHeaders(d), nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DataArts Factory job")
+	}
+
+	return nil
+}
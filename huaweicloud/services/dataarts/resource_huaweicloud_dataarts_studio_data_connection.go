@@ -0,0 +1,213 @@
+package dataarts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceStudioDataConnection is the impl of huaweicloud_dataarts_studio_data_connection.
+// The resource ID is the data connection ID.
+func ResourceStudioDataConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceStudioDataConnectionCreate,
+		ReadContext:   resourceStudioDataConnectionRead,
+		UpdateContext: resourceStudioDataConnectionUpdate,
+		DeleteContext: resourceStudioDataConnectionDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceWorkspaceSubResourceImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"workspace_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the workspace to which the data connection belongs.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the name of the data connection.`,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"DLI", "DWS", "RDS", "MRS_HIVE", "MRS_SPARK", "MRS_HBASE", "MRS_KAFKA",
+				}, false),
+				Description: `Specifies the type of the data connection.`,
+			},
+			"config": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the connection configuration of the data source.`,
+			},
+			"agent_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the ID of the CDM cluster used as the agent of the data connection.`,
+			},
+			"agent_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the name of the CDM cluster used as the agent of the data connection.`,
+			},
+			"env_type": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: `Specifies the environment type of the data connection.`,
+			},
+			"qualified_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the qualified name of the data connection.`,
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the user who created the data connection.`,
+			},
+		},
+	}
+}
+
+func buildStudioDataConnectionParams(d *schema.ResourceData) map[string]interface{} {
+	connection := map[string]interface{}{
+		"dw_name":    d.Get("name"),
+		"dw_type":    d.Get("type"),
+		"dw_config":  d.Get("config"),
+		"agent_id":   utils.ValueIngoreEmpty(d.Get("agent_id")),
+		"agent_name": utils.ValueIngoreEmpty(d.Get("agent_name")),
+		"env_type":   utils.ValueIngoreEmpty(d.Get("env_type")),
+	}
+	return map[string]interface{}{
+		"data_source_vos": []map[string]interface{}{connection},
+	}
+}
+
+func buildDataArtsWorkspaceHeaders(d *schema.ResourceData) map[string]string {
+	return map[string]string{"workspace": d.Get("workspace_id").(string)}
+}
+
+func resourceStudioDataConnectionCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dataarts_dlf", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DataArts Factory client: %s", err)
+	}
+
+	respBody, err := common.RequestApi(client, "POST", "v1/{project_id}/data-connections",
+		buildDataArtsWorkspaceHeaders(d), buildStudioDataConnectionParams(d))
+	if err != nil {
+		return diag.Errorf("error creating DataArts Studio data connection: %s", err)
+	}
+
+	connectionId := utils.PathSearch("data.data_connection_id", respBody, "").(string)
+	if connectionId == "" {
+		return diag.Errorf("unable to find the DataArts Studio data connection ID from the API response")
+	}
+	d.SetId(connectionId)
+
+	return resourceStudioDataConnectionRead(ctx, d, meta)
+}
+
+func resourceStudioDataConnectionRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dataarts_dlf", region)
+	if err != nil {
+		return diag.Errorf("error creating DataArts Factory client: %s", err)
+	}
+
+	connection, err := common.RequestApi(client, "GET", "v1/{project_id}/data-connections/"+d.Id(),
+		buildDataArtsWorkspaceHeaders(d), nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DataArts Studio data connection")
+	}
+
+	// The sensitive values of the configuration (e.g. password) are not returned, so the config is not refreshed.
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("dw_name", connection, nil)),
+		d.Set("type", utils.PathSearch("dw_type", connection, nil)),
+		d.Set("agent_id", utils.PathSearch("agent_id", connection, nil)),
+		d.Set("agent_name", utils.PathSearch("agent_name", connection, nil)),
+		d.Set("env_type", utils.PathSearch("env_type", connection, nil)),
+		d.Set("qualified_name", utils.PathSearch("qualified_name", connection, nil)),
+		d.Set("created_by", utils.PathSearch("create_user", connection, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceStudioDataConnectionUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dataarts_dlf", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DataArts Factory client: %s", err)
+	}
+
+	_, err = common.RequestApi(client, "PUT", "v1/{project_id}/data-connections/"+d.Id(),
+		buildDataArtsWorkspaceHeaders(d), buildStudioDataConnectionParams(d))
+	if err != nil {
+		return diag.Errorf("error updating DataArts Studio data connection (%s): %s", d.Id(), err)
+	}
+
+	return resourceStudioDataConnectionRead(ctx, d, meta)
+}
+
+func resourceStudioDataConnectionDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dataarts_dlf", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DataArts Factory client: %s", err)
+	}
+
+	_, err = common.RequestApi(client, "DELETE", "v1/{project_id}/data-connections/"+d.Id(),
+		buildDataArtsWorkspaceHeaders(d), nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DataArts Studio data connection")
+	}
+
+	return nil
+}
+
+// resourceWorkspaceSubResourceImportState imports the resources in the workspace, the import ID can be the resource
+// ID (for the default workspace) or in the format of <workspace_id>/<id>.
+func resourceWorkspaceSubResourceImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return []*schema.ResourceData{d}, nil
+	}
+	if parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid format of import ID, want '<workspace_id>/<id>', but got '%s'", d.Id())
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("workspace_id", parts[0])
+}
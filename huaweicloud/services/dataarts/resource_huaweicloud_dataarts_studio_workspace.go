@@ -0,0 +1,210 @@
+package dataarts
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceStudioWorkspace is the impl of huaweicloud_dataarts_studio_workspace.
+// The resource ID is the workspace ID, and the instance ID is required for all workspace APIs.
+func ResourceStudioWorkspace() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceStudioWorkspaceCreate,
+		ReadContext:   resourceStudioWorkspaceRead,
+		UpdateContext: resourceStudioWorkspaceUpdate,
+		DeleteContext: resourceStudioWorkspaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceStudioWorkspaceImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the DataArts Studio instance.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the name of the workspace.`,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the description of the workspace.`,
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `Specifies the enterprise project ID of the workspace.`,
+			},
+			"bad_record_location_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the OBS path where the dirty data of the jobs is stored.`,
+			},
+			"job_log_location_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the OBS path where the logs of the jobs are stored.`,
+			},
+			"is_default": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `Indicates whether the workspace is the default workspace.`,
+			},
+			"member_num": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `Indicates the number of the workspace members.`,
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the user who created the workspace.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the time when the workspace was created.`,
+			},
+		},
+	}
+}
+
+func buildStudioWorkspaceParams(d *schema.ResourceData, cfg *config.Config) map[string]interface{} {
+	return map[string]interface{}{
+		"name":                     d.Get("name"),
+		"description":              d.Get("description"),
+		"eps_id":                   utils.ValueIngoreEmpty(cfg.GetEnterpriseProjectID(d)),
+		"bad_record_location_name": utils.ValueIngoreEmpty(d.Get("bad_record_location_name")),
+		"job_log_location_name":    utils.ValueIngoreEmpty(d.Get("job_log_location_name")),
+	}
+}
+
+func resourceStudioWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DataArtsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DataArts Studio v1 client: %s", err)
+	}
+
+	headers := map[string]string{"instance": d.Get("instance_id").(string)}
+	respBody, err := common.RequestApi(client, "POST", "v1/{project_id}/workspaces", headers,
+		buildStudioWorkspaceParams(d, cfg))
+	if err != nil {
+		return diag.Errorf("error creating DataArts Studio workspace: %s", err)
+	}
+
+	workspaceId := utils.PathSearch("data.id", respBody, "").(string)
+	if workspaceId == "" {
+		return diag.Errorf("unable to find the DataArts Studio workspace ID from the API response")
+	}
+	d.SetId(workspaceId)
+
+	return resourceStudioWorkspaceRead(ctx, d, meta)
+}
+
+func resourceStudioWorkspaceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DataArtsV1Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DataArts Studio v1 client: %s", err)
+	}
+
+	headers := map[string]string{"instance": d.Get("instance_id").(string)}
+	respBody, err := common.RequestApi(client, "GET", "v1/{project_id}/workspaces/"+d.Id(), headers, nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DataArts Studio workspace")
+	}
+
+	workspace := utils.PathSearch("data", respBody, nil)
+	if workspace == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving DataArts Studio workspace")
+	}
+
+	var createdAt string
+	if v, ok := utils.PathSearch("create_time", workspace, float64(0)).(float64); ok && v > 0 {
+		createdAt = utils.FormatTimeStampRFC3339(int64(v)/1000, false)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", workspace, nil)),
+		d.Set("description", utils.PathSearch("description", workspace, nil)),
+		d.Set("enterprise_project_id", utils.PathSearch("eps_id", workspace, nil)),
+		d.Set("bad_record_location_name", utils.PathSearch("bad_record_location_name", workspace, nil)),
+		d.Set("job_log_location_name", utils.PathSearch("job_log_location_name", workspace, nil)),
+		d.Set("is_default", utils.PathSearch("is_default", workspace, nil) == float64(1)),
+		d.Set("member_num", utils.PathSearch("member_num", workspace, nil)),
+		d.Set("created_by", utils.PathSearch("create_user", workspace, nil)),
+		d.Set("created_at", createdAt),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceStudioWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DataArtsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DataArts Studio v1 client: %s", err)
+	}
+
+	headers := map[string]string{"instance": d.Get("instance_id").(string)}
+	_, err = common.RequestApi(client, "PUT", "v1/{project_id}/workspaces/"+d.Id(), headers,
+		buildStudioWorkspaceParams(d, cfg))
+	if err != nil {
+		return diag.Errorf("error updating DataArts Studio workspace (%s): %s", d.Id(), err)
+	}
+
+	return resourceStudioWorkspaceRead(ctx, d, meta)
+}
+
+func resourceStudioWorkspaceDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DataArtsV1Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DataArts Studio v1 client: %s", err)
+	}
+
+	headers := map[string]string{"instance": d.Get("instance_id").(string)}
+	_, err = common.RequestApi(client, "DELETE", "v1/{project_id}/workspaces/"+d.Id(), headers, nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DataArts Studio workspace")
+	}
+
+	return nil
+}
+
+func resourceStudioWorkspaceImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format of import ID, want '<instance_id>/<id>', but got '%s'", d.Id())
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("instance_id", parts[0])
+}
@@ -0,0 +1,252 @@
+package dcs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDcsBackup is the impl for huaweicloud_dcs_backup resource, which manages the manual backups of DCS instances.
+// The resource ID is in the format of <instance_id>/<backup_id>.
+func ResourceDcsBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcsBackupCreate,
+		ReadContext:   resourceDcsBackupRead,
+		DeleteContext: resourceDcsBackupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDcsBackupImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the DCS instance.`,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+				Description:  `Specifies the description of the backup.`,
+			},
+			"backup_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"aof", "rdb"}, false),
+				Description:  `Specifies the format of the backup file.`,
+			},
+			"backup_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the ID of the backup.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the name of the backup.`,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the backup type.`,
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `Indicates the size of the backup file in bytes.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the backup status.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the time when the backup task is created.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the time when the backup task is updated.`,
+			},
+			"is_support_restore": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates whether the backup can be used to restore the instance.`,
+			},
+		},
+	}
+}
+
+func resourceDcsBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DcsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DCS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	params := map[string]interface{}{
+		"remark":        utils.ValueIngoreEmpty(d.Get("description")),
+		"backup_format": utils.ValueIngoreEmpty(d.Get("backup_format")),
+	}
+	createRespBody, err := requestDcsApi(client, "POST",
+		fmt.Sprintf("v2/{project_id}/instances/%s/backups", instanceId), params)
+	if err != nil {
+		return diag.Errorf("error creating DCS backup: %s", err)
+	}
+
+	backupId := utils.PathSearch("backup_id", createRespBody, "").(string)
+	if backupId == "" {
+		return diag.Errorf("unable to find the backup ID from the API response")
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceId, backupId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"waiting", "backuping"},
+		Target:       []string{"succeed"},
+		Refresh:      dcsInstanceRecordStatusRefreshFunc(client, instanceId, "backups", backupId),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for DCS backup (%s) to be completed: %s", backupId, err)
+	}
+
+	return resourceDcsBackupRead(ctx, d, meta)
+}
+
+// getDcsInstanceRecord pages the backup or restore records of the instance to find the record with the given ID.
+func getDcsInstanceRecord(client *golangsdk.ServiceClient, instanceId, recordType, recordId string) (interface{},
+	error) {
+	// the list key is backup_record_response or restore_record_response, and the ID key is backup_id or restore_id
+	recordKey := strings.TrimSuffix(recordType, "s")
+	listPath := fmt.Sprintf("v2/{project_id}/instances/%s/%s?limit=100", instanceId, recordType)
+	for page := 1; ; page++ {
+		respBody, err := requestDcsApi(client, "GET", fmt.Sprintf("%s&offset=%d", listPath, page), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		records := utils.PathSearch(recordKey+"_record_response", respBody, make([]interface{}, 0)).([]interface{})
+		record := utils.PathSearch(fmt.Sprintf("[?%s_id=='%s']|[0]", recordKey, recordId), records, nil)
+		if record != nil {
+			return record, nil
+		}
+		if len(records) < 100 {
+			return nil, golangsdk.ErrDefault404{}
+		}
+	}
+}
+
+func dcsInstanceRecordStatusRefreshFunc(client *golangsdk.ServiceClient, instanceId, recordType,
+	recordId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		record, err := getDcsInstanceRecord(client, instanceId, recordType, recordId)
+		if err != nil {
+			return nil, "ERROR", err
+		}
+		status := utils.PathSearch("status", record, "").(string)
+		if status == "failed" {
+			return record, status, fmt.Errorf("the task failed, error code: %v",
+				utils.PathSearch("error_code", record, nil))
+		}
+		return record, status, nil
+	}
+}
+
+func resourceDcsBackupRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DcsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DCS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	backupId := strings.TrimPrefix(d.Id(), instanceId+"/")
+	backup, err := getDcsInstanceRecord(client, instanceId, "backups", backupId)
+	if err == nil && utils.PathSearch("status", backup, "").(string) == "deleted" {
+		err = golangsdk.ErrDefault404{}
+	}
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DCS backup")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("backup_id", backupId),
+		d.Set("description", utils.PathSearch("remark", backup, nil)),
+		d.Set("backup_format", utils.PathSearch("backup_format", backup, nil)),
+		d.Set("name", utils.PathSearch("backup_name", backup, nil)),
+		d.Set("type", utils.PathSearch("backup_type", backup, nil)),
+		d.Set("size", utils.PathSearch("size", backup, nil)),
+		d.Set("status", utils.PathSearch("status", backup, nil)),
+		d.Set("created_at", utils.PathSearch("created_at", backup, nil)),
+		d.Set("updated_at", utils.PathSearch("updated_at", backup, nil)),
+		d.Set("is_support_restore", utils.PathSearch("is_support_restore", backup, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDcsBackupDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DcsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DCS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	_, err = requestDcsApi(client, "DELETE", fmt.Sprintf("v2/{project_id}/instances/%s/backups/%s", instanceId,
+		d.Get("backup_id")), nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DCS backup")
+	}
+
+	return nil
+}
+
+func resourceDcsBackupImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <instance_id>/<backup_id>")
+	}
+
+	return []*schema.ResourceData{d}, d.Set("instance_id", parts[0])
+}
@@ -227,6 +227,37 @@ func ResourceDcsInstance() *schema.Resource {
 				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"parameters": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"restart_for_parameters": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ssl_enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"public_ip_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"deleted_nodes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"reserved_ips": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"enterprise_project_id": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -271,6 +302,22 @@ func ResourceDcsInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"replica_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"sharding_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"public_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"restart_required": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 
 			// Deprecated
 			"product_id": {
@@ -557,6 +604,15 @@ func resourceDcsInstancesCreate(ctx context.Context, d *schema.ResourceData, met
 		createOpts.RenameCommands = renameCommands
 	}
 
+	if d.Get("ssl_enable").(bool) {
+		createOpts.EnableSsl = utils.Bool(true)
+	}
+
+	if v, ok := d.GetOk("public_ip_id"); ok {
+		createOpts.EnablePublicIp = utils.Bool(true)
+		createOpts.PublicIpId = v.(string)
+	}
+
 	// build and set backup policy if configured.
 	backupPolicy := buildBackupPolicyParams(d)
 	if backupPolicy != nil {
@@ -615,6 +671,12 @@ func resourceDcsInstancesCreate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
+	if len(d.Get("parameters").(map[string]interface{})) > 0 {
+		if err = updateDcsInstanceParameters(ctx, client, d, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceDcsInstancesRead(ctx, d, meta)
 }
 
@@ -707,6 +769,9 @@ func resourceDcsInstancesRead(ctx context.Context, d *schema.ResourceData, meta
 		d.Set("user_name", r.UserName),
 		d.Set("access_user", r.AccessUser),
 		d.Set("order_id", r.OrderId),
+		d.Set("ssl_enable", r.EnableSsl),
+		d.Set("public_ip_id", r.PublicIpId),
+		d.Set("public_ip_address", r.PublicIpAddress),
 	)
 
 	if mErr.ErrorOrNil() != nil {
@@ -738,6 +803,23 @@ func resourceDcsInstancesRead(ctx context.Context, d *schema.ResourceData, meta
 		logp.Printf("[WARN] fetching tags of DCS instance failed: %s", err)
 	}
 
+	// the replica and sharding numbers are not returned by the SDK
+	if detail, err := requestDcsApi(client, "GET", "v2/{project_id}/instances/"+d.Id(), nil); err == nil {
+		mErr = multierror.Append(mErr,
+			d.Set("replica_count", utils.PathSearch("replica_count", detail, nil)),
+			d.Set("sharding_count", utils.PathSearch("sharding_count", detail, nil)),
+		)
+	} else {
+		logp.Printf("[WARN] fetching replica and sharding numbers of DCS instance failed: %s", err)
+	}
+
+	if err = setDcsInstanceParameters(client, d); err != nil {
+		mErr = multierror.Append(mErr, err)
+	}
+	if mErr.ErrorOrNil() != nil {
+		return fmtp.DiagErrorf("error setting DCS instance attributes: %s", mErr)
+	}
+
 	// set white list
 	// some regions (cn-south-1) will fail to call the API due to the cloud reason
 	// ignore the error temporarily.
@@ -807,6 +889,18 @@ func resourceDcsInstancesUpdate(ctx context.Context, d *schema.ResourceData, met
 		return diag.FromErr(err)
 	}
 
+	if d.HasChange("ssl_enable") {
+		if err = updateDcsInstanceSsl(ctx, client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChanges("parameters", "restart_for_parameters") {
+		if err = updateDcsInstanceParameters(ctx, client, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// update tags
 	if d.HasChange("tags") {
 		oldVal, newVal := d.GetChange("tags")
@@ -913,6 +1007,9 @@ func resizeDcsInstance(ctx context.Context, d *schema.ResourceData, meta interfa
 				IsAutoPay: common.GetAutoPay(d),
 			}
 		}
+		if err = buildDcsReplicationChangeOpts(client, d, &opts); err != nil {
+			return err
+		}
 		logp.Printf("[DEBUG] Resize DCS dcs instance options : %#v", opts)
 
 		r, err := instances.ResizeInstance(client, d.Id(), opts)
@@ -952,6 +1049,214 @@ func resizeDcsInstance(ctx context.Context, d *schema.ResourceData, meta interfa
 	return nil
 }
 
+// buildDcsReplicationChangeOpts sets the change type when the replica number in the flavor name (e.g. the r2 of
+// redis.ha.xu1.large.r2.4) is changed, the new replicas are spread across the availability zones of the instance and
+// the replicas to be deleted are picked from the standby nodes if deleted_nodes is not specified.
+func buildDcsReplicationChangeOpts(client *golangsdk.ServiceClient, d *schema.ResourceData,
+	opts *instances.ResizeInstanceOpts) error {
+	oldFlavor, newFlavor := d.GetChange("flavor")
+	oldNum, newNum := getDcsReplicaNumFromFlavor(oldFlavor.(string)), getDcsReplicaNumFromFlavor(newFlavor.(string))
+	if oldNum == 0 || newNum == 0 || oldNum == newNum {
+		return nil
+	}
+
+	if newNum > oldNum {
+		azCodes := utils.ExpandToStringList(d.Get("availability_zones").([]interface{}))
+		if len(azCodes) == 0 {
+			return fmt.Errorf("unable to find the availability zones of the DCS instance")
+		}
+		opts.ChangeType = "createReplication"
+		for i := 0; i < newNum-oldNum; i++ {
+			opts.AvailableZones = append(opts.AvailableZones, azCodes[i%len(azCodes)])
+		}
+		opts.ReservedIp = utils.ExpandToStringList(d.Get("reserved_ips").([]interface{}))
+		return nil
+	}
+
+	opts.ChangeType = "deleteReplication"
+	opts.NodeList = utils.ExpandToStringList(d.Get("deleted_nodes").([]interface{}))
+	if len(opts.NodeList) > 0 {
+		return nil
+	}
+	respBody, err := requestDcsApi(client, "GET", fmt.Sprintf("v2/{project_id}/instance/%s/groups", d.Id()), nil)
+	if err != nil {
+		return fmt.Errorf("error retrieving the replicas of DCS instance (%s): %s", d.Id(), err)
+	}
+	nodes := utils.PathSearch("group_list[].replication_list[?replication_role=='slave'][].node_id", respBody,
+		make([]interface{}, 0)).([]interface{})
+	if len(nodes) < oldNum-newNum {
+		return fmt.Errorf("the DCS instance (%s) only has %d standby replicas, can not delete %d of them", d.Id(),
+			len(nodes), oldNum-newNum)
+	}
+	opts.NodeList = utils.ExpandToStringList(nodes[len(nodes)-(oldNum-newNum):])
+	return nil
+}
+
+func getDcsReplicaNumFromFlavor(flavor string) int {
+	match := regexp.MustCompile(`\.r(\d+)\.`).FindStringSubmatch(flavor)
+	if len(match) < 2 {
+		return 0
+	}
+	num, _ := strconv.Atoi(match[1])
+	return num
+}
+
+func requestDcsApi(client *golangsdk.ServiceClient, method, path string, params interface{}) (interface{}, error) {
+	requestPath := client.Endpoint + strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	requestOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201, 202, 204},
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	if params != nil {
+		requestOpt.JSONBody = params
+	}
+	resp, err := client.Request(method, requestPath, &requestOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func updateDcsInstanceSsl(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	enable := d.Get("ssl_enable").(bool)
+	params := map[string]interface{}{
+		"enabled": enable,
+	}
+	_, err := requestDcsApi(client, "PUT", fmt.Sprintf("v2/{project_id}/instances/%s/ssl", d.Id()), params)
+	if err != nil {
+		return fmt.Errorf("error updating SSL of DCS instance (%s): %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{strconv.FormatBool(!enable)},
+		Target:  []string{strconv.FormatBool(enable)},
+		Refresh: func() (interface{}, string, error) {
+			r, err := instances.Get(client, d.Id())
+			if err != nil {
+				return nil, "Error", err
+			}
+			return r, strconv.FormatBool(r.EnableSsl), nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for SSL of DCS instance (%s) to be updated: %s", d.Id(), err)
+	}
+	return nil
+}
+
+// updateDcsInstanceParameters applies the parameters on the instance, the instance is restarted only when some of the
+// parameters require a restart and restart_for_parameters is true, otherwise restart_required is set.
+func updateDcsInstanceParameters(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData,
+	timeout time.Duration) error {
+	configPath := fmt.Sprintf("v2/{project_id}/instances/%s/configs", d.Id())
+	configs, err := requestDcsApi(client, "GET", configPath, nil)
+	if err != nil {
+		return fmt.Errorf("error retrieving parameters of DCS instance (%s): %s", d.Id(), err)
+	}
+
+	restartRequired := false
+	oldRaw, newRaw := d.GetChange("parameters")
+	oldParams, newParams := oldRaw.(map[string]interface{}), newRaw.(map[string]interface{})
+	redisConfigs := make([]map[string]interface{}, 0, len(newParams))
+	for name, value := range newParams {
+		if oldParams[name] == value {
+			continue
+		}
+		param := utils.PathSearch(fmt.Sprintf("redis_config[?param_name=='%s']|[0]", name), configs, nil)
+		if param == nil {
+			return fmt.Errorf("the parameter (%s) is not supported by DCS instance (%s)", name, d.Id())
+		}
+		redisConfigs = append(redisConfigs, map[string]interface{}{
+			"param_id":    utils.PathSearch("param_id", param, nil),
+			"param_name":  name,
+			"param_value": value,
+		})
+		if utils.PathSearch("need_restart", param, false).(bool) {
+			restartRequired = true
+		}
+	}
+
+	if len(redisConfigs) > 0 {
+		logp.Printf("[DEBUG] Update parameters of DCS instance (%s): %#v", d.Id(), redisConfigs)
+		params := map[string]interface{}{
+			"redis_config": redisConfigs,
+		}
+		if _, err = requestDcsApi(client, "PUT", configPath, params); err != nil {
+			return fmt.Errorf("error updating parameters of DCS instance (%s): %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{"UPDATING"},
+			Target:  []string{"SUCCESS"},
+			Refresh: func() (interface{}, string, error) {
+				respBody, err := requestDcsApi(client, "GET", configPath, nil)
+				if err != nil {
+					return nil, "Error", err
+				}
+				status := utils.PathSearch("config_status", respBody, "").(string)
+				if status == "FAILURE" {
+					return respBody, status, fmt.Errorf("the parameters failed to be applied")
+				}
+				return respBody, status, nil
+			},
+			Timeout:      timeout,
+			Delay:        5 * time.Second,
+			PollInterval: 5 * time.Second,
+		}
+		if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("error waiting for parameters of DCS instance (%s) to be updated: %s", d.Id(), err)
+		}
+	}
+
+	// keep the pending restart until the instance has been restarted
+	restartRequired = restartRequired || d.Get("restart_required").(bool)
+	if restartRequired && d.Get("restart_for_parameters").(bool) {
+		params := map[string]interface{}{
+			"instances": []string{d.Id()},
+			"action":    "restart",
+		}
+		if _, err = requestDcsApi(client, "PUT", "v2/{project_id}/instances/status", params); err != nil {
+			return fmt.Errorf("error restarting DCS instance (%s) to apply the parameters: %s", d.Id(), err)
+		}
+		err = waitForDcsInstanceCompleted(ctx, client, d.Id(), timeout, []string{"RESTARTING"}, []string{"RUNNING"})
+		if err != nil {
+			return err
+		}
+		restartRequired = false
+	}
+	if restartRequired {
+		logp.Printf("[WARN] the parameters of DCS instance (%s) take effect after the instance is restarted", d.Id())
+	}
+
+	return d.Set("restart_required", restartRequired)
+}
+
+func setDcsInstanceParameters(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	rawParams := d.Get("parameters").(map[string]interface{})
+	if len(rawParams) == 0 {
+		return nil
+	}
+
+	configs, err := requestDcsApi(client, "GET", fmt.Sprintf("v2/{project_id}/instances/%s/configs", d.Id()), nil)
+	if err != nil {
+		return fmt.Errorf("error retrieving parameters of DCS instance (%s): %s", d.Id(), err)
+	}
+
+	// the configs API returns all Redis parameters of the instance, the unmanaged ones are not saved
+	params := make(map[string]interface{})
+	for name := range rawParams {
+		expression := fmt.Sprintf("redis_config[?param_name=='%s']|[0].param_value", name)
+		if value := utils.PathSearch(expression, configs, nil); value != nil {
+			params[name] = value
+		}
+	}
+	return d.Set("parameters", params)
+}
+
 func resourceDcsInstancesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	conf := meta.(*config.Config)
 	client, err := conf.DcsV2Client(conf.GetRegion(d))
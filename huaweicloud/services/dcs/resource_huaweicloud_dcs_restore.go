@@ -0,0 +1,161 @@
+package dcs
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDcsRestore is the impl for huaweicloud_dcs_restore resource, which restores a DCS instance from a backup.
+// The restore record can not be deleted, so destroying the resource only removes it from the state.
+// The resource ID is in the format of <instance_id>/<restore_id>.
+func ResourceDcsRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDcsRestoreCreate,
+		ReadContext:   resourceDcsRestoreRead,
+		DeleteContext: resourceDcsRestoreDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the DCS instance to be restored.`,
+			},
+			"backup_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the backup used to restore the instance.`,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 128),
+				Description:  `Specifies the description of the restoration.`,
+			},
+			"restore_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the ID of the restoration record.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the name of the restoration record.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the restoration status.`,
+			},
+			"progress": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the restoration progress.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the time when the restoration task is created.`,
+			},
+		},
+	}
+}
+
+func resourceDcsRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.DcsV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DCS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	params := map[string]interface{}{
+		"backup_id": d.Get("backup_id"),
+		"remark":    utils.ValueIngoreEmpty(d.Get("description")),
+	}
+	createRespBody, err := requestDcsApi(client, "POST",
+		fmt.Sprintf("v2/{project_id}/instances/%s/restores", instanceId), params)
+	if err != nil {
+		return diag.Errorf("error restoring DCS instance (%s): %s", instanceId, err)
+	}
+
+	restoreId := utils.PathSearch("restore_id", createRespBody, "").(string)
+	if restoreId == "" {
+		return diag.Errorf("unable to find the restoration ID from the API response")
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceId, restoreId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"waiting", "restoring"},
+		Target:       []string{"succeed"},
+		Refresh:      dcsInstanceRecordStatusRefreshFunc(client, instanceId, "restores", restoreId),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for DCS instance (%s) to be restored: %s", instanceId, err)
+	}
+
+	return resourceDcsRestoreRead(ctx, d, meta)
+}
+
+func resourceDcsRestoreRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.DcsV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating DCS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	restoreId := strings.TrimPrefix(d.Id(), instanceId+"/")
+	restore, err := getDcsInstanceRecord(client, instanceId, "restores", restoreId)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DCS restoration record")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("restore_id", restoreId),
+		d.Set("backup_id", utils.PathSearch("backup_id", restore, nil)),
+		d.Set("description", utils.PathSearch("remark", restore, nil)),
+		d.Set("name", utils.PathSearch("restore_name", restore, nil)),
+		d.Set("status", utils.PathSearch("status", restore, nil)),
+		d.Set("progress", utils.PathSearch("progress", restore, nil)),
+		d.Set("created_at", utils.PathSearch("created_at", restore, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDcsRestoreDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
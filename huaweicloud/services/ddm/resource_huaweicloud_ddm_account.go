@@ -0,0 +1,256 @@
+package ddm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDdmAccount is the impl for huaweicloud_ddm_account resource.
+// The resource ID is in the format of <instance_id>/<name>.
+func ResourceDdmAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDdmAccountCreate,
+		ReadContext:   resourceDdmAccountRead,
+		UpdateContext: resourceDdmAccountUpdate,
+		DeleteContext: resourceDdmAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDdmAccountImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the DDM instance.`,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+				Description:  `Specifies the name of the DDM account.`,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: `Specifies the password of the DDM account.`,
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{
+						"CREATE", "DROP", "ALTER", "INDEX", "INSERT", "DELETE", "UPDATE", "SELECT",
+					}, false),
+				},
+				Description: `Specifies the basic permissions of the DDM account.`,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+				Description:  `Specifies the description of the DDM account.`,
+			},
+			"schemas": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the names of the schemas associated with the DDM account.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the status of the DDM account.`,
+			},
+		},
+	}
+}
+
+func buildDdmAccountSchemas(d *schema.ResourceData) []map[string]interface{} {
+	names := d.Get("schemas").(*schema.Set).List()
+	schemas := make([]map[string]interface{}, 0, len(names))
+	for _, name := range names {
+		schemas = append(schemas, map[string]interface{}{
+			"name": name,
+		})
+	}
+	return schemas
+}
+
+func resourceDdmAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("ddm", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DDM client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	name := d.Get("name").(string)
+	params := map[string]interface{}{
+		"users": []map[string]interface{}{
+			{
+				"name":           name,
+				"password":       d.Get("password"),
+				"base_authority": d.Get("permissions").(*schema.Set).List(),
+				"description":    utils.ValueIngoreEmpty(d.Get("description")),
+				"databases":      buildDdmAccountSchemas(d),
+			},
+		},
+	}
+	_, err = requestDdm(client, "POST", buildDdmInstancePath(client, "v1", instanceId, "/users"), params)
+	if err != nil {
+		return diag.Errorf("error creating DDM account: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceId, name))
+
+	return resourceDdmAccountRead(ctx, d, meta)
+}
+
+func getDdmAccount(client *golangsdk.ServiceClient, instanceId, name string) (interface{}, error) {
+	listPath := buildDdmInstancePath(client, "v1", instanceId, "/users?limit=128")
+	offset := 0
+	for {
+		respBody, err := requestDdm(client, "GET", fmt.Sprintf("%s&offset=%d", listPath, offset), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		users := utils.PathSearch("users", respBody, make([]interface{}, 0)).([]interface{})
+		user := utils.PathSearch(fmt.Sprintf("[?name=='%s']|[0]", name), users, nil)
+		if user != nil {
+			return user, nil
+		}
+		if len(users) < 128 {
+			return nil, golangsdk.ErrDefault404{}
+		}
+		offset += len(users)
+	}
+}
+
+func resourceDdmAccountRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("ddm", region)
+	if err != nil {
+		return diag.Errorf("error creating DDM client: %s", err)
+	}
+
+	user, err := getDdmAccount(client, d.Get("instance_id").(string), d.Get("name").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DDM account")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("permissions", utils.PathSearch("base_authority", user, nil)),
+		d.Set("description", utils.PathSearch("description", user, nil)),
+		d.Set("schemas", utils.PathSearch("databases[].name", user, nil)),
+		d.Set("status", utils.PathSearch("status", user, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDdmAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("ddm", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DDM client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	name := d.Get("name").(string)
+	if d.HasChanges("permissions", "description", "schemas") {
+		params := map[string]interface{}{
+			"user": map[string]interface{}{
+				"base_authority": d.Get("permissions").(*schema.Set).List(),
+				"description":    d.Get("description"),
+				"databases":      buildDdmAccountSchemas(d),
+			},
+		}
+		_, err = requestDdm(client, "PUT", buildDdmInstancePath(client, "v1", instanceId, "/users/"+name), params)
+		if err != nil {
+			return diag.Errorf("error updating DDM account (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("password") {
+		params := map[string]interface{}{
+			"password": d.Get("password"),
+		}
+		_, err = requestDdm(client, "PUT", buildDdmInstancePath(client, "v2", instanceId,
+			"/users/"+name+"/password"), params)
+		if err != nil {
+			return diag.Errorf("error updating password of DDM account (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceDdmAccountRead(ctx, d, meta)
+}
+
+func resourceDdmAccountDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("ddm", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DDM client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	deletePath := buildDdmInstancePath(client, "v1", instanceId, "/users/"+d.Get("name").(string))
+	if _, err = requestDdm(client, "DELETE", deletePath, nil); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DDM account")
+	}
+
+	return nil
+}
+
+func resourceDdmAccountImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <instance_id>/<name>")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", parts[0]),
+		d.Set("name", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
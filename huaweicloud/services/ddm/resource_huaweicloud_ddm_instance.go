@@ -0,0 +1,531 @@
+package ddm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+var regexpDdmInstanceName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9-]*$`)
+
+// ResourceDdmInstance is the impl for huaweicloud_ddm_instance resource.
+func ResourceDdmInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDdmInstanceCreate,
+		ReadContext:   resourceDdmInstanceRead,
+		UpdateContext: resourceDdmInstanceUpdate,
+		DeleteContext: resourceDdmInstanceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(15 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(4, 64),
+					validation.StringMatch(regexpDdmInstanceName,
+						"the name must start with a letter and can only contain letters, digits and hyphens (-)"),
+				),
+				Description: `Specifies the name of the DDM instance.`,
+			},
+			"flavor_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the flavor ID of the DDM instance nodes.`,
+			},
+			"node_num": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 32),
+				Description:  `Specifies the number of the DDM instance nodes.`,
+			},
+			"engine_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the engine ID of the DDM instance.`,
+			},
+			"availability_zones": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the availability zones where the DDM instance nodes are located.`,
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the VPC to which the DDM instance belongs.`,
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the subnet to which the DDM instance belongs.`,
+			},
+			"security_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the ID of the security group to which the DDM instance belongs.`,
+			},
+			"param_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: `Specifies the ID of the parameter group used by the DDM instance.`,
+			},
+			"time_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: `Specifies the time zone of the DDM instance.`,
+			},
+			"admin_user": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"admin_password"},
+				Description:  `Specifies the name of the administrator of the DDM instance.`,
+			},
+			"admin_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"admin_user"},
+				Description:  `Specifies the password of the administrator of the DDM instance.`,
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: `Specifies the enterprise project ID of the DDM instance.`,
+			},
+			"delete_rds_data": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: `Specifies whether to delete the data of the associated RDS instances when deleting.`,
+			},
+
+			"charging_mode": common.SchemaChargingMode(nil),
+			"period_unit":   common.SchemaPeriodUnit(nil),
+			"period":        common.SchemaPeriod(nil),
+			"auto_renew":    common.SchemaAutoRenewUpdatable(nil),
+			"auto_pay":      common.SchemaAutoPay(nil),
+
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the status of the DDM instance.`,
+			},
+			"access_ip": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the address for accessing the DDM instance.`,
+			},
+			"access_port": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the port for accessing the DDM instance.`,
+			},
+			"engine_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the engine version of the DDM instance.`,
+			},
+			"nodes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Indicates the nodes of the DDM instance.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the ID of the node.`,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the status of the node.`,
+						},
+						"ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the IP address of the node.`,
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `Indicates the port of the node.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildDdmInstancePath(client *golangsdk.ServiceClient, version, instanceId, subPath string) string {
+	path := client.Endpoint + version + "/{project_id}/instances/{instance_id}" + subPath
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	return strings.ReplaceAll(path, "{instance_id}", instanceId)
+}
+
+// requestDdm sends the request of the DDM API and returns the flattened response body.
+func requestDdm(client *golangsdk.ServiceClient, method, path string, params interface{}) (interface{}, error) {
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 201, 202, 204,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	}
+	if params != nil {
+		opt.JSONBody = params
+	}
+	resp, err := client.Request(method, path, &opt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func buildDdmInstanceChargeInfo(d *schema.ResourceData) interface{} {
+	if d.Get("charging_mode").(string) != "prePaid" {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"charge_mode":   "prePaid",
+		"period_type":   d.Get("period_unit"),
+		"period_num":    d.Get("period"),
+		"is_auto_renew": d.Get("auto_renew").(string) == "true",
+		"is_auto_pay":   true,
+	}
+}
+
+func resourceDdmInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("ddm", region)
+	if err != nil {
+		return diag.Errorf("error creating DDM client: %s", err)
+	}
+
+	createPath := client.Endpoint + "v1/{project_id}/instances"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	params := map[string]interface{}{
+		"instance": map[string]interface{}{
+			"name":                  d.Get("name"),
+			"flavor_id":             d.Get("flavor_id"),
+			"node_num":              d.Get("node_num"),
+			"engine_id":             d.Get("engine_id"),
+			"available_zones":       d.Get("availability_zones"),
+			"vpc_id":                d.Get("vpc_id"),
+			"subnet_id":             d.Get("subnet_id"),
+			"security_group_id":     d.Get("security_group_id"),
+			"param_group_id":        utils.ValueIngoreEmpty(d.Get("param_group_id")),
+			"time_zone":             utils.ValueIngoreEmpty(d.Get("time_zone")),
+			"admin_user_name":       utils.ValueIngoreEmpty(d.Get("admin_user")),
+			"admin_user_password":   utils.ValueIngoreEmpty(d.Get("admin_password")),
+			"enterprise_project_id": utils.ValueIngoreEmpty(common.GetEnterpriseProjectID(d, cfg)),
+		},
+		"extend_param": buildDdmInstanceChargeInfo(d),
+	}
+	createRespBody, err := requestDdm(client, "POST", createPath, utils.RemoveNil(params))
+	if err != nil {
+		return diag.Errorf("error creating DDM instance: %s", err)
+	}
+
+	instanceId := utils.PathSearch("id", createRespBody, "").(string)
+	if orderId := utils.PathSearch("order_id", createRespBody, "").(string); orderId != "" {
+		bssClient, err := cfg.BssV2Client(region)
+		if err != nil {
+			return diag.Errorf("error creating BSS v2 client: %s", err)
+		}
+		if err = common.WaitOrderComplete(ctx, bssClient, orderId, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return diag.FromErr(err)
+		}
+		instanceId, err = common.WaitOrderResourceComplete(ctx, bssClient, orderId, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if instanceId == "" {
+		return diag.Errorf("unable to find the DDM instance ID from the API response")
+	}
+	d.SetId(instanceId)
+
+	if err = waitForDdmInstanceRunning(ctx, client, instanceId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for DDM instance (%s) creation to complete: %s", instanceId, err)
+	}
+
+	return resourceDdmInstanceRead(ctx, d, meta)
+}
+
+func getDdmInstance(client *golangsdk.ServiceClient, instanceId string) (interface{}, error) {
+	return requestDdm(client, "GET", buildDdmInstancePath(client, "v1", instanceId, ""), nil)
+}
+
+func waitForDdmInstanceRunning(ctx context.Context, client *golangsdk.ServiceClient, instanceId string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"RUNNING"},
+		Refresh: func() (interface{}, string, error) {
+			respBody, err := getDdmInstance(client, instanceId)
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			status := utils.PathSearch("status", respBody, "").(string)
+			switch status {
+			case "RUNNING":
+				return respBody, status, nil
+			case "CREATEFAIL", "ERROR", "ABNORMAL":
+				return respBody, status, fmt.Errorf("the DDM instance is in %s status", status)
+			default:
+				return respBody, "PENDING", nil
+			}
+		},
+		Timeout:      timeout,
+		Delay:        30 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceDdmInstanceRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("ddm", region)
+	if err != nil {
+		return diag.Errorf("error creating DDM client: %s", err)
+	}
+
+	instance, err := getDdmInstance(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DDM instance")
+	}
+	if utils.PathSearch("status", instance, "").(string) == "DELETED" {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving DDM instance")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", instance, nil)),
+		d.Set("node_num", utils.PathSearch("node_count", instance, nil)),
+		d.Set("vpc_id", utils.PathSearch("vpc_id", instance, nil)),
+		d.Set("subnet_id", utils.PathSearch("subnet_id", instance, nil)),
+		d.Set("security_group_id", utils.PathSearch("security_group_id", instance, nil)),
+		d.Set("enterprise_project_id", utils.PathSearch("enterprise_project_id", instance, nil)),
+		d.Set("status", utils.PathSearch("status", instance, nil)),
+		d.Set("access_ip", utils.PathSearch("access_ip", instance, nil)),
+		d.Set("access_port", utils.PathSearch("access_port", instance, nil)),
+		d.Set("engine_version", utils.PathSearch("engine_version", instance, nil)),
+		d.Set("nodes", flattenDdmInstanceNodes(instance)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenDdmInstanceNodes(instance interface{}) []interface{} {
+	rawNodes := utils.PathSearch("nodes", instance, make([]interface{}, 0)).([]interface{})
+	nodes := make([]interface{}, 0, len(rawNodes))
+	for _, node := range rawNodes {
+		nodes = append(nodes, map[string]interface{}{
+			"id":     utils.PathSearch("node_id", node, nil),
+			"status": utils.PathSearch("status", node, nil),
+			"ip":     utils.PathSearch("ip", node, nil),
+			"port":   utils.PathSearch("port", node, nil),
+		})
+	}
+	return nodes
+}
+
+func resourceDdmInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("ddm", region)
+	if err != nil {
+		return diag.Errorf("error creating DDM client: %s", err)
+	}
+
+	instanceId := d.Id()
+	if d.HasChange("name") {
+		params := map[string]interface{}{
+			"name": d.Get("name"),
+		}
+		_, err = requestDdm(client, "PUT", buildDdmInstancePath(client, "v1", instanceId, "/modify-name"), params)
+		if err != nil {
+			return diag.Errorf("error updating name of DDM instance (%s): %s", instanceId, err)
+		}
+	}
+
+	if d.HasChange("security_group_id") {
+		params := map[string]interface{}{
+			"security_group_id": d.Get("security_group_id"),
+		}
+		_, err = requestDdm(client, "PUT", buildDdmInstancePath(client, "v1", instanceId, "/modify-security-group"),
+			params)
+		if err != nil {
+			return diag.Errorf("error updating security group of DDM instance (%s): %s", instanceId, err)
+		}
+	}
+
+	if d.HasChange("flavor_id") {
+		params := map[string]interface{}{
+			"flavor_id":   d.Get("flavor_id"),
+			"is_auto_pay": true,
+		}
+		err = updateDdmInstanceAndWait(ctx, cfg, client, d, "PUT", buildDdmInstancePath(client, "v3", instanceId,
+			"/flavor"), params)
+		if err != nil {
+			return diag.Errorf("error updating flavor of DDM instance (%s): %s", instanceId, err)
+		}
+	}
+
+	if d.HasChange("node_num") {
+		if err = updateDdmInstanceNodeNum(ctx, cfg, client, d); err != nil {
+			return diag.Errorf("error updating node number of DDM instance (%s): %s", instanceId, err)
+		}
+	}
+
+	if d.HasChange("auto_renew") {
+		bssClient, err := cfg.BssV2Client(region)
+		if err != nil {
+			return diag.Errorf("error creating BSS V2 client: %s", err)
+		}
+		if err = common.UpdateAutoRenew(bssClient, d.Get("auto_renew").(string), instanceId); err != nil {
+			return diag.Errorf("error updating the auto-renew of DDM instance (%s): %s", instanceId, err)
+		}
+	}
+
+	return resourceDdmInstanceRead(ctx, d, meta)
+}
+
+func updateDdmInstanceNodeNum(ctx context.Context, cfg *config.Config, client *golangsdk.ServiceClient,
+	d *schema.ResourceData) error {
+	oldNum, newNum := d.GetChange("node_num")
+	delta := newNum.(int) - oldNum.(int)
+	if delta > 0 {
+		params := map[string]interface{}{
+			"flavor_id":   d.Get("flavor_id"),
+			"node_number": delta,
+			"is_auto_pay": true,
+		}
+		return updateDdmInstanceAndWait(ctx, cfg, client, d, "POST", buildDdmInstancePath(client, "v1", d.Id(),
+			"/action/enlarge"), params)
+	}
+
+	params := map[string]interface{}{
+		"number_of_nodes": -delta,
+		"is_auto_pay":     true,
+	}
+	return updateDdmInstanceAndWait(ctx, cfg, client, d, "POST", buildDdmInstancePath(client, "v2", d.Id(),
+		"/action/reduce"), params)
+}
+
+// updateDdmInstanceAndWait sends the change request, waits for the order to be paid for the prePaid instance, and
+// then waits for the instance to be running again.
+func updateDdmInstanceAndWait(ctx context.Context, cfg *config.Config, client *golangsdk.ServiceClient,
+	d *schema.ResourceData, method, path string, params map[string]interface{}) error {
+	log.Printf("[DEBUG] Update DDM instance (%s) options: %#v", d.Id(), params)
+	respBody, err := requestDdm(client, method, path, params)
+	if err != nil {
+		return err
+	}
+
+	if orderId := utils.PathSearch("order_id", respBody, "").(string); orderId != "" {
+		bssClient, err := cfg.BssV2Client(cfg.GetRegion(d))
+		if err != nil {
+			return fmt.Errorf("error creating BSS v2 client: %s", err)
+		}
+		if err = common.WaitOrderComplete(ctx, bssClient, orderId, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return waitForDdmInstanceRunning(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+}
+
+func resourceDdmInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("ddm", region)
+	if err != nil {
+		return diag.Errorf("error creating DDM client: %s", err)
+	}
+
+	if d.Get("charging_mode").(string) == "prePaid" {
+		if err = common.UnsubscribePrePaidResource(d, cfg, []string{d.Id()}); err != nil {
+			return diag.Errorf("error unsubscribing DDM instance (%s): %s", d.Id(), err)
+		}
+	} else {
+		deletePath := buildDdmInstancePath(client, "v1", d.Id(), "")
+		deletePath += fmt.Sprintf("?delete_rds_data=%t", d.Get("delete_rds_data").(bool))
+		if _, err = requestDdm(client, "DELETE", deletePath, nil); err != nil {
+			return common.CheckDeletedDiag(d, err, "error deleting DDM instance")
+		}
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			respBody, err := getDdmInstance(client, d.Id())
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return "", "DELETED", nil
+				}
+				return nil, "ERROR", err
+			}
+			if utils.PathSearch("status", respBody, "").(string) == "DELETED" {
+				return respBody, "DELETED", nil
+			}
+			return respBody, "PENDING", nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for DDM instance (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,324 @@
+package ddm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDdmSchema is the impl for huaweicloud_ddm_schema resource, which creates a logical schema of the DDM instance
+// and shards it across the associated RDS MySQL instances.
+// The resource ID is in the format of <instance_id>/<name>.
+func ResourceDdmSchema() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDdmSchemaCreate,
+		ReadContext:   resourceDdmSchemaRead,
+		UpdateContext: resourceDdmSchemaUpdate,
+		DeleteContext: resourceDdmSchemaDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDdmSchemaImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the DDM instance.`,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(2, 48),
+				Description:  `Specifies the name of the logical schema.`,
+			},
+			"shard_mode": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"cluster", "single"}, false),
+				Description:  `Specifies the sharding mode of the schema.`,
+			},
+			"shard_number": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the total number of shards of the schema.`,
+			},
+			"data_nodes": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the RDS MySQL instances associated with the schema.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: `Specifies the ID of the RDS MySQL instance.`,
+						},
+						"admin_user": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: `Specifies the administrator account of the RDS MySQL instance.`,
+						},
+						"admin_password": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Sensitive:   true,
+							Description: `Specifies the password of the administrator account.`,
+						},
+					},
+				},
+			},
+			"delete_rds_data": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: `Specifies whether to delete the data stored on the RDS instances when deleting.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the status of the schema.`,
+			},
+			"shards": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Indicates the shards of the schema.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db_slot": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `Indicates the number of the shard.`,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the name of the physical database.`,
+						},
+						"rds_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the ID of the RDS instance where the shard is located.`,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the status of the shard.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildDdmSchemaDataNodes(d *schema.ResourceData) []map[string]interface{} {
+	rawNodes := d.Get("data_nodes").([]interface{})
+	nodes := make([]map[string]interface{}, 0, len(rawNodes))
+	for _, v := range rawNodes {
+		node := v.(map[string]interface{})
+		nodes = append(nodes, map[string]interface{}{
+			"id":            node["id"],
+			"adminUser":     node["admin_user"],
+			"adminPassword": node["admin_password"],
+		})
+	}
+	return nodes
+}
+
+func resourceDdmSchemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("ddm", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DDM client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	name := d.Get("name").(string)
+	params := map[string]interface{}{
+		"databases": []map[string]interface{}{
+			{
+				"name":         name,
+				"shard_mode":   d.Get("shard_mode"),
+				"shard_number": d.Get("shard_number"),
+				"used_rds":     buildDdmSchemaDataNodes(d),
+			},
+		},
+	}
+	_, err = requestDdm(client, "POST", buildDdmInstancePath(client, "v1", instanceId, "/databases"), params)
+	if err != nil {
+		return diag.Errorf("error creating DDM schema: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceId, name))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"CREATING"},
+		Target:  []string{"RUNNING"},
+		Refresh: func() (interface{}, string, error) {
+			schemaDetail, err := getDdmSchema(client, instanceId, name)
+			if err != nil {
+				return nil, "ERROR", err
+			}
+			status := utils.PathSearch("status", schemaDetail, "").(string)
+			if status == "CREATE_FAILED" {
+				return schemaDetail, status, fmt.Errorf("the schema failed to be created")
+			}
+			return schemaDetail, status, nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for DDM schema (%s) to be created: %s", d.Id(), err)
+	}
+
+	return resourceDdmSchemaRead(ctx, d, meta)
+}
+
+func getDdmSchema(client *golangsdk.ServiceClient, instanceId, name string) (interface{}, error) {
+	respBody, err := requestDdm(client, "GET", buildDdmInstancePath(client, "v1", instanceId, "/databases/"+name), nil)
+	if err != nil {
+		return nil, err
+	}
+	schemaDetail := utils.PathSearch("database", respBody, nil)
+	if schemaDetail == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return schemaDetail, nil
+}
+
+func resourceDdmSchemaRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("ddm", region)
+	if err != nil {
+		return diag.Errorf("error creating DDM client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	schemaDetail, err := getDdmSchema(client, instanceId, name)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DDM schema")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("shard_mode", utils.PathSearch("shard_mode", schemaDetail, nil)),
+		d.Set("shard_number", utils.PathSearch("shard_number", schemaDetail, nil)),
+		d.Set("status", utils.PathSearch("status", schemaDetail, nil)),
+		d.Set("shards", flattenDdmSchemaShards(schemaDetail)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenDdmSchemaShards(schemaDetail interface{}) []interface{} {
+	rawShards := utils.PathSearch("databases", schemaDetail, make([]interface{}, 0)).([]interface{})
+	shards := make([]interface{}, 0, len(rawShards))
+	for _, shard := range rawShards {
+		shards = append(shards, map[string]interface{}{
+			"db_slot": utils.PathSearch("dbslot", shard, nil),
+			"name":    utils.PathSearch("name", shard, nil),
+			"rds_id":  utils.PathSearch("id", shard, nil),
+			"status":  utils.PathSearch("status", shard, nil),
+		})
+	}
+	return shards
+}
+
+// resourceDdmSchemaUpdate only saves delete_rds_data, which is used when deleting the schema.
+func resourceDdmSchemaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceDdmSchemaRead(ctx, d, meta)
+}
+
+func resourceDdmSchemaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("ddm", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DDM client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	name := d.Get("name").(string)
+	deletePath := buildDdmInstancePath(client, "v1", instanceId, "/databases/"+name)
+	deletePath += fmt.Sprintf("?delete_rds_data=%t", d.Get("delete_rds_data").(bool))
+	if _, err = requestDdm(client, "DELETE", deletePath, nil); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DDM schema")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"DELETED"},
+		Refresh: func() (interface{}, string, error) {
+			schemaDetail, err := getDdmSchema(client, instanceId, name)
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return "", "DELETED", nil
+				}
+				return nil, "ERROR", err
+			}
+			return schemaDetail, "PENDING", nil
+		},
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for DDM schema (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceDdmSchemaImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <instance_id>/<name>")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", parts[0]),
+		d.Set("name", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
@@ -3,7 +3,9 @@ package dds
 import (
 	"context"
 	"fmt"
+	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/chnsz/golangsdk"
@@ -32,6 +34,8 @@ func ResourceDdsInstanceV3() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceDdsInstanceFlavorCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -132,15 +136,13 @@ func ResourceDdsInstanceV3() *schema.Resource {
 			"flavor": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": {
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 							ValidateFunc: validation.StringInSlice([]string{
-								"mongos", "shard", "config", "replica", "single",
+								"mongos", "shard", "config", "replica", "single", "readonly",
 							}, true),
 						},
 						"num": {
@@ -150,7 +152,6 @@ func ResourceDdsInstanceV3() *schema.Resource {
 						"storage": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 							ValidateFunc: validation.StringInSlice([]string{
 								"ULTRAHIGH",
 							}, true),
@@ -166,6 +167,51 @@ func ResourceDdsInstanceV3() *schema.Resource {
 					},
 				},
 			},
+			"configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"mongos", "shard", "config", "replica", "single",
+							}, true),
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"restore": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"backup_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"restore.0.restore_time"},
+						},
+						"restore_time": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 			"backup_strategy": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -268,6 +314,10 @@ func resourceDdsFlavors(d *schema.ResourceData) []instances.Flavor {
 	logp.Printf("[DEBUG] flavorRaw: %+v", flavorRaw)
 	for i := range flavorRaw {
 		flavor := flavorRaw[i].(map[string]interface{})
+		// the read-only nodes are added after the instance is created
+		if flavor["type"].(string) == "readonly" {
+			continue
+		}
 		flavorReq := instances.Flavor{
 			Type:     flavor["type"].(string),
 			Num:      flavor["num"].(int),
@@ -281,6 +331,72 @@ func resourceDdsFlavors(d *schema.ResourceData) []instances.Flavor {
 	return flavors
 }
 
+// ddsCreateOpts is used to create a new instance with the parameter templates, or from the backup or the point in time
+// of an existing instance.
+type ddsCreateOpts struct {
+	instances.CreateOpts
+	Configurations []configurationOpts `json:"configurations,omitempty"`
+	RestorePoint   *restorePoint       `json:"restore_point,omitempty"`
+}
+
+type configurationOpts struct {
+	Type            string `json:"type"`
+	ConfigurationId string `json:"configuration_id"`
+}
+
+type restorePoint struct {
+	InstanceId  string `json:"instance_id"`
+	Type        string `json:"type"`
+	BackupId    string `json:"backup_id,omitempty"`
+	RestoreTime int    `json:"restore_time,omitempty"`
+}
+
+func (opts ddsCreateOpts) ToInstancesCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOpts.ToInstancesCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	if len(opts.Configurations) > 0 {
+		b["configurations"] = opts.Configurations
+	}
+	if opts.RestorePoint != nil {
+		b["restore_point"] = opts.RestorePoint
+	}
+	return b, nil
+}
+
+func resourceDdsConfigurations(d *schema.ResourceData) []configurationOpts {
+	configurationRaw := d.Get("configuration").([]interface{})
+	configurations := make([]configurationOpts, 0, len(configurationRaw))
+	for _, v := range configurationRaw {
+		raw := v.(map[string]interface{})
+		configurations = append(configurations, configurationOpts{
+			Type:            raw["type"].(string),
+			ConfigurationId: raw["id"].(string),
+		})
+	}
+	return configurations
+}
+
+func resourceDdsRestorePoint(d *schema.ResourceData) *restorePoint {
+	restoreRaw := d.Get("restore").([]interface{})
+	if len(restoreRaw) < 1 {
+		return nil
+	}
+
+	raw := restoreRaw[0].(map[string]interface{})
+	point := restorePoint{
+		InstanceId:  raw["instance_id"].(string),
+		BackupId:    raw["backup_id"].(string),
+		RestoreTime: raw["restore_time"].(int),
+		Type:        "backup",
+	}
+	if point.BackupId == "" {
+		point.Type = "timestamp"
+	}
+	return &point
+}
+
 func resourceDdsBackupStrategy(d *schema.ResourceData) instances.BackupStrategy {
 	var backupStrategy instances.BackupStrategy
 	backupStrategyRaw := d.Get("backup_strategy").([]interface{})
@@ -379,7 +495,12 @@ func resourceDdsInstanceV3Create(ctx context.Context, d *schema.ResourceData, me
 		createOpts.Port = strconv.Itoa(val.(int))
 	}
 
-	instance, err := instances.Create(client, createOpts).Extract()
+	ddsOpts := ddsCreateOpts{
+		CreateOpts:     createOpts,
+		Configurations: resourceDdsConfigurations(d),
+		RestorePoint:   resourceDdsRestorePoint(d),
+	}
+	instance, err := instances.Create(client, ddsOpts).Extract()
 	if err != nil {
 		return fmtp.DiagErrorf("Error getting instance from result: %s ", err)
 	}
@@ -430,6 +551,17 @@ func resourceDdsInstanceV3Create(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	// the read-only nodes can only be added to the replica set instance after it is created
+	for _, v := range d.Get("flavor").([]interface{}) {
+		flavor := v.(map[string]interface{})
+		if flavor["type"].(string) == "readonly" && flavor["num"].(int) > 0 {
+			err = addReadonlyNodes(ctx, config, client, d, flavor["spec_code"].(string), flavor["num"].(int))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
 	return resourceDdsInstanceV3Read(ctx, d, meta)
 }
 
@@ -505,6 +637,12 @@ func resourceDdsInstanceV3Read(_ context.Context, d *schema.ResourceData, meta i
 	backupStrategyList = append(backupStrategyList, backupStrategy)
 	mErr = multierror.Append(mErr, d.Set("backup_strategy", backupStrategyList))
 
+	configurations, err := flattenDdsInstanceConfigurations(client, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	mErr = multierror.Append(mErr, d.Set("configuration", configurations))
+
 	// save tags
 	if resourceTags, err := tags.Get(client, "instances", d.Id()).Extract(); err == nil {
 		tagmap := utils.TagsToMap(resourceTags.Tags)
@@ -654,13 +792,30 @@ func resourceDdsInstanceV3Update(ctx context.Context, d *schema.ResourceData, me
 		}
 	}
 
+	if d.HasChange("configuration") {
+		if err = applyDdsInstanceConfigurations(ctx, client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// update flavor
 	if d.HasChange("flavor") {
+		oldFlavors, _ := d.GetChange("flavor")
 		for i := range d.Get("flavor").([]interface{}) {
 			numIndex := fmt.Sprintf("flavor.%d.num", i)
 			volumeSizeIndex := fmt.Sprintf("flavor.%d.size", i)
 			specCodeIndex := fmt.Sprintf("flavor.%d.spec_code", i)
 
+			// the appended flavor can only be the readonly flavor, the read-only nodes are added with its spec code
+			if i >= len(oldFlavors.([]interface{})) {
+				if d.Get(numIndex).(int) > 0 {
+					if err := flavorNumUpdate(ctx, config, client, d, i); err != nil {
+						return diag.FromErr(err)
+					}
+				}
+				continue
+			}
+
 			// The update operation of the volume size must ahead of the update operation of the number. Because the
 			// size and number are updated at the same time and the number is increased, and the request will fail.
 			// For example, when the number is increased from 2 to 3, and the size of all nodes is increased from 20 to
@@ -819,6 +974,254 @@ func getDdsInstanceV3MongosNodeID(client *golangsdk.ServiceClient, d *schema.Res
 
 }
 
+func getDdsInstanceV3ReadonlyNodeID(client *golangsdk.ServiceClient, d *schema.ResourceData) ([]string, error) {
+	nodeIDs := make([]string, 0)
+
+	instanceID := d.Id()
+	opts := instances.ListInstanceOpts{
+		Id: instanceID,
+	}
+	allPages, err := instances.List(client, &opts).AllPages()
+	if err != nil {
+		return nodeIDs, fmt.Errorf("error fetching DDS instance: %s", err)
+	}
+	instances, err := instances.ExtractInstances(allPages)
+	if err != nil {
+		return nodeIDs, fmt.Errorf("error extracting DDS instance: %s", err)
+	}
+	if instances.TotalCount == 0 {
+		log.Printf("[WARN] DDS instance (%s) was not found", instanceID)
+		return nodeIDs, nil
+	}
+
+	for _, group := range instances.Instances[0].Groups {
+		for _, node := range group.Nodes {
+			if node.Role == "ReadOnly" {
+				nodeIDs = append(nodeIDs, node.Id)
+			}
+		}
+	}
+	return nodeIDs, nil
+}
+
+// getDdsInstanceConfigurationEntityIDs returns the IDs of the entities which the parameter template of the specified
+// type is applied to: the instance itself for replica set and single node instances, the groups for shard and config
+// nodes, and the nodes for mongos.
+func getDdsInstanceConfigurationEntityIDs(client *golangsdk.ServiceClient, d *schema.ResourceData,
+	nodeType string) ([]string, error) {
+	switch nodeType {
+	case "replica", "single":
+		return []string{d.Id()}, nil
+	case "mongos":
+		return getDdsInstanceV3MongosNodeID(client, d)
+	}
+
+	entityIDs := make([]string, 0)
+	allPages, err := instances.List(client, &instances.ListInstanceOpts{Id: d.Id()}).AllPages()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching DDS instance: %s", err)
+	}
+	instanceList, err := instances.ExtractInstances(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting DDS instance: %s", err)
+	}
+	if instanceList.TotalCount == 0 {
+		return entityIDs, nil
+	}
+	for _, group := range instanceList.Instances[0].Groups {
+		if group.Type == nodeType {
+			entityIDs = append(entityIDs, group.Id)
+		}
+	}
+	return entityIDs, nil
+}
+
+// applyDdsInstanceConfigurations applies the parameter templates which are newly specified or changed.
+func applyDdsInstanceConfigurations(ctx context.Context, client *golangsdk.ServiceClient,
+	d *schema.ResourceData) error {
+	oldRaw, _ := d.GetChange("configuration")
+	applied := make(map[string]string)
+	for _, v := range oldRaw.([]interface{}) {
+		raw := v.(map[string]interface{})
+		applied[raw["type"].(string)] = raw["id"].(string)
+	}
+
+	for _, configuration := range resourceDdsConfigurations(d) {
+		if applied[configuration.Type] == configuration.ConfigurationId {
+			continue
+		}
+
+		entityIDs, err := getDdsInstanceConfigurationEntityIDs(client, d, configuration.Type)
+		if err != nil {
+			return err
+		}
+		applyPath := client.Endpoint + "v3/{project_id}/configurations/{config_id}/apply"
+		applyPath = strings.ReplaceAll(applyPath, "{project_id}", client.ProjectID)
+		applyPath = strings.ReplaceAll(applyPath, "{config_id}", configuration.ConfigurationId)
+		applyOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes: []int{
+				200,
+			},
+			MoreHeaders: map[string]string{"Content-Type": "application/json"},
+			JSONBody: map[string]interface{}{
+				"entity_ids": entityIDs,
+			},
+		}
+		applyResp, err := client.Request("PUT", applyPath, &applyOpt)
+		if err != nil {
+			return fmt.Errorf("error applying parameter template (%s) to DDS instance (%s): %s",
+				configuration.ConfigurationId, d.Id(), err)
+		}
+		applyRespBody, err := utils.FlattenResponse(applyResp)
+		if err != nil {
+			return err
+		}
+
+		jobID := utils.PathSearch("job_id", applyRespBody, "").(string)
+		if jobID == "" {
+			continue
+		}
+		stateConf := &resource.StateChangeConf{
+			Pending:      []string{"Running"},
+			Target:       []string{"Completed"},
+			Refresh:      JobStateRefreshFunc(client, jobID),
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
+			PollInterval: 10 * time.Second,
+		}
+		if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("error waiting for the job (%s) completed: %s", jobID, err)
+		}
+	}
+	return nil
+}
+
+func getDdsApiResource(client *golangsdk.ServiceClient, path string) (interface{}, error) {
+	getPath := client.Endpoint + strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(getResp)
+}
+
+// isDdsConfigurationApplied checks whether the parameters of the nodes are the same as the values in the template.
+func isDdsConfigurationApplied(client *golangsdk.ServiceClient, d *schema.ResourceData, nodeType,
+	configID string) (bool, error) {
+	template, err := getDdsApiResource(client, "v3/{project_id}/configurations/"+configID)
+	if err != nil {
+		if _, ok := err.(golangsdk.ErrDefault404); ok {
+			return false, nil
+		}
+		return false, fmt.Errorf("error retrieving DDS parameter template (%s): %s", configID, err)
+	}
+
+	entityIDs, err := getDdsInstanceConfigurationEntityIDs(client, d, nodeType)
+	if err != nil {
+		return false, err
+	}
+	templateParams := utils.PathSearch("parameters", template, make([]interface{}, 0)).([]interface{})
+	for _, entityID := range entityIDs {
+		entityParams, err := getDdsApiResource(client, fmt.Sprintf(
+			"v3/{project_id}/instances/%s/configurations?entity_id=%s", d.Id(), entityID))
+		if err != nil {
+			return false, fmt.Errorf("error retrieving parameters of DDS node (%s): %s", entityID, err)
+		}
+		for _, param := range templateParams {
+			expression := fmt.Sprintf("parameters[?name=='%s']|[0].value", utils.PathSearch("name", param, ""))
+			value := utils.PathSearch(expression, entityParams, nil)
+			if value != nil && value != utils.PathSearch("value", param, nil) {
+				return false, nil
+			}
+		}
+	}
+	return true, nil
+}
+
+// flattenDdsInstanceConfigurations refreshes the configured parameter templates, the ID of the template is cleared
+// when the parameters of the nodes have been changed, so that the template is applied again.
+func flattenDdsInstanceConfigurations(client *golangsdk.ServiceClient,
+	d *schema.ResourceData) ([]map[string]interface{}, error) {
+	rawConfigurations := d.Get("configuration").([]interface{})
+	rst := make([]map[string]interface{}, 0, len(rawConfigurations))
+	for _, v := range rawConfigurations {
+		raw := v.(map[string]interface{})
+		configuration := map[string]interface{}{
+			"type": raw["type"],
+			"id":   raw["id"],
+		}
+		applied, err := isDdsConfigurationApplied(client, d, raw["type"].(string), raw["id"].(string))
+		if err != nil {
+			return nil, err
+		}
+		if !applied {
+			logp.Printf("[WARN] the parameters of the %s nodes are different from the template (%s)", raw["type"],
+				raw["id"])
+			configuration["id"] = ""
+		}
+		rst = append(rst, configuration)
+	}
+	return rst, nil
+}
+
+// resourceDdsInstanceFlavorCustomizeDiff replaces the instance only when the type or the storage of an existing
+// flavor is changed, and the readonly flavor can be appended to add read-only nodes to the replica set instance.
+func resourceDdsInstanceFlavorCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.HasChange("flavor") {
+		return nil
+	}
+
+	oldRaw, newRaw := d.GetChange("flavor")
+	oldFlavors, newFlavors := oldRaw.([]interface{}), newRaw.([]interface{})
+	if len(newFlavors) < len(oldFlavors) {
+		return fmt.Errorf("the flavors of the DDS instance can not be removed")
+	}
+	for i := range oldFlavors {
+		for _, field := range []string{"type", "storage"} {
+			key := fmt.Sprintf("flavor.%d.%s", i, field)
+			if !d.HasChange(key) {
+				continue
+			}
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+	for _, v := range newFlavors[len(oldFlavors):] {
+		flavor, _ := v.(map[string]interface{})
+		if flavorType, _ := flavor["type"].(string); flavorType != "" && flavorType != "readonly" {
+			return fmt.Errorf("only the readonly flavor can be added to the DDS instance, but got %s", flavorType)
+		}
+	}
+	return nil
+}
+
+// addReadonlyNodes adds read-only nodes to the replica set instance.
+func addReadonlyNodes(ctx context.Context, config *config.Config, client *golangsdk.ServiceClient,
+	d *schema.ResourceData, specCode string, num int) error {
+	addNodeOpts := map[string]interface{}{
+		"spec_code": specCode,
+		"num":       num,
+	}
+	if d.Get("charging_mode").(string) == "prePaid" && d.Get("auto_pay").(string) != "false" {
+		addNodeOpts["is_auto_pay"] = true
+	}
+	opt := instances.UpdateOpt{
+		Param:  "",
+		Value:  addNodeOpts,
+		Action: "readonly-node",
+		Method: "post",
+	}
+	return flavorUpdate(ctx, config, client, d, []instances.UpdateOpt{opt})
+}
+
 func flavorUpdate(ctx context.Context, config *config.Config, client *golangsdk.ServiceClient, d *schema.ResourceData,
 	opts []instances.UpdateOpt) error {
 	resp, err := instances.Update(client, d.Id(), opts).Extract()
@@ -857,7 +1260,7 @@ func flavorUpdate(ctx context.Context, config *config.Config, client *golangsdk.
 func flavorNumUpdate(ctx context.Context, config *config.Config, client *golangsdk.ServiceClient, d *schema.ResourceData, i int) error {
 	groupTypeIndex := fmt.Sprintf("flavor.%d.type", i)
 	groupType := d.Get(groupTypeIndex).(string)
-	if groupType != "mongos" && groupType != "shard" {
+	if groupType != "mongos" && groupType != "shard" && groupType != "readonly" {
 		return fmtp.Errorf("Error updating instance: %s does not support adding nodes", groupType)
 	}
 	specCodeIndex := fmt.Sprintf("flavor.%d.spec_code", i)
@@ -870,6 +1273,9 @@ func flavorNumUpdate(ctx context.Context, config *config.Config, client *golangs
 	if newNum < oldNum {
 		return fmtp.Errorf("Error updating instance: the new num(%d) must be greater than the old num(%d)", newNum, oldNum)
 	}
+	if groupType == "readonly" {
+		return addReadonlyNodes(ctx, config, client, d, d.Get(specCodeIndex).(string), newNum-oldNum)
+	}
 
 	var numUpdateOpts []instances.UpdateOpt
 
@@ -1027,6 +1433,33 @@ func flavorSpecCodeUpdate(ctx context.Context, config *config.Config, client *go
 				return err
 			}
 		}
+	} else if groupType == "readonly" {
+		nodeIDs, err := getDdsInstanceV3ReadonlyNodeID(client, d)
+		if err != nil {
+			return err
+		}
+		for _, ID := range nodeIDs {
+			updateSpecOpts := instances.UpdateSpecOpts{
+				Resize: instances.SpecOpts{
+					TargetType:     "readonly",
+					TargetID:       ID,
+					TargetSpecCode: d.Get(specCodeIndex).(string),
+				},
+			}
+			if d.Get("charging_mode").(string) == "prePaid" && d.Get("auto_pay").(string) != "false" {
+				updateSpecOpts.IsAutoPay = true
+			}
+			opt := instances.UpdateOpt{
+				Param:  "",
+				Value:  updateSpecOpts,
+				Action: "resize",
+				Method: "post",
+			}
+			err := flavorUpdate(ctx, config, client, d, []instances.UpdateOpt{opt})
+			if err != nil {
+				return err
+			}
+		}
 	} else if groupType == "shard" {
 		groupIDs, err := getDdsInstanceV3ShardGroupID(client, d)
 		if err != nil {
@@ -0,0 +1,351 @@
+package dds
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDdsParameterTemplate is the impl for huaweicloud_dds_parameter_template resource.
+// The template is applied to the instances through the configuration block of huaweicloud_dds_instance.
+func ResourceDdsParameterTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDdsParameterTemplateCreate,
+		ReadContext:   resourceDdsParameterTemplateRead,
+		UpdateContext: resourceDdsParameterTemplateUpdate,
+		DeleteContext: resourceDdsParameterTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the parameter template name.`,
+			},
+			"node_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the node type of the parameter template.`,
+				ValidateFunc: validation.StringInSlice([]string{
+					"mongos", "shard", "config", "replica", "single",
+				}, false),
+			},
+			"node_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the database version.`,
+			},
+			"parameter_values": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the mapping between parameter names and parameter values.`,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the parameter template description.`,
+			},
+			"parameters": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Indicates the parameters defined by users based on the default parameter templates.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the parameter name.`,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the parameter value.`,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the parameter description.`,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the parameter type.`,
+						},
+						"value_range": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the value range.`,
+						},
+						"restart_required": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Indicates whether the instance needs to be restarted.`,
+						},
+						"readonly": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: `Indicates whether the parameter is read-only.`,
+						},
+					},
+				},
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the creation time of the parameter template.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the update time of the parameter template.`,
+			},
+		},
+	}
+}
+
+func resourceDdsParameterTemplateCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createParameterTemplate: create a DDS parameter template.
+	var (
+		createParameterTemplateHttpUrl = "v3/{project_id}/configurations"
+		createParameterTemplateProduct = "dds"
+	)
+	createParameterTemplateClient, err := cfg.NewServiceClient(createParameterTemplateProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DDS Client: %s", err)
+	}
+
+	createParameterTemplatePath := createParameterTemplateClient.Endpoint + createParameterTemplateHttpUrl
+	createParameterTemplatePath = strings.ReplaceAll(createParameterTemplatePath, "{project_id}",
+		createParameterTemplateClient.ProjectID)
+
+	createParameterTemplateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+		JSONBody:    utils.RemoveNil(buildCreateParameterTemplateBodyParams(d)),
+	}
+	createParameterTemplateResp, err := createParameterTemplateClient.Request("POST", createParameterTemplatePath,
+		&createParameterTemplateOpt)
+	if err != nil {
+		return diag.Errorf("error creating DDS parameter template: %s", err)
+	}
+
+	createParameterTemplateRespBody, err := utils.FlattenResponse(createParameterTemplateResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := utils.PathSearch("configuration.id", createParameterTemplateRespBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the DDS parameter template ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceDdsParameterTemplateRead(ctx, d, meta)
+}
+
+func buildCreateParameterTemplateBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"name":             d.Get("name"),
+		"description":      utils.ValueIngoreEmpty(d.Get("description")),
+		"parameter_values": utils.ValueIngoreEmpty(d.Get("parameter_values")),
+		"datastore": map[string]interface{}{
+			"type":      "DDS-Community",
+			"node_type": d.Get("node_type"),
+			"version":   d.Get("node_version"),
+		},
+	}
+}
+
+func resourceDdsParameterTemplateRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	var mErr *multierror.Error
+
+	// getParameterTemplate: Query the DDS parameter template.
+	var (
+		getParameterTemplateHttpUrl = "v3/{project_id}/configurations/{config_id}"
+		getParameterTemplateProduct = "dds"
+	)
+	getParameterTemplateClient, err := cfg.NewServiceClient(getParameterTemplateProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DDS Client: %s", err)
+	}
+
+	getParameterTemplatePath := getParameterTemplateClient.Endpoint + getParameterTemplateHttpUrl
+	getParameterTemplatePath = strings.ReplaceAll(getParameterTemplatePath, "{project_id}",
+		getParameterTemplateClient.ProjectID)
+	getParameterTemplatePath = strings.ReplaceAll(getParameterTemplatePath, "{config_id}", d.Id())
+
+	getParameterTemplateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	}
+	getParameterTemplateResp, err := getParameterTemplateClient.Request("GET", getParameterTemplatePath,
+		&getParameterTemplateOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DDS parameter template")
+	}
+
+	getParameterTemplateRespBody, err := utils.FlattenResponse(getParameterTemplateResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr = multierror.Append(
+		mErr,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", getParameterTemplateRespBody, nil)),
+		d.Set("node_version", utils.PathSearch("datastore_version", getParameterTemplateRespBody, nil)),
+		d.Set("description", utils.PathSearch("description", getParameterTemplateRespBody, nil)),
+		d.Set("parameters", flattenParameterTemplateParameters(getParameterTemplateRespBody)),
+		d.Set("parameter_values", flattenParameterTemplateValues(d, getParameterTemplateRespBody)),
+		d.Set("created_at", utils.PathSearch("created", getParameterTemplateRespBody, nil)),
+		d.Set("updated_at", utils.PathSearch("updated", getParameterTemplateRespBody, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenParameterTemplateParameters(resp interface{}) []interface{} {
+	curJson := utils.PathSearch("parameters", resp, make([]interface{}, 0))
+	curArray := curJson.([]interface{})
+	rst := make([]interface{}, 0, len(curArray))
+	for _, v := range curArray {
+		rst = append(rst, map[string]interface{}{
+			"name":             utils.PathSearch("name", v, nil),
+			"value":            utils.PathSearch("value", v, nil),
+			"description":      utils.PathSearch("description", v, nil),
+			"type":             utils.PathSearch("type", v, nil),
+			"value_range":      utils.PathSearch("value_range", v, nil),
+			"restart_required": utils.PathSearch("restart_required", v, nil),
+			"readonly":         utils.PathSearch("readonly", v, nil),
+		})
+	}
+	return rst
+}
+
+// flattenParameterTemplateValues only returns the values of the parameters which are specified, the other parameters
+// of the template can be found in the parameters attribute.
+func flattenParameterTemplateValues(d *schema.ResourceData, resp interface{}) map[string]interface{} {
+	rst := make(map[string]interface{})
+	for name := range d.Get("parameter_values").(map[string]interface{}) {
+		expression := fmt.Sprintf("parameters[?name=='%s'].value | [0]", name)
+		if value := utils.PathSearch(expression, resp, nil); value != nil {
+			rst[name] = value
+		}
+	}
+	return rst
+}
+
+func resourceDdsParameterTemplateUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// updateParameterTemplate: update the DDS parameter template.
+	var (
+		updateParameterTemplateHttpUrl = "v3/{project_id}/configurations/{config_id}"
+		updateParameterTemplateProduct = "dds"
+	)
+	updateParameterTemplateClient, err := cfg.NewServiceClient(updateParameterTemplateProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DDS Client: %s", err)
+	}
+
+	updateParameterTemplatePath := updateParameterTemplateClient.Endpoint + updateParameterTemplateHttpUrl
+	updateParameterTemplatePath = strings.ReplaceAll(updateParameterTemplatePath, "{project_id}",
+		updateParameterTemplateClient.ProjectID)
+	updateParameterTemplatePath = strings.ReplaceAll(updateParameterTemplatePath, "{config_id}", d.Id())
+
+	updateParameterTemplateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+		JSONBody:    buildUpdateParameterTemplateBodyParams(d),
+	}
+	_, err = updateParameterTemplateClient.Request("PUT", updateParameterTemplatePath, &updateParameterTemplateOpt)
+	if err != nil {
+		return diag.Errorf("error updating DDS parameter template: %s", err)
+	}
+
+	return resourceDdsParameterTemplateRead(ctx, d, meta)
+}
+
+func buildUpdateParameterTemplateBodyParams(d *schema.ResourceData) map[string]interface{} {
+	bodyParams := map[string]interface{}{
+		"name":        d.Get("name"),
+		"description": d.Get("description"),
+	}
+	if d.HasChange("parameter_values") {
+		bodyParams["parameter_values"] = d.Get("parameter_values")
+	}
+	return bodyParams
+}
+
+func resourceDdsParameterTemplateDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// deleteParameterTemplate: delete the DDS parameter template.
+	var (
+		deleteParameterTemplateHttpUrl = "v3/{project_id}/configurations/{config_id}"
+		deleteParameterTemplateProduct = "dds"
+	)
+	deleteParameterTemplateClient, err := cfg.NewServiceClient(deleteParameterTemplateProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DDS Client: %s", err)
+	}
+
+	deleteParameterTemplatePath := deleteParameterTemplateClient.Endpoint + deleteParameterTemplateHttpUrl
+	deleteParameterTemplatePath = strings.ReplaceAll(deleteParameterTemplatePath, "{project_id}",
+		deleteParameterTemplateClient.ProjectID)
+	deleteParameterTemplatePath = strings.ReplaceAll(deleteParameterTemplatePath, "{config_id}", d.Id())
+
+	deleteParameterTemplateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	}
+	_, err = deleteParameterTemplateClient.Request("DELETE", deleteParameterTemplatePath, &deleteParameterTemplateOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DDS parameter template")
+	}
+
+	return nil
+}
@@ -0,0 +1,329 @@
+package dli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDliDatasourceConnection is the impl for huaweicloud_dli_datasource_connection resource, which creates an
+// enhanced datasource connection, a VPC peering between the DLI queues and the VPC of the data sources.
+func ResourceDliDatasourceConnection() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDliDatasourceConnectionCreate,
+		ReadContext:   resourceDliDatasourceConnectionRead,
+		UpdateContext: resourceDliDatasourceConnectionUpdate,
+		DeleteContext: resourceDliDatasourceConnectionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the datasource connection.`,
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the VPC where the data source is located.`,
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the subnet where the data source is located.`,
+			},
+			"route_table_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the route table associated with the subnet.`,
+			},
+			"queues": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the names of the queues which use the connection.`,
+			},
+			"elastic_resource_pools": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the names of the elastic resource pools which use the connection.`,
+			},
+			"hosts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Specifies the host mappings used by the queues to access the data source.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the host name.`,
+						},
+						"ip": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the IP address of the host.`,
+						},
+					},
+				},
+			},
+			"tags": common.TagsForceNewSchema(),
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the status of the datasource connection.`,
+			},
+		},
+	}
+}
+
+// buildDliDatasourceConnectionHosts returns a general list, so that an empty list is kept by RemoveNil to clear the
+// host mappings.
+func buildDliDatasourceConnectionHosts(d *schema.ResourceData) []interface{} {
+	rawHosts := d.Get("hosts").([]interface{})
+	hosts := make([]interface{}, len(rawHosts))
+	for i, v := range rawHosts {
+		host := v.(map[string]interface{})
+		hosts[i] = map[string]interface{}{
+			"name": host["name"],
+			"ip":   host["ip"],
+		}
+	}
+	return hosts
+}
+
+func resourceDliDatasourceConnectionCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dli", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DLI client: %s", err)
+	}
+
+	params := map[string]interface{}{
+		"name":                   d.Get("name"),
+		"dest_vpc_id":            d.Get("vpc_id"),
+		"dest_network_id":        d.Get("subnet_id"),
+		"routetable_id":          utils.ValueIngoreEmpty(d.Get("route_table_id")),
+		"queues":                 utils.ExpandToStringListBySet(d.Get("queues").(*schema.Set)),
+		"elastic_resource_pools": utils.ExpandToStringListBySet(d.Get("elastic_resource_pools").(*schema.Set)),
+		"hosts":                  buildDliDatasourceConnectionHosts(d),
+		"tags":                   utils.ExpandResourceTagsMap(d.Get("tags").(map[string]interface{})),
+	}
+	respBody, err := requestDliApi(client, "POST", "v2.0/{project_id}/datasource/enhanced-connections", params)
+	if err != nil {
+		return diag.Errorf("error creating DLI datasource connection: %s", err)
+	}
+
+	connectionId := utils.PathSearch("connection_id", respBody, "").(string)
+	if connectionId == "" {
+		return diag.Errorf("unable to find the connection ID from the API response")
+	}
+	d.SetId(connectionId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"CREATING"},
+		Target:       []string{"ACTIVE"},
+		Refresh:      dliDatasourceConnectionStatusRefreshFunc(client, connectionId),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for DLI datasource connection (%s) to become active: %s", connectionId, err)
+	}
+
+	return resourceDliDatasourceConnectionRead(ctx, d, meta)
+}
+
+func getDliDatasourceConnection(client *golangsdk.ServiceClient, connectionId string) (interface{}, error) {
+	respBody, err := requestDliApi(client, "GET",
+		"v2.0/{project_id}/datasource/enhanced-connections/"+connectionId, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// the deleted connection can still be queried for a while.
+	if utils.PathSearch("status", respBody, "").(string) == "DELETED" {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return respBody, nil
+}
+
+func dliDatasourceConnectionStatusRefreshFunc(client *golangsdk.ServiceClient,
+	connectionId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		connection, err := getDliDatasourceConnection(client, connectionId)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		status := utils.PathSearch("status", connection, "").(string)
+		if status == "FAILED" {
+			return connection, status, fmt.Errorf("the datasource connection (%s) failed", connectionId)
+		}
+		return connection, status, nil
+	}
+}
+
+func flattenDliDatasourceConnectionHosts(connection interface{}) []map[string]interface{} {
+	rawHosts := utils.PathSearch("hosts", connection, make([]interface{}, 0)).([]interface{})
+	hosts := make([]map[string]interface{}, len(rawHosts))
+	for i, host := range rawHosts {
+		hosts[i] = map[string]interface{}{
+			"name": utils.PathSearch("name", host, nil),
+			"ip":   utils.PathSearch("ip", host, nil),
+		}
+	}
+	return hosts
+}
+
+func resourceDliDatasourceConnectionRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dli", region)
+	if err != nil {
+		return diag.Errorf("error creating DLI client: %s", err)
+	}
+
+	connection, err := getDliDatasourceConnection(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DLI datasource connection")
+	}
+
+	tags, err := getDliResourceTags(client, "dli_enhanced_connection", d.Id())
+	if err != nil {
+		return diag.Errorf("error retrieving the tags of DLI datasource connection (%s): %s", d.Id(), err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", connection, nil)),
+		d.Set("vpc_id", utils.PathSearch("dest_vpc_id", connection, nil)),
+		d.Set("subnet_id", utils.PathSearch("dest_network_id", connection, nil)),
+		d.Set("queues", utils.PathSearch("available_queue_info[*].name", connection, nil)),
+		d.Set("elastic_resource_pools", utils.PathSearch("elastic_resource_pools[*].name", connection, nil)),
+		d.Set("hosts", flattenDliDatasourceConnectionHosts(connection)),
+		d.Set("status", utils.PathSearch("status", connection, nil)),
+		d.Set("tags", tags),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+// updateDliDatasourceConnectionBindings associates the added queues or pools with the connection and disassociates
+// the removed ones.
+func updateDliDatasourceConnectionBindings(client *golangsdk.ServiceClient, d *schema.ResourceData,
+	key string) error {
+	oldRaw, newRaw := d.GetChange(key)
+	removed := oldRaw.(*schema.Set).Difference(newRaw.(*schema.Set))
+	added := newRaw.(*schema.Set).Difference(oldRaw.(*schema.Set))
+
+	connectionPath := "v2.0/{project_id}/datasource/enhanced-connections/" + d.Id()
+	if removed.Len() > 0 {
+		params := map[string]interface{}{
+			key: utils.ExpandToStringListBySet(removed),
+		}
+		if _, err := requestDliApi(client, "POST", connectionPath+"/disassociate-queue", params); err != nil {
+			return fmt.Errorf("error disassociating %s from DLI datasource connection (%s): %s", key, d.Id(), err)
+		}
+	}
+	if added.Len() > 0 {
+		params := map[string]interface{}{
+			key: utils.ExpandToStringListBySet(added),
+		}
+		if _, err := requestDliApi(client, "POST", connectionPath+"/associate-queue", params); err != nil {
+			return fmt.Errorf("error associating %s with DLI datasource connection (%s): %s", key, d.Id(), err)
+		}
+	}
+	return nil
+}
+
+func resourceDliDatasourceConnectionUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dli", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DLI client: %s", err)
+	}
+
+	for _, key := range []string{"queues", "elastic_resource_pools"} {
+		if d.HasChange(key) {
+			if err = updateDliDatasourceConnectionBindings(client, d, key); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	if d.HasChange("hosts") {
+		params := map[string]interface{}{
+			"hosts": buildDliDatasourceConnectionHosts(d),
+		}
+		updatePath := "v2.0/{project_id}/datasource/enhanced-connections/" + d.Id()
+		if _, err = requestDliApi(client, "PUT", updatePath, params); err != nil {
+			return diag.Errorf("error updating the hosts of DLI datasource connection (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceDliDatasourceConnectionRead(ctx, d, meta)
+}
+
+func resourceDliDatasourceConnectionDelete(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dli", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DLI client: %s", err)
+	}
+
+	_, err = requestDliApi(client, "DELETE", "v2.0/{project_id}/datasource/enhanced-connections/"+d.Id(), nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DLI datasource connection")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"ACTIVE", "DELETING"},
+		Target:       []string{"DELETED"},
+		Refresh:      dliDatasourceConnectionStatusRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for DLI datasource connection (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
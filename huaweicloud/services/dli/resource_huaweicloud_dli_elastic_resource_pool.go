@@ -0,0 +1,305 @@
+package dli
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDliElasticResourcePool is the impl for huaweicloud_dli_elastic_resource_pool resource, the queues added to
+// the pool share its compute resources.
+// The resource ID is the name of the elastic resource pool.
+func ResourceDliElasticResourcePool() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDliElasticResourcePoolCreate,
+		ReadContext:   resourceDliElasticResourcePoolRead,
+		UpdateContext: resourceDliElasticResourcePoolUpdate,
+		DeleteContext: resourceDliElasticResourcePoolDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z0-9_]{1,128}$`),
+					"only lowercase letters, digits and underscores (_) are allowed"),
+				Description: `Specifies the name of the elastic resource pool.`,
+			},
+			"max_cu": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: `Specifies the maximum CUs of the elastic resource pool.`,
+			},
+			"min_cu": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: `Specifies the minimum CUs of the elastic resource pool.`,
+			},
+			"cidr": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `Specifies the CIDR block of the elastic resource pool.`,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+				Description:  `Specifies the description of the elastic resource pool.`,
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `Specifies the enterprise project ID of the elastic resource pool.`,
+			},
+			"tags": common.TagsForceNewSchema(),
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the status of the elastic resource pool.`,
+			},
+			"current_cu": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `Indicates the current CUs of the elastic resource pool.`,
+			},
+			"owner": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the owner of the elastic resource pool.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the creation time of the elastic resource pool.`,
+			},
+		},
+	}
+}
+
+// requestDliApi sends the request to the DLI API, the path is relative to the endpoint and starts with the version.
+func requestDliApi(client *golangsdk.ServiceClient, method, path string,
+	params map[string]interface{}) (interface{}, error) {
+	respBody, err := common.RequestApi(client, method, path, nil, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// Some DLI APIs return the errors with the HTTP status code 200.
+	if isSuccess, ok := utils.PathSearch("is_success", respBody, true).(bool); ok && !isSuccess {
+		return nil, fmt.Errorf("%v", utils.PathSearch("message", respBody, "unknown error"))
+	}
+	return respBody, nil
+}
+
+// getDliResourceTags queries the tags of the DLI resource, such as the elastic resource pool
+// (dli_elastic_resource_pool) and the enhanced datasource connection (dli_enhanced_connection).
+func getDliResourceTags(client *golangsdk.ServiceClient, resourceType,
+	resourceId string) (map[string]interface{}, error) {
+	getPath := fmt.Sprintf("v3/{project_id}/%s/%s/tags", resourceType, resourceId)
+	respBody, err := requestDliApi(client, "GET", getPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenTagsToMap(utils.PathSearch("tags", respBody, nil)), nil
+}
+
+func resourceDliElasticResourcePoolCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dli", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DLI client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	params := map[string]interface{}{
+		"elastic_resource_pool_name": name,
+		"max_cu":                     d.Get("max_cu"),
+		"min_cu":                     d.Get("min_cu"),
+		"cidr_in_vpc":                utils.ValueIngoreEmpty(d.Get("cidr")),
+		"description":                utils.ValueIngoreEmpty(d.Get("description")),
+		"enterprise_project_id":      utils.ValueIngoreEmpty(cfg.GetEnterpriseProjectID(d)),
+		"tags":                       utils.ExpandResourceTagsMap(d.Get("tags").(map[string]interface{})),
+	}
+	if _, err = requestDliApi(client, "POST", "v3/{project_id}/elastic-resource-pools", params); err != nil {
+		return diag.Errorf("error creating DLI elastic resource pool: %s", err)
+	}
+	d.SetId(name)
+
+	if err = waitForDliElasticResourcePoolAvailable(ctx, client, name, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDliElasticResourcePoolRead(ctx, d, meta)
+}
+
+func getDliElasticResourcePool(client *golangsdk.ServiceClient, name string) (interface{}, error) {
+	respBody, err := requestDliApi(client, "GET", "v3/{project_id}/elastic-resource-pools?name="+name, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// the name is used for the fuzzy search, so the pool is filtered by the exact name.
+	pool := utils.PathSearch(fmt.Sprintf("elastic_resource_pools[?elastic_resource_pool_name=='%s']|[0]", name),
+		respBody, nil)
+	if pool == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return pool, nil
+}
+
+func dliElasticResourcePoolStatusRefreshFunc(client *golangsdk.ServiceClient, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		pool, err := getDliElasticResourcePool(client, name)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		status := utils.PathSearch("status", pool, "").(string)
+		if status == "FAILED" {
+			return pool, status, fmt.Errorf("%v", utils.PathSearch("fail_reason", pool, "unknown reason"))
+		}
+		return pool, status, nil
+	}
+}
+
+func waitForDliElasticResourcePoolAvailable(ctx context.Context, client *golangsdk.ServiceClient, name string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"CREATING", "SCALING"},
+		Target:       []string{"AVAILABLE"},
+		Refresh:      dliElasticResourcePoolStatusRefreshFunc(client, name),
+		Timeout:      timeout,
+		Delay:        30 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("error waiting for DLI elastic resource pool (%s) to become available: %s", name, err)
+	}
+	return nil
+}
+
+func resourceDliElasticResourcePoolRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dli", region)
+	if err != nil {
+		return diag.Errorf("error creating DLI client: %s", err)
+	}
+
+	pool, err := getDliElasticResourcePool(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DLI elastic resource pool")
+	}
+
+	tags, err := getDliResourceTags(client, "dli_elastic_resource_pool", d.Id())
+	if err != nil {
+		return diag.Errorf("error retrieving the tags of DLI elastic resource pool (%s): %s", d.Id(), err)
+	}
+
+	createTime := int64(utils.PathSearch("create_time", pool, float64(0)).(float64)) / 1000
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("elastic_resource_pool_name", pool, nil)),
+		d.Set("max_cu", utils.PathSearch("max_cu", pool, nil)),
+		d.Set("min_cu", utils.PathSearch("min_cu", pool, nil)),
+		d.Set("cidr", utils.PathSearch("cidr_in_vpc", pool, nil)),
+		d.Set("description", utils.PathSearch("description", pool, nil)),
+		d.Set("enterprise_project_id", utils.PathSearch("enterprise_project_id", pool, nil)),
+		d.Set("status", utils.PathSearch("status", pool, nil)),
+		d.Set("current_cu", utils.PathSearch("current_cu", pool, nil)),
+		d.Set("owner", utils.PathSearch("owner", pool, nil)),
+		d.Set("created_at", utils.FormatTimeStampRFC3339(createTime, false)),
+		d.Set("tags", tags),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDliElasticResourcePoolUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dli", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DLI client: %s", err)
+	}
+
+	params := map[string]interface{}{
+		"description": d.Get("description"),
+		"max_cu":      d.Get("max_cu"),
+		"min_cu":      d.Get("min_cu"),
+	}
+	_, err = requestDliApi(client, "PUT", "v3/{project_id}/elastic-resource-pools/"+d.Id(), params)
+	if err != nil {
+		return diag.Errorf("error updating DLI elastic resource pool (%s): %s", d.Id(), err)
+	}
+
+	if err = waitForDliElasticResourcePoolAvailable(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDliElasticResourcePoolRead(ctx, d, meta)
+}
+
+func resourceDliElasticResourcePoolDelete(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dli", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DLI client: %s", err)
+	}
+
+	_, err = requestDliApi(client, "DELETE", "v3/{project_id}/elastic-resource-pools/"+d.Id(), nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DLI elastic resource pool")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"AVAILABLE", "DELETING"},
+		Target:       []string{"DELETED"},
+		Refresh:      dliElasticResourcePoolStatusRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for DLI elastic resource pool (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,231 @@
+package dli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDliElasticResourcePoolQueue is the impl for huaweicloud_dli_elastic_resource_pool_queue resource, which adds
+// the queue to the elastic resource pool and manages the scaling policies of the queue in the pool.
+// The queue can not be removed from the pool, so destroying the resource only removes it from the state.
+// The resource ID is in the format of <elastic_resource_pool_name>/<queue_name>.
+func ResourceDliElasticResourcePoolQueue() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDliElasticResourcePoolQueueCreate,
+		ReadContext:   resourceDliElasticResourcePoolQueueRead,
+		UpdateContext: resourceDliElasticResourcePoolQueueUpdate,
+		DeleteContext: resourceDliElasticResourcePoolQueueDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDliElasticResourcePoolQueueImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"elastic_resource_pool_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the elastic resource pool.`,
+			},
+			"queue_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the queue.`,
+			},
+			"scaling_policies": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: `Specifies the time-windowed scaling policies of the queue.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+							Description:  `Specifies the priority of the queue in the time window.`,
+						},
+						"impact_start_time": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the start time of the time window, in the format of HH:mm.`,
+						},
+						"impact_stop_time": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the end time of the time window, in the format of HH:mm.`,
+						},
+						"min_cu": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: `Specifies the minimum CUs of the queue in the time window.`,
+						},
+						"max_cu": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: `Specifies the maximum CUs of the queue in the time window.`,
+						},
+					},
+				},
+			},
+			"queue_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the type of the queue.`,
+			},
+		},
+	}
+}
+
+func buildDliPoolQueuesPath(poolName string) string {
+	return fmt.Sprintf("v3/{project_id}/elastic-resource-pools/%s/queues", poolName)
+}
+
+func resourceDliElasticResourcePoolQueueCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dli", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DLI client: %s", err)
+	}
+
+	poolName := d.Get("elastic_resource_pool_name").(string)
+	queueName := d.Get("queue_name").(string)
+	params := map[string]interface{}{
+		"queue_name": queueName,
+	}
+	if _, err = requestDliApi(client, "POST", buildDliPoolQueuesPath(poolName), params); err != nil {
+		return diag.Errorf("error adding queue (%s) to DLI elastic resource pool (%s): %s", queueName, poolName, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", poolName, queueName))
+
+	// the default policy created by the service is replaced, so the policies of the queue match the configuration.
+	if err = updateDliPoolQueueScalingPolicies(client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDliElasticResourcePoolQueueRead(ctx, d, meta)
+}
+
+// updateDliPoolQueueScalingPolicies replaces the scaling policies of the queue, an empty list is sent to clear them
+// when they are removed from the configuration.
+func updateDliPoolQueueScalingPolicies(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	rawPolicies := d.Get("scaling_policies").([]interface{})
+	policies := make([]interface{}, len(rawPolicies))
+	for i, v := range rawPolicies {
+		policy := v.(map[string]interface{})
+		policies[i] = map[string]interface{}{
+			"priority":          policy["priority"],
+			"impact_start_time": policy["impact_start_time"],
+			"impact_stop_time":  policy["impact_stop_time"],
+			"min_cu":            policy["min_cu"],
+			"max_cu":            policy["max_cu"],
+		}
+	}
+
+	params := map[string]interface{}{
+		"queue_scaling_policies": policies,
+	}
+	updatePath := buildDliPoolQueuesPath(d.Get("elastic_resource_pool_name").(string)) + "/" +
+		d.Get("queue_name").(string)
+	if _, err := requestDliApi(client, "PUT", updatePath, params); err != nil {
+		return fmt.Errorf("error updating the scaling policies of DLI queue (%s): %s", d.Id(), err)
+	}
+	return nil
+}
+
+func flattenDliPoolQueueScalingPolicies(queue interface{}) []map[string]interface{} {
+	rawPolicies := utils.PathSearch("queue_scaling_policies", queue, make([]interface{}, 0)).([]interface{})
+	policies := make([]map[string]interface{}, len(rawPolicies))
+	for i, policy := range rawPolicies {
+		policies[i] = map[string]interface{}{
+			"priority":          utils.PathSearch("priority", policy, nil),
+			"impact_start_time": utils.PathSearch("impact_start_time", policy, nil),
+			"impact_stop_time":  utils.PathSearch("impact_stop_time", policy, nil),
+			"min_cu":            utils.PathSearch("min_cu", policy, nil),
+			"max_cu":            utils.PathSearch("max_cu", policy, nil),
+		}
+	}
+	return policies
+}
+
+func resourceDliElasticResourcePoolQueueRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dli", region)
+	if err != nil {
+		return diag.Errorf("error creating DLI client: %s", err)
+	}
+
+	queueName := d.Get("queue_name").(string)
+	getPath := buildDliPoolQueuesPath(d.Get("elastic_resource_pool_name").(string)) + "?queue_name=" + queueName
+	respBody, err := requestDliApi(client, "GET", getPath, nil)
+	if err == nil {
+		respBody = utils.PathSearch(fmt.Sprintf("queues[?queue_name=='%s']|[0]", queueName), respBody, nil)
+		if respBody == nil {
+			err = golangsdk.ErrDefault404{}
+		}
+	}
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DLI elastic resource pool queue")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("scaling_policies", flattenDliPoolQueueScalingPolicies(respBody)),
+		d.Set("queue_type", utils.PathSearch("queue_type", respBody, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDliElasticResourcePoolQueueUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dli", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DLI client: %s", err)
+	}
+
+	if err = updateDliPoolQueueScalingPolicies(client, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDliElasticResourcePoolQueueRead(ctx, d, meta)
+}
+
+func resourceDliElasticResourcePoolQueueDelete(_ context.Context, _ *schema.ResourceData,
+	_ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceDliElasticResourcePoolQueueImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <elastic_resource_pool_name>/<queue_name>")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("elastic_resource_pool_name", parts[0]),
+		d.Set("queue_name", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
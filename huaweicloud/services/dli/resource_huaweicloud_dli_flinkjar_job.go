@@ -25,6 +25,7 @@ func ResourceFlinkJarJob() *schema.Resource {
 		ReadContext:   resourceFlinkJarJobRead,
 		UpdateContext: resourceFlinkJarJobUpdate,
 		DeleteContext: resourceFlinkJarJobDelete,
+		CustomizeDiff: validateFlinkJobDestroyAction,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -176,12 +177,36 @@ func ResourceFlinkJarJob() *schema.Resource {
 				Optional: true,
 			},
 
+			"savepoint_on_update": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"obs_bucket"},
+			},
+
+			"destroy_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      flinkJobDestroyActionCancel,
+				ValidateFunc: validation.StringInSlice(flinkJobDestroyActions, false),
+			},
+
+			"destroy_savepoint_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"tags": common.TagsForceNewSchema(),
 
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"checkpoint_directory": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
@@ -327,6 +352,7 @@ func resourceFlinkJarJobRead(_ context.Context, d *schema.ResourceData, meta int
 		d.Set("checkpoint_path", detail.JobConfig.CheckpointPath),
 		setRuntimeConfigToState(d, detail.JobConfig.RuntimeConfig),
 		d.Set("status", detail.Status),
+		d.Set("checkpoint_directory", buildFlinkJobCheckpointDirectory(detail)),
 	)
 	if setSdErr := mErr.ErrorOrNil(); setSdErr != nil {
 		return fmtp.DiagErrorf("Error setting vault fields: %s", setSdErr)
@@ -335,7 +361,7 @@ func resourceFlinkJarJobRead(_ context.Context, d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceFlinkJarJobDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func resourceFlinkJarJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*config.Config)
 	region := config.GetRegion(d)
 	client, err := config.DliV1Client(region)
@@ -348,6 +374,14 @@ func resourceFlinkJarJobDelete(_ context.Context, d *schema.ResourceData, meta i
 		return fmtp.DiagErrorf("the DLI flink job_id must be number. actual id=%s", d.Id())
 	}
 
+	if d.Get("destroy_action").(string) == flinkJobDestroyActionStopWithSavepoint {
+		sErr := stopFlinkJobWithSavepoint(ctx, client, jobId, d.Get("destroy_savepoint_path").(string),
+			d.Timeout(schema.TimeoutDelete))
+		if sErr != nil {
+			return diag.FromErr(sErr)
+		}
+	}
+
 	deleteRst, dErr := flinkjob.Delete(client, jobId)
 	if dErr != nil {
 		return fmtp.DiagErrorf("delete DLI flink jar job failed. %q:%s", jobId, dErr)
@@ -426,12 +460,13 @@ func updateFlinkJarJobInRunning(client *golangsdk.ServiceClient, jobId int, d *s
 
 func updateFlinkJarJobWithStop(ctx context.Context, client *golangsdk.ServiceClient, jobId int,
 	d *schema.ResourceData) diag.Diagnostics {
-	if d.HasChangesExcept("smn_topic", "restart_when_exception", "resume_checkpoint", "resume_max_num", "obs_bucket",
-		"checkpoint_path") {
-
+	inRunningParams := []string{"smn_topic", "restart_when_exception", "resume_checkpoint", "resume_max_num",
+		"obs_bucket", "checkpoint_path"}
+	if d.HasChangesExcept(append(inRunningParams, flinkJobLocalParams...)...) {
 		// 1. stop the job
+		triggerSavepoint := d.Get("resume_checkpoint").(bool) || d.Get("savepoint_on_update").(bool)
 		_, err := flinkjob.Stop(client, flinkjob.StopFlinkJobInBatch{
-			TriggerSavepoint: utils.Bool(d.Get("resume_checkpoint").(bool)),
+			TriggerSavepoint: utils.Bool(triggerSavepoint),
 			JobIds:           []int{jobId},
 		})
 
@@ -499,7 +534,7 @@ func updateFlinkJarJobWithStop(ctx context.Context, client *golangsdk.ServiceCli
 		//3. run the flink jar job
 		_, runErr := flinkjob.Run(client, flinkjob.RunJobOpts{
 			JobIds:          []int{jobId},
-			ResumeSavepoint: utils.Bool(d.Get("resume_checkpoint").(bool)),
+			ResumeSavepoint: utils.Bool(triggerSavepoint),
 		})
 		if runErr != nil {
 			return fmtp.DiagErrorf("Error run DLI flink jar job: %s", runErr)
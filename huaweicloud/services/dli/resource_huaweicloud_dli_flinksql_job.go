@@ -3,6 +3,7 @@ package dli
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -21,12 +22,35 @@ import (
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils/logp"
 )
 
+const (
+	flinkJobDestroyActionCancel            = "cancel"
+	flinkJobDestroyActionStopWithSavepoint = "stop_with_savepoint"
+)
+
+var (
+	flinkJobDestroyActions = []string{flinkJobDestroyActionCancel, flinkJobDestroyActionStopWithSavepoint}
+
+	// flinkJobLocalParams are only used by the provider, changing them does not update the job.
+	flinkJobLocalParams = []string{"savepoint_on_update", "destroy_action", "destroy_savepoint_path"}
+)
+
+// validateFlinkJobDestroyAction rejects the savepoint path of the destruction when the job is cancelled without a
+// savepoint.
+func validateFlinkJobDestroyAction(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Get("destroy_action").(string) == flinkJobDestroyActionCancel && d.Get("destroy_savepoint_path").(string) != "" {
+		return fmtp.Errorf("destroy_savepoint_path can only be specified when destroy_action is %s",
+			flinkJobDestroyActionStopWithSavepoint)
+	}
+	return nil
+}
+
 func ResourceFlinkSqlJob() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceFlinkSqlJobCreate,
 		ReadContext:   resourceFlinkSqlJobRead,
 		UpdateContext: resourceFlinkSqlJobUpdate,
 		DeleteContext: resourceFlinkSqlJobDelete,
+		CustomizeDiff: validateFlinkJobDestroyAction,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -186,12 +210,36 @@ func ResourceFlinkSqlJob() *schema.Resource {
 
 			"runtime_config": common.TagsSchema(),
 
+			"savepoint_on_update": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				Default:      false,
+				RequiredWith: []string{"obs_bucket"},
+			},
+
+			"destroy_action": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      flinkJobDestroyActionCancel,
+				ValidateFunc: validation.StringInSlice(flinkJobDestroyActions, false),
+			},
+
+			"destroy_savepoint_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"tags": common.TagsForceNewSchema(),
 
 			"status": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+
+			"checkpoint_directory": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -337,6 +385,7 @@ func resourceFlinkSqlJobRead(ctx context.Context, d *schema.ResourceData, meta i
 		d.Set("resume_max_num", detail.JobConfig.ResumeMaxNum),
 		setRuntimeConfigToState(d, detail.JobConfig.RuntimeConfig),
 		d.Set("status", detail.Status),
+		d.Set("checkpoint_directory", buildFlinkJobCheckpointDirectory(detail)),
 	)
 	if setSdErr := mErr.ErrorOrNil(); setSdErr != nil {
 		return fmtp.DiagErrorf("Error setting vault fields: %s", setSdErr)
@@ -359,6 +408,14 @@ func resourceFlinkSqlJobDelete(ctx context.Context, d *schema.ResourceData, meta
 		return fmtp.DiagErrorf("the DLI flink job_id must be number. actual id=%s", d.Id())
 	}
 
+	if d.Get("destroy_action").(string) == flinkJobDestroyActionStopWithSavepoint {
+		sErr := stopFlinkJobWithSavepoint(ctx, client, jobId, d.Get("destroy_savepoint_path").(string),
+			d.Timeout(schema.TimeoutDelete))
+		if sErr != nil {
+			return diag.FromErr(sErr)
+		}
+	}
+
 	deleteRst, dErr := flinkjob.Delete(client, jobId)
 	if dErr != nil {
 		return fmtp.DiagErrorf("delete DLI flink job failed. %q:%s", jobId, dErr)
@@ -511,10 +568,12 @@ func authorizeObsBucket(client *golangsdk.ServiceClient, d *schema.ResourceData)
 func updateFlinkSqlJobWithStop(ctx context.Context, client *golangsdk.ServiceClient, jobId int,
 	d *schema.ResourceData) diag.Diagnostics {
 
-	if d.HasChangesExcept("smn_topic", "restart_when_exception", "resume_checkpoint", "resume_max_num", "obs_bucket") {
+	inRunningParams := []string{"smn_topic", "restart_when_exception", "resume_checkpoint", "resume_max_num",
+		"obs_bucket"}
+	if d.HasChangesExcept(append(inRunningParams, flinkJobLocalParams...)...) {
 		// 1. stop the job
 		_, err := flinkjob.Stop(client, flinkjob.StopFlinkJobInBatch{
-			TriggerSavepoint: utils.Bool(false),
+			TriggerSavepoint: utils.Bool(d.Get("savepoint_on_update").(bool)),
 			JobIds:           []int{jobId},
 		})
 
@@ -581,7 +640,7 @@ func updateFlinkSqlJobWithStop(ctx context.Context, client *golangsdk.ServiceCli
 		//3. run the flink job
 		_, runErr := flinkjob.Run(client, flinkjob.RunJobOpts{
 			JobIds:          []int{jobId},
-			ResumeSavepoint: utils.Bool(d.Get("resume_checkpoint").(bool)),
+			ResumeSavepoint: utils.Bool(d.Get("resume_checkpoint").(bool) || d.Get("savepoint_on_update").(bool)),
 		})
 		if runErr != nil {
 			return fmtp.DiagErrorf("Error run DLI flink job: %s", runErr)
@@ -603,3 +662,88 @@ func setRuntimeConfigToState(d *schema.ResourceData, configStr string) error {
 
 	return d.Set("runtime_config", utils.TagsToMap(rst))
 }
+
+// buildFlinkJobCheckpointDirectory returns the OBS directory under which the checkpoints of the job are saved, the
+// custom path of the JAR job is preferred, otherwise the default directory in the OBS bucket of the job is returned.
+// Each checkpoint is a sub-directory created by Flink, which is not returned by the job API.
+func buildFlinkJobCheckpointDirectory(detail flinkjob.Job) string {
+	if detail.JobConfig.CheckpointPath != "" {
+		return detail.JobConfig.CheckpointPath
+	}
+	if detail.JobConfig.ObsBucket == "" {
+		return ""
+	}
+	return fmt.Sprintf("obs://%s/jobs/checkpoint/%d", detail.JobConfig.ObsBucket, detail.JobId)
+}
+
+// stopFlinkJobWithSavepoint stops the running job after a savepoint is created. If the savepoint path is specified,
+// the savepoint is triggered to the path before the job is stopped, otherwise the savepoint is saved in the OBS
+// bucket of the job when the job is stopped.
+func stopFlinkJobWithSavepoint(ctx context.Context, client *golangsdk.ServiceClient, jobId int, savepointPath string,
+	timeout time.Duration) error {
+	detailRsp, err := flinkjob.Get(client, jobId)
+	if err != nil {
+		return fmtp.Errorf("error query DLI flink job (%d): %s", jobId, err)
+	}
+	// there is no state to be saved if the job is not running.
+	if detailRsp.JobDetail.Status != "job_running" {
+		logp.Printf("[WARN] the DLI flink job (%d) is %s, skip creating the savepoint", jobId,
+			detailRsp.JobDetail.Status)
+		return nil
+	}
+
+	if savepointPath != "" {
+		opts := map[string]interface{}{
+			"action":         "trigger",
+			"savepoint_path": savepointPath,
+		}
+		var rst flinkjob.CommonResp
+		_, err = client.Post(client.ServiceURL("streaming", "jobs", strconv.Itoa(jobId), "savepoint"), opts, &rst,
+			&golangsdk.RequestOpts{
+				MoreHeaders: flinkjob.RequestOpts.MoreHeaders,
+			})
+		if err == nil && !rst.IsSuccess {
+			err = fmtp.Errorf("%s", rst.Message)
+		}
+		if err != nil {
+			return fmtp.Errorf("error triggering the savepoint of DLI flink job (%d): %s", jobId, err)
+		}
+
+		if err = checkFlinkJobSavepointResult(ctx, client, jobId, timeout); err != nil {
+			return err
+		}
+	}
+
+	_, err = flinkjob.Stop(client, flinkjob.StopFlinkJobInBatch{
+		TriggerSavepoint: utils.Bool(savepointPath == ""),
+		JobIds:           []int{jobId},
+	})
+	if err != nil {
+		return fmtp.Errorf("error stopping DLI flink job (%d): %s", jobId, err)
+	}
+
+	return checkFlinkJobStopResult(ctx, client, jobId, timeout)
+}
+
+func checkFlinkJobSavepointResult(ctx context.Context, client *golangsdk.ServiceClient, id int,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"job_savepointing"},
+		Target:  []string{"job_running"},
+		Refresh: func() (interface{}, string, error) {
+			job, err := flinkjob.Get(client, id)
+			if err != nil {
+				return nil, "", err
+			}
+			return job, job.JobDetail.Status, nil
+		},
+		Timeout:      timeout,
+		PollInterval: 10 * time.Second,
+		Delay:        10 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmtp.Errorf("error waiting for the savepoint of DLI flink job (%d) to be created: %s", id, err)
+	}
+	return nil
+}
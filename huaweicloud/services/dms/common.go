@@ -0,0 +1,52 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+)
+
+// buildDmsInstancePath builds the request path of the sub-resources (such as topics, groups and users) of the DMS
+// instance.
+func buildDmsInstancePath(client *golangsdk.ServiceClient, instanceId, subPath string) string {
+	path := "v2/{project_id}/instances/{instance_id}/" + subPath
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	return strings.ReplaceAll(path, "{instance_id}", instanceId)
+}
+
+// resourceDmsInstanceSubResourceImportState imports the sub-resources which are identified by the name, such as
+// topics and consumer groups, the import ID is in the format of <instance_id>/<name>.
+func resourceDmsInstanceSubResourceImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <instance_id>/<name>")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", parts[0]),
+		d.Set("name", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
+
+// resourceDmsInstanceUserImportState imports the users of the instance, the import ID is in the format of
+// <instance_id>/<access_key>.
+func resourceDmsInstanceUserImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <instance_id>/<access_key>")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", parts[0]),
+		d.Set("access_key", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
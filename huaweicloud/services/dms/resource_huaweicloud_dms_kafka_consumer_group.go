@@ -0,0 +1,176 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDmsKafkaConsumerGroup is the impl for huaweicloud_dms_kafka_consumer_group resource.
+// The resource ID is in the format of <instance_id>/<name>.
+func ResourceDmsKafkaConsumerGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsKafkaConsumerGroupCreate,
+		ReadContext:   resourceDmsKafkaConsumerGroupRead,
+		UpdateContext: resourceDmsKafkaConsumerGroupUpdate,
+		DeleteContext: resourceDmsKafkaConsumerGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsInstanceSubResourceImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the Kafka instance.`,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 64),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9_.-]*$`),
+						"only letters, digits, periods (.), hyphens (-) and underscores (_) are allowed"),
+				),
+				Description: `Specifies the name of the consumer group.`,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+				Description:  `Specifies the description of the consumer group.`,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the state of the consumer group.`,
+			},
+			"coordinator_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `Indicates the ID of the broker which coordinates the consumer group.`,
+			},
+		},
+	}
+}
+
+// buildKafkaInstancePath builds the request URL of the Kafka APIs whose path starts with the engine after the
+// project ID.
+func buildKafkaInstancePath(client *golangsdk.ServiceClient, instanceId, subPath string) string {
+	path := "v2/{project_id}/kafka/instances/{instance_id}/" + subPath
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	return strings.ReplaceAll(path, "{instance_id}", instanceId)
+}
+
+func resourceDmsKafkaConsumerGroupCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	params := map[string]interface{}{
+		"group_name": name,
+		"group_desc": utils.ValueIngoreEmpty(d.Get("description")),
+	}
+	createPath := buildKafkaInstancePath(client, instanceId, "group")
+	if _, err = common.RequestApi(client, "POST", createPath, nil, params); err != nil {
+		return diag.Errorf("error creating Kafka consumer group: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceId, name))
+
+	return resourceDmsKafkaConsumerGroupRead(ctx, d, meta)
+}
+
+func resourceDmsKafkaConsumerGroupRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dms", region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	getPath := buildDmsInstancePath(client, d.Get("instance_id").(string), "groups/"+d.Get("name").(string))
+	respBody, err := common.RequestApi(client, "GET", getPath, nil, nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving Kafka consumer group")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("description", utils.PathSearch("group.group_desc", respBody, nil)),
+		d.Set("state", utils.PathSearch("group.state", respBody, nil)),
+		d.Set("coordinator_id", utils.PathSearch("group.coordinator_id", respBody, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDmsKafkaConsumerGroupUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	params := map[string]interface{}{
+		"group_name": name,
+		"group_desc": d.Get("description"),
+	}
+	updatePath := buildKafkaInstancePath(client, d.Get("instance_id").(string), "groups/"+name)
+	if _, err = common.RequestApi(client, "PUT", updatePath, nil, params); err != nil {
+		return diag.Errorf("error updating Kafka consumer group (%s): %s", d.Id(), err)
+	}
+
+	return resourceDmsKafkaConsumerGroupRead(ctx, d, meta)
+}
+
+func resourceDmsKafkaConsumerGroupDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	params := map[string]interface{}{
+		"group_ids": []string{d.Get("name").(string)},
+	}
+	deletePath := buildDmsInstancePath(client, d.Get("instance_id").(string), "groups/batch-delete")
+	respBody, err := common.RequestApi(client, "POST", deletePath, nil, params)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting Kafka consumer group")
+	}
+
+	// The batch deletion API returns the groups which failed to be deleted instead of an error code.
+	if failedGroup := utils.PathSearch("failed_groups[0]", respBody, nil); failedGroup != nil {
+		return diag.Errorf("error deleting Kafka consumer group (%s): %v",
+			d.Id(), utils.PathSearch("error_message", failedGroup, ""))
+	}
+
+	return nil
+}
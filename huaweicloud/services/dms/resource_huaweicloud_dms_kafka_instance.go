@@ -37,6 +37,7 @@ func ResourceDmsKafkaInstance() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: validateKafkaBrokerScaling,
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(50 * time.Minute),
 			Update: schema.DefaultTimeout(50 * time.Minute),
@@ -112,7 +113,6 @@ func ResourceDmsKafkaInstance() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
 			"product_id": {
 				Type:     schema.TypeString,
@@ -153,7 +153,6 @@ func ResourceDmsKafkaInstance() *schema.Resource {
 			"public_ip_ids": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 			"retention_policy": {
@@ -827,6 +826,12 @@ func resourceDmsKafkaInstanceUpdate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
+	if d.HasChange("broker_num") {
+		if err = scaleKafkaBrokers(ctx, d, meta); err != nil {
+			mErr = multierror.Append(mErr, err)
+		}
+	}
+
 	if d.HasChange("tags") {
 		// update tags
 		engine := "kafka"
@@ -905,6 +910,81 @@ func resizeInstance(ctx context.Context, d *schema.ResourceData, meta interface{
 	return nil
 }
 
+// validateKafkaBrokerScaling makes sure the brokers can only be added, and the public IPs of the new brokers are
+// appended to public_ip_ids.
+func validateKafkaBrokerScaling(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	oldNum, newNum := d.GetChange("broker_num")
+	if newNum.(int) < oldNum.(int) {
+		return fmtp.Errorf("the number of brokers can not be decreased from %d to %d", oldNum, newNum)
+	}
+
+	if d.HasChange("public_ip_ids") {
+		oldRaw, newRaw := d.GetChange("public_ip_ids")
+		oldIds := utils.ExpandToStringList(oldRaw.([]interface{}))
+		newIds := utils.ExpandToStringList(newRaw.([]interface{}))
+		// Changing the public IPs in use still creates a new instance.
+		if len(oldIds) == 0 || len(newIds) < len(oldIds) ||
+			strings.Join(newIds[:len(oldIds)], ",") != strings.Join(oldIds, ",") {
+			return d.ForceNew("public_ip_ids")
+		}
+		if len(newIds)-len(oldIds) != newNum.(int)-oldNum.(int) {
+			return fmtp.Errorf("%d public IPs must be appended for the new brokers, but got %d",
+				newNum.(int)-oldNum.(int), len(newIds)-len(oldIds))
+		}
+	}
+	return nil
+}
+
+// scaleKafkaBrokers adds the brokers of the instance, the public IPs of the new brokers are required if the public
+// access is enabled.
+// scaleKafkaBrokers increases the broker number of the instance. The partitions of the existing topics stay on the
+// old brokers, they must be reassigned (see huaweicloud_dms_kafka_partition_reassign) to use the new brokers.
+func scaleKafkaBrokers(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	client, err := config.NewServiceClient("dms", config.GetRegion(d))
+	if err != nil {
+		return fmtp.Errorf("error creating DMS client: %s", err)
+	}
+	dmsV2Client, err := config.DmsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmtp.Errorf("error creating HuaweiCloud DMS instance client: %s", err)
+	}
+
+	brokerNum := d.Get("broker_num").(int)
+	params := map[string]interface{}{
+		"oper_type":      "horizontal",
+		"new_broker_num": brokerNum,
+	}
+	oldRaw, newRaw := d.GetChange("public_ip_ids")
+	if newIds := utils.ExpandToStringList(newRaw.([]interface{})); len(newIds) > len(oldRaw.([]interface{})) {
+		params["publicip_id"] = strings.Join(newIds[len(oldRaw.([]interface{})):], ",")
+	}
+
+	logp.Printf("[DEBUG] Scale DMS kafka brokers option : %#v", params)
+	extendPath := buildKafkaInstancePath(client, d.Id(), "extend")
+	if _, err = common.RequestApi(client, "POST", extendPath, nil, params); err != nil {
+		return fmtp.Errorf("error scaling the brokers of DMS kafka instance: %s", err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"EXTENDING", "REFRESHING"},
+		Target:       []string{"RUNNING"},
+		Refresh:      refreshKafkaBrokerNumFunc(dmsV2Client, d.Id(), brokerNum),
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        300 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	_, err = stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return fmtp.Errorf("error waiting for the brokers of instance (%s) to be scaled: %v", d.Id(), err)
+	}
+	return nil
+}
+
 func resourceDmsKafkaInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*config.Config)
 	dmsV2Client, err := config.DmsV2Client(config.GetRegion(d))
@@ -985,6 +1065,23 @@ func refreshResizeProductIDFunc(client *golangsdk.ServiceClient, instanceID,
 	}
 }
 
+func refreshKafkaBrokerNumFunc(client *golangsdk.ServiceClient, instanceID string,
+	brokerNum int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := instances.Get(client, instanceID).Extract()
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return v, "DELETED", nil
+			}
+			return nil, "", err
+		}
+		if v.Status == "RUNNING" && v.BrokerNum != brokerNum {
+			return v, "REFRESHING", nil
+		}
+		return v, v.Status, nil
+	}
+}
+
 func getAvailableZoneIDByCode(config *config.Config, region string, azCodes []interface{}) ([]string, error) {
 	if len(azCodes) == 0 {
 		return nil, fmtp.Errorf("availability_zones is required")
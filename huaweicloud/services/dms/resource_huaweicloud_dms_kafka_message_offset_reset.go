@@ -0,0 +1,126 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// ResourceDmsKafkaMessageOffsetReset is the impl for huaweicloud_dms_kafka_message_offset_reset resource, which resets
+// the consumer offset of the consumer group to the specified offset or time.
+// The operation can not be undone, so destroying the resource only removes it from the state.
+// The resource ID is in the format of <instance_id>/<group>/<topic>.
+func ResourceDmsKafkaMessageOffsetReset() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsKafkaMessageOffsetResetCreate,
+		ReadContext:   resourceDmsKafkaMessageOffsetResetRead,
+		DeleteContext: resourceDmsKafkaMessageOffsetResetDelete,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the Kafka instance.`,
+			},
+			"group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the consumer group.`,
+			},
+			"topic": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the topic.`,
+			},
+			"partition": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     -1,
+				Description: `Specifies the partition number, -1 means all partitions of the topic.`,
+			},
+			"message_offset": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"timestamp"},
+				Description:  `Specifies the message offset to which the consumer offset is reset.`,
+			},
+			"timestamp": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the time, in milliseconds, to which the consumer offset is reset.`,
+			},
+		},
+	}
+}
+
+func resourceDmsKafkaMessageOffsetResetCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	group := d.Get("group").(string)
+	topic := d.Get("topic").(string)
+	params := map[string]interface{}{
+		"topic":     topic,
+		"partition": d.Get("partition"),
+	}
+	// The message offset can be reset to zero, so the presence is checked on the raw configuration.
+	if !d.GetRawConfig().GetAttr("message_offset").IsNull() {
+		params["message_offset"] = d.Get("message_offset")
+	} else {
+		params["timestamp"] = d.Get("timestamp")
+	}
+	resetPath := buildDmsInstancePath(client, instanceId,
+		fmt.Sprintf("management/groups/%s/reset-message-offset", group))
+	if _, err = common.RequestApi(client, "POST", resetPath, nil, params); err != nil {
+		return diag.Errorf("error resetting the message offset of Kafka consumer group (%s): %s", group, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceId, group, topic))
+
+	return resourceDmsKafkaMessageOffsetResetRead(ctx, d, meta)
+}
+
+func resourceDmsKafkaMessageOffsetResetRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dms", region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	// The reset operation has no record, only make sure the consumer group still exists.
+	getPath := buildDmsInstancePath(client, d.Get("instance_id").(string), "groups/"+d.Get("group").(string))
+	if _, err = common.RequestApi(client, "GET", getPath, nil, nil); err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving Kafka consumer group")
+	}
+
+	return diag.FromErr(d.Set("region", region))
+}
+
+func resourceDmsKafkaMessageOffsetResetDelete(_ context.Context, _ *schema.ResourceData,
+	_ interface{}) diag.Diagnostics {
+	return nil
+}
@@ -0,0 +1,251 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDmsKafkaPartitionReassign is the impl for huaweicloud_dms_kafka_partition_reassign resource, which
+// reassigns the partitions of the topics to the specified brokers, e.g. after the brokers are added.
+// The reassignment can not be undone, so destroying the resource only removes it from the state.
+// The resource ID is in the format of <instance_id>/<task_id>.
+func ResourceDmsKafkaPartitionReassign() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsKafkaPartitionReassignCreate,
+		ReadContext:   resourceDmsKafkaPartitionReassignRead,
+		DeleteContext: resourceDmsKafkaPartitionReassignDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the Kafka instance.`,
+			},
+			"reassignments": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the reassignment plans of the topics.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"topic": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: `Specifies the name of the topic.`,
+						},
+						"brokers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: `Specifies the IDs of the brokers to which the partitions are automatically assigned.`,
+						},
+						"replication_factor": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `Specifies the replication factor used in the automatic assignment.`,
+						},
+						"assignment": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							Description: `Specifies the manual assignment plan of the partitions.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"partition": {
+										Type:        schema.TypeInt,
+										Required:    true,
+										ForceNew:    true,
+										Description: `Specifies the partition number.`,
+									},
+									"partition_brokers": {
+										Type:        schema.TypeList,
+										Required:    true,
+										ForceNew:    true,
+										Elem:        &schema.Schema{Type: schema.TypeInt},
+										Description: `Specifies the IDs of the brokers where the replicas of the partition are located.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"throttle": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(-1, 300000),
+				Description:  `Specifies the bandwidth limit of the reassignment, in KB/s.`,
+			},
+			"task_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the ID of the reassignment task.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the status of the reassignment task.`,
+			},
+		},
+	}
+}
+
+func buildKafkaPartitionReassignments(d *schema.ResourceData) []map[string]interface{} {
+	rawReassignments := d.Get("reassignments").([]interface{})
+	reassignments := make([]map[string]interface{}, 0, len(rawReassignments))
+	for _, v := range rawReassignments {
+		reassignment := v.(map[string]interface{})
+		params := map[string]interface{}{
+			"topic":              reassignment["topic"],
+			"replication_factor": utils.ValueIngoreEmpty(reassignment["replication_factor"]),
+		}
+		if brokers := reassignment["brokers"].([]interface{}); len(brokers) > 0 {
+			params["brokers"] = brokers
+		}
+
+		rawAssignment := reassignment["assignment"].([]interface{})
+		if len(rawAssignment) > 0 {
+			assignment := make([]map[string]interface{}, 0, len(rawAssignment))
+			for _, a := range rawAssignment {
+				partition := a.(map[string]interface{})
+				assignment = append(assignment, map[string]interface{}{
+					"partition":         partition["partition"],
+					"partition_brokers": partition["partition_brokers"],
+				})
+			}
+			params["assignment"] = assignment
+		}
+		reassignments = append(reassignments, params)
+	}
+	return reassignments
+}
+
+func resourceDmsKafkaPartitionReassignCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	params := map[string]interface{}{
+		"reassignments": buildKafkaPartitionReassignments(d),
+		"throttle":      utils.ValueIngoreEmpty(d.Get("throttle")),
+	}
+	reassignPath := "v2/kafka/{project_id}/instances/{instance_id}/reassign"
+	reassignPath = strings.ReplaceAll(reassignPath, "{project_id}", client.ProjectID)
+	reassignPath = strings.ReplaceAll(reassignPath, "{instance_id}", instanceId)
+	respBody, err := common.RequestApi(client, "POST", reassignPath, nil, params)
+	if err != nil {
+		return diag.Errorf("error reassigning the partitions of Kafka instance (%s): %s", instanceId, err)
+	}
+
+	taskId := utils.PathSearch("job_id", respBody, "").(string)
+	if taskId == "" {
+		return diag.Errorf("unable to find the reassignment task ID from the API response")
+	}
+	d.SetId(fmt.Sprintf("%s/%s", instanceId, taskId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"CREATED", "EXECUTING"},
+		Target:       []string{"SUCCESS"},
+		Refresh:      kafkaInstanceTaskStatusRefreshFunc(client, instanceId, taskId),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the partitions of Kafka instance (%s) to be reassigned: %s",
+			instanceId, err)
+	}
+
+	return resourceDmsKafkaPartitionReassignRead(ctx, d, meta)
+}
+
+func getKafkaInstanceTask(client *golangsdk.ServiceClient, instanceId, taskId string) (interface{}, error) {
+	respBody, err := common.RequestApi(client, "GET", buildDmsInstancePath(client, instanceId, "tasks/"+taskId), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	task := utils.PathSearch("tasks[0]", respBody, nil)
+	if task == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return task, nil
+}
+
+func kafkaInstanceTaskStatusRefreshFunc(client *golangsdk.ServiceClient, instanceId,
+	taskId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		task, err := getKafkaInstanceTask(client, instanceId, taskId)
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := utils.PathSearch("status", task, "").(string)
+		if status == "FAILED" {
+			return task, status, fmt.Errorf("the task (%s) failed", taskId)
+		}
+		return task, status, nil
+	}
+}
+
+func resourceDmsKafkaPartitionReassignRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dms", region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	taskId := strings.TrimPrefix(d.Id(), instanceId+"/")
+	task, err := getKafkaInstanceTask(client, instanceId, taskId)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving Kafka partition reassignment task")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("task_id", taskId),
+		d.Set("status", utils.PathSearch("status", task, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDmsKafkaPartitionReassignDelete(_ context.Context, _ *schema.ResourceData,
+	_ interface{}) diag.Diagnostics {
+	return nil
+}
@@ -0,0 +1,185 @@
+package dms
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDmsKafkaSmartConnect is the impl for huaweicloud_dms_kafka_smart_connect resource, which enables the Smart
+// Connect of the Kafka instance to run the data dumping and replication tasks.
+// The resource ID is the ID of the connector.
+func ResourceDmsKafkaSmartConnect() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsKafkaSmartConnectCreate,
+		ReadContext:   resourceDmsKafkaSmartConnectRead,
+		DeleteContext: resourceDmsKafkaSmartConnectDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the Kafka instance.`,
+			},
+			"bandwidth": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the bandwidth of the Kafka instance, e.g. 100MB.`,
+			},
+			"node_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      2,
+				ValidateFunc: validation.IntAtLeast(2),
+				Description:  `Specifies the number of the Smart Connect nodes.`,
+			},
+		},
+	}
+}
+
+func resourceDmsKafkaSmartConnectCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	params := map[string]interface{}{
+		"specification": d.Get("bandwidth"),
+		"node_cnt":      d.Get("node_count"),
+	}
+	respBody, err := common.RequestApi(client, "POST", buildDmsInstancePath(client, instanceId, "connector"), nil, params)
+	if err != nil {
+		return diag.Errorf("error enabling the Smart Connect of Kafka instance (%s): %s", instanceId, err)
+	}
+
+	connectorId := utils.PathSearch("connector_id", respBody, "").(string)
+	if connectorId == "" {
+		return diag.Errorf("unable to find the connector ID from the API response")
+	}
+	d.SetId(connectorId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"ENABLED"},
+		Refresh:      kafkaSmartConnectStatusRefreshFunc(client, instanceId),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        30 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the Smart Connect of Kafka instance (%s) to be enabled: %s",
+			instanceId, err)
+	}
+
+	return resourceDmsKafkaSmartConnectRead(ctx, d, meta)
+}
+
+// getKafkaInstanceDetail queries the instance detail, which also contains the information of the Smart Connect.
+func getKafkaInstanceDetail(client *golangsdk.ServiceClient, instanceId string) (interface{}, error) {
+	getPath := strings.TrimSuffix(buildDmsInstancePath(client, instanceId, ""), "/")
+	return common.RequestApi(client, "GET", getPath, nil, nil)
+}
+
+// kafkaSmartConnectStatusRefreshFunc returns PENDING until the instance is running, and then returns whether the
+// Smart Connect is enabled.
+func kafkaSmartConnectStatusRefreshFunc(client *golangsdk.ServiceClient, instanceId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instance, err := getKafkaInstanceDetail(client, instanceId)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if utils.PathSearch("status", instance, "").(string) != "RUNNING" {
+			return instance, "PENDING", nil
+		}
+		if utils.PathSearch("connector_enable", instance, false).(bool) {
+			return instance, "ENABLED", nil
+		}
+		return instance, "DISABLED", nil
+	}
+}
+
+func resourceDmsKafkaSmartConnectRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dms", region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instance, err := getKafkaInstanceDetail(client, d.Get("instance_id").(string))
+	if err == nil && utils.PathSearch("connector_id", instance, "").(string) != d.Id() {
+		err = golangsdk.ErrDefault404{}
+	}
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving Kafka Smart Connect")
+	}
+
+	return diag.FromErr(d.Set("region", region))
+}
+
+func resourceDmsKafkaSmartConnectDelete(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	params := map[string]interface{}{
+		"connector_id": d.Id(),
+	}
+	_, err = common.RequestApi(client, "PUT", buildKafkaInstancePath(client, instanceId, "delete-connector"), nil, params)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error disabling Kafka Smart Connect")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING", "ENABLED"},
+		Target:       []string{"DISABLED"},
+		Refresh:      kafkaSmartConnectStatusRefreshFunc(client, instanceId),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        30 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the Smart Connect of Kafka instance (%s) to be disabled: %s",
+			instanceId, err)
+	}
+
+	return nil
+}
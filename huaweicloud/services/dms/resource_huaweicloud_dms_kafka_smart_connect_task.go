@@ -0,0 +1,512 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDmsKafkaSmartConnectTask is the impl for huaweicloud_dms_kafka_smart_connect_task resource, which dumps
+// the messages to OBS or replicates the messages between Kafka instances.
+// The resource ID is the ID of the task, and the import ID is in the format of <instance_id>/<id>.
+func ResourceDmsKafkaSmartConnectTask() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsKafkaSmartConnectTaskCreate,
+		ReadContext:   resourceDmsKafkaSmartConnectTaskRead,
+		DeleteContext: resourceDmsKafkaSmartConnectTaskDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsKafkaSmartConnectTaskImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the Kafka instance.`,
+			},
+			"task_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(4, 64),
+				Description:  `Specifies the name of the Smart Connect task.`,
+			},
+			"start_later": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: `Specifies whether to start the task later.`,
+			},
+			"topics": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"topics_regex"},
+				Description:   `Specifies the names of the topics to be dumped or replicated.`,
+			},
+			"topics_regex": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `Specifies the regular expression of the topics to be dumped or replicated.`,
+			},
+			"source_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "NONE",
+				ValidateFunc: validation.StringInSlice([]string{"KAFKA_REPLICATOR_SOURCE", "NONE"}, false),
+				Description:  `Specifies the source type of the task.`,
+			},
+			"source_task": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem:        kafkaReplicatorSourceTaskSchema(),
+				Description: `Specifies the configuration of the Kafka replication task.`,
+			},
+			"sink_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "NONE",
+				ValidateFunc: validation.StringInSlice([]string{"OBS_SINK", "NONE"}, false),
+				Description:  `Specifies the sink type of the task.`,
+			},
+			"sink_task": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem:        kafkaObsSinkTaskSchema(),
+				Description: `Specifies the configuration of the OBS dumping task.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the status of the Smart Connect task.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the creation time of the Smart Connect task.`,
+			},
+		},
+	}
+}
+
+func kafkaReplicatorSourceTaskSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"current_cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the alias of the current Kafka instance.`,
+			},
+			"cluster_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the alias of the peer Kafka instance.`,
+			},
+			"peer_instance_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"source_task.0.bootstrap_servers"},
+				Description:  `Specifies the ID of the peer Kafka instance.`,
+			},
+			"bootstrap_servers": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the connection addresses of the peer Kafka instance.`,
+			},
+			"security_protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"PLAINTEXT", "SASL_SSL", "SASL_PLAINTEXT"}, false),
+				Description:  `Specifies the authentication protocol of the peer Kafka instance.`,
+			},
+			"sasl_mechanism": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"PLAIN", "SCRAM-SHA-512"}, false),
+				Description:  `Specifies the authentication mechanism of the peer Kafka instance.`,
+			},
+			"user_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the username of the peer Kafka instance.`,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: `Specifies the password of the peer Kafka instance.`,
+			},
+			"direction": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"pull", "push", "two-way"}, false),
+				Description:  `Specifies the replication direction.`,
+			},
+			"sync_consumer_offsets_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies whether to synchronize the consumer offsets.`,
+			},
+			"replication_factor": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the number of replicas of the topics automatically created in the target.`,
+			},
+			"task_num": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the number of the data replication tasks.`,
+			},
+			"rename_topic_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies whether to add the alias of the source instance to the target topic names.`,
+			},
+			"provenance_header_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies whether to add the source header to the replicated messages.`,
+			},
+			"consumer_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"latest", "earliest"}, false),
+				Description:  `Specifies the start offset of the replication.`,
+			},
+			"compression_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"none", "gzip", "snappy", "lz4", "zstd"}, false),
+				Description:  `Specifies the compression algorithm of the replicated messages.`,
+			},
+			"topics_mapping": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the topic mappings in the format of source_topic:target_topic.`,
+			},
+		},
+	}
+}
+
+func kafkaObsSinkTaskSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"consumer_strategy": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"latest", "earliest"}, false),
+				Description:  `Specifies the start offset of the dumping.`,
+			},
+			"destination_file_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "TEXT",
+				Description: `Specifies the format of the dumped files.`,
+			},
+			"deliver_time_interval": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(30, 900),
+				Description:  `Specifies the dumping period, in seconds.`,
+			},
+			"access_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: `Specifies the access key used to access the OBS bucket.`,
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: `Specifies the secret key used to access the OBS bucket.`,
+			},
+			"obs_bucket_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the OBS bucket.`,
+			},
+			"obs_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the directory of the dumped files in the OBS bucket.`,
+			},
+			"partition_format": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the time format of the dumping directories, e.g. yyyy/MM/dd/HH/mm.`,
+			},
+			"record_delimiter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the delimiter between the dumped records.`,
+			},
+			"store_keys": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies whether to dump the message keys.`,
+			},
+		},
+	}
+}
+
+func buildKafkaReplicatorSourceTask(d *schema.ResourceData) interface{} {
+	rawTasks := d.Get("source_task").([]interface{})
+	if len(rawTasks) == 0 || rawTasks[0] == nil {
+		return nil
+	}
+
+	task := rawTasks[0].(map[string]interface{})
+	return map[string]interface{}{
+		"current_cluster_name":          task["current_cluster_name"],
+		"cluster_name":                  task["cluster_name"],
+		"instance_id":                   utils.ValueIngoreEmpty(task["peer_instance_id"]),
+		"bootstrap_servers":             utils.ValueIngoreEmpty(task["bootstrap_servers"]),
+		"security_protocol":             utils.ValueIngoreEmpty(task["security_protocol"]),
+		"sasl_mechanism":                utils.ValueIngoreEmpty(task["sasl_mechanism"]),
+		"user_name":                     utils.ValueIngoreEmpty(task["user_name"]),
+		"password":                      utils.ValueIngoreEmpty(task["password"]),
+		"direction":                     task["direction"],
+		"sync_consumer_offsets_enabled": task["sync_consumer_offsets_enabled"],
+		"replication_factor":            utils.ValueIngoreEmpty(task["replication_factor"]),
+		"task_num":                      utils.ValueIngoreEmpty(task["task_num"]),
+		"rename_topic_enable":           task["rename_topic_enabled"],
+		"provenance_header_enabled":     task["provenance_header_enabled"],
+		"consumer_strategy":             utils.ValueIngoreEmpty(task["consumer_strategy"]),
+		"compression_type":              utils.ValueIngoreEmpty(task["compression_type"]),
+		"topics_mapping":                utils.ValueIngoreEmpty(joinKafkaStringList(task["topics_mapping"])),
+	}
+}
+
+func buildKafkaObsSinkTask(d *schema.ResourceData) interface{} {
+	rawTasks := d.Get("sink_task").([]interface{})
+	if len(rawTasks) == 0 || rawTasks[0] == nil {
+		return nil
+	}
+
+	task := rawTasks[0].(map[string]interface{})
+	return map[string]interface{}{
+		"consumer_strategy":     task["consumer_strategy"],
+		"destination_file_type": task["destination_file_type"],
+		"deliver_time_interval": task["deliver_time_interval"],
+		"access_key":            task["access_key"],
+		"secret_key":            task["secret_key"],
+		"obs_bucket_name":       task["obs_bucket_name"],
+		"obs_path":              utils.ValueIngoreEmpty(task["obs_path"]),
+		"partition_format":      task["partition_format"],
+		"record_delimiter":      utils.ValueIngoreEmpty(task["record_delimiter"]),
+		"store_keys":            task["store_keys"],
+	}
+}
+
+// joinKafkaStringList joins the elements of the list with commas, as the Smart Connect APIs require.
+func joinKafkaStringList(rawList interface{}) string {
+	return strings.Join(utils.ExpandToStringList(rawList.([]interface{})), ",")
+}
+
+func resourceDmsKafkaSmartConnectTaskCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	params := map[string]interface{}{
+		"task_name":    d.Get("task_name"),
+		"start_later":  d.Get("start_later"),
+		"topics":       utils.ValueIngoreEmpty(joinKafkaStringList(d.Get("topics"))),
+		"topics_regex": utils.ValueIngoreEmpty(d.Get("topics_regex")),
+		"source_type":  d.Get("source_type"),
+		"source_task":  buildKafkaReplicatorSourceTask(d),
+		"sink_type":    d.Get("sink_type"),
+		"sink_task":    buildKafkaObsSinkTask(d),
+	}
+	createPath := buildDmsInstancePath(client, instanceId, "connector/tasks")
+	respBody, err := common.RequestApi(client, "POST", createPath, nil, params)
+	if err != nil {
+		return diag.Errorf("error creating Kafka Smart Connect task: %s", err)
+	}
+
+	taskId := utils.PathSearch("id", respBody, "").(string)
+	if taskId == "" {
+		return diag.Errorf("unable to find the Smart Connect task ID from the API response")
+	}
+	d.SetId(taskId)
+
+	target := "RUNNING"
+	if d.Get("start_later").(bool) {
+		target = "WAITING"
+	}
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"CREATING"},
+		Target:       []string{target},
+		Refresh:      kafkaSmartConnectTaskStatusRefreshFunc(client, instanceId, taskId),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for Kafka Smart Connect task (%s) to be created: %s", taskId, err)
+	}
+
+	return resourceDmsKafkaSmartConnectTaskRead(ctx, d, meta)
+}
+
+func kafkaSmartConnectTaskStatusRefreshFunc(client *golangsdk.ServiceClient, instanceId,
+	taskId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getPath := buildDmsInstancePath(client, instanceId, "connector/tasks/"+taskId)
+		task, err := common.RequestApi(client, "GET", getPath, nil, nil)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		status := utils.PathSearch("status", task, "").(string)
+		if status == "ERROR" {
+			return task, status, fmt.Errorf("the status of the task is ERROR")
+		}
+		return task, status, nil
+	}
+}
+
+func resourceDmsKafkaSmartConnectTaskRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dms", region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	getPath := buildDmsInstancePath(client, d.Get("instance_id").(string), "connector/tasks/"+d.Id())
+	task, err := common.RequestApi(client, "GET", getPath, nil, nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving Kafka Smart Connect task")
+	}
+
+	var topics []string
+	if rawTopics := utils.PathSearch("topics", task, "").(string); rawTopics != "" {
+		topics = strings.Split(rawTopics, ",")
+	}
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("task_name", utils.PathSearch("task_name", task, nil)),
+		d.Set("topics", topics),
+		d.Set("topics_regex", utils.PathSearch("topics_regex", task, nil)),
+		d.Set("source_type", utils.PathSearch("source_type", task, nil)),
+		d.Set("sink_type", utils.PathSearch("sink_type", task, nil)),
+		d.Set("status", utils.PathSearch("status", task, nil)),
+		d.Set("created_at", utils.FormatTimeStampRFC3339(
+			int64(utils.PathSearch("create_time", task, float64(0)).(float64))/1000, false)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDmsKafkaSmartConnectTaskDelete(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	deletePath := buildDmsInstancePath(client, instanceId, "connector/tasks/"+d.Id())
+	if _, err = common.RequestApi(client, "DELETE", deletePath, nil, nil); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting Kafka Smart Connect task")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"RUNNING", "WAITING", "PAUSED", "DELETING"},
+		Target:       []string{"DELETED"},
+		Refresh:      kafkaSmartConnectTaskStatusRefreshFunc(client, instanceId, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for Kafka Smart Connect task (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceDmsKafkaSmartConnectTaskImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <instance_id>/<id>")
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("instance_id", parts[0])
+}
@@ -0,0 +1,185 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDmsRabbitMQBinding is the impl for huaweicloud_dms_rabbitmq_binding resource, which binds a queue or an
+// exchange to the source exchange.
+// The resource ID is in the format of <instance_id>,<vhost>,<exchange>,<destination_type>,<destination>,<routing_key>.
+func ResourceDmsRabbitMQBinding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsRabbitMQBindingCreate,
+		ReadContext:   resourceDmsRabbitMQBindingRead,
+		DeleteContext: resourceDmsRabbitMQBindingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsRabbitMQBindingImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the RabbitMQ instance.`,
+			},
+			"vhost": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the virtual host.`,
+			},
+			"exchange": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the source exchange.`,
+			},
+			"destination_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"queue", "exchange"}, false),
+				Description:  `Specifies the type of the binding destination.`,
+			},
+			"destination": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the destination queue or exchange.`,
+			},
+			"routing_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the routing key of the binding.`,
+			},
+			"properties_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the unique key of the binding, which is generated from the routing key.`,
+			},
+		},
+	}
+}
+
+func resourceDmsRabbitMQBindingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	vhost := d.Get("vhost").(string)
+	exchange := d.Get("exchange").(string)
+	params := map[string]interface{}{
+		"destination_type": d.Get("destination_type"),
+		"destination":      d.Get("destination"),
+		"routing_key":      d.Get("routing_key"),
+	}
+	createPath := buildRabbitMQPath(client, instanceId, vhost, "exchanges/"+url.PathEscape(exchange)+"/binding")
+	if _, err = common.RequestApi(client, "POST", createPath, nil, params); err != nil {
+		return diag.Errorf("error creating RabbitMQ binding: %s", err)
+	}
+
+	d.SetId(strings.Join([]string{instanceId, vhost, exchange, d.Get("destination_type").(string),
+		d.Get("destination").(string), d.Get("routing_key").(string)}, ","))
+
+	return resourceDmsRabbitMQBindingRead(ctx, d, meta)
+}
+
+func getRabbitMQBinding(client *golangsdk.ServiceClient, d *schema.ResourceData) (interface{}, error) {
+	listPath := buildRabbitMQPath(client, d.Get("instance_id").(string), d.Get("vhost").(string),
+		"exchanges/"+url.PathEscape(d.Get("exchange").(string))+"/binding")
+	bindings, err := listRabbitMQItems(client, listPath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, binding := range bindings {
+		if utils.PathSearch("destination_type", binding, "").(string) == d.Get("destination_type").(string) &&
+			utils.PathSearch("destination", binding, "").(string) == d.Get("destination").(string) &&
+			utils.PathSearch("routing_key", binding, "").(string) == d.Get("routing_key").(string) {
+			return binding, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func resourceDmsRabbitMQBindingRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dms", region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	binding, err := getRabbitMQBinding(client, d)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving RabbitMQ binding")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("properties_key", utils.PathSearch("properties_key", binding, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDmsRabbitMQBindingDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	subPath := fmt.Sprintf("exchanges/%s/destination-type/%s/destination/%s/properties-key/%s/unbinding",
+		url.PathEscape(d.Get("exchange").(string)), d.Get("destination_type").(string),
+		url.PathEscape(d.Get("destination").(string)), url.PathEscape(d.Get("properties_key").(string)))
+	deletePath := buildRabbitMQPath(client, d.Get("instance_id").(string), d.Get("vhost").(string), subPath)
+	if _, err = common.RequestApi(client, "DELETE", deletePath, nil, nil); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting RabbitMQ binding")
+	}
+
+	return nil
+}
+
+func resourceDmsRabbitMQBindingImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseRabbitMQResourceID(d.Id(),
+		"<instance_id>,<vhost>,<exchange>,<destination_type>,<destination>,<routing_key>", 6)
+	if err != nil {
+		return nil, err
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", parts[0]),
+		d.Set("vhost", parts[1]),
+		d.Set("exchange", parts[2]),
+		d.Set("destination_type", parts[3]),
+		d.Set("destination", parts[4]),
+		d.Set("routing_key", parts[5]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
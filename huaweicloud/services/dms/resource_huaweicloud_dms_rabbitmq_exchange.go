@@ -0,0 +1,169 @@
+package dms
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDmsRabbitMQExchange is the impl for huaweicloud_dms_rabbitmq_exchange resource.
+// The resource ID is in the format of <instance_id>,<vhost>,<name>.
+func ResourceDmsRabbitMQExchange() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsRabbitMQExchangeCreate,
+		ReadContext:   resourceDmsRabbitMQExchangeRead,
+		DeleteContext: resourceDmsRabbitMQExchangeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsRabbitMQExchangeImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the RabbitMQ instance.`,
+			},
+			"vhost": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the virtual host.`,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+				Description:  `Specifies the name of the exchange.`,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"direct", "fanout", "topic", "headers"}, false),
+				Description:  `Specifies the type of the exchange.`,
+			},
+			"durable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: `Specifies whether the exchange survives a broker restart.`,
+			},
+			"auto_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: `Specifies whether the exchange is deleted when the last binding is removed.`,
+			},
+			"internal": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: `Specifies whether the exchange can only be bound by other exchanges.`,
+			},
+		},
+	}
+}
+
+func resourceDmsRabbitMQExchangeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	vhost := d.Get("vhost").(string)
+	name := d.Get("name").(string)
+	params := map[string]interface{}{
+		"name":        name,
+		"type":        d.Get("type"),
+		"durable":     d.Get("durable"),
+		"auto_delete": d.Get("auto_delete"),
+		"internal":    d.Get("internal"),
+	}
+	createPath := buildRabbitMQPath(client, instanceId, vhost, "exchanges")
+	if _, err = common.RequestApi(client, "POST", createPath, nil, params); err != nil {
+		return diag.Errorf("error creating RabbitMQ exchange: %s", err)
+	}
+
+	d.SetId(strings.Join([]string{instanceId, vhost, name}, ","))
+
+	return resourceDmsRabbitMQExchangeRead(ctx, d, meta)
+}
+
+func resourceDmsRabbitMQExchangeRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dms", region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	listPath := buildRabbitMQPath(client, d.Get("instance_id").(string), d.Get("vhost").(string), "exchanges")
+	exchange, err := getRabbitMQItem(client, listPath, d.Get("name").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving RabbitMQ exchange")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("type", utils.PathSearch("type", exchange, nil)),
+		d.Set("durable", utils.PathSearch("durable", exchange, nil)),
+		d.Set("auto_delete", utils.PathSearch("auto_delete", exchange, nil)),
+		d.Set("internal", utils.PathSearch("internal", exchange, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDmsRabbitMQExchangeDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	params := map[string]interface{}{
+		"name": []string{d.Get("name").(string)},
+	}
+	deletePath := buildRabbitMQPath(client, d.Get("instance_id").(string), d.Get("vhost").(string), "exchanges")
+	if _, err = common.RequestApi(client, "DELETE", deletePath, nil, params); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting RabbitMQ exchange")
+	}
+
+	return nil
+}
+
+func resourceDmsRabbitMQExchangeImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseRabbitMQResourceID(d.Id(), "<instance_id>,<vhost>,<name>", 3)
+	if err != nil {
+		return nil, err
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", parts[0]),
+		d.Set("vhost", parts[1]),
+		d.Set("name", parts[2]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
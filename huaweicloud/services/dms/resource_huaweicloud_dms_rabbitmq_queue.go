@@ -0,0 +1,198 @@
+package dms
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDmsRabbitMQQueue is the impl for huaweicloud_dms_rabbitmq_queue resource.
+// The resource ID is in the format of <instance_id>,<vhost>,<name>.
+func ResourceDmsRabbitMQQueue() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsRabbitMQQueueCreate,
+		ReadContext:   resourceDmsRabbitMQQueueRead,
+		DeleteContext: resourceDmsRabbitMQQueueDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsRabbitMQQueueImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the RabbitMQ instance.`,
+			},
+			"vhost": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the virtual host.`,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+				Description:  `Specifies the name of the queue.`,
+			},
+			"durable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: `Specifies whether the queue survives a broker restart.`,
+			},
+			"auto_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: `Specifies whether the queue is deleted when the last consumer unsubscribes.`,
+			},
+			"dead_letter_exchange": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the exchange to which the rejected or expired messages are republished.`,
+			},
+			"dead_letter_routing_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the routing key of the dead letter messages.`,
+			},
+			"message_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies how long a message published to the queue can live before it is discarded.`,
+			},
+			"lazy_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"lazy"}, false),
+				Description:  `Specifies whether to keep as many messages as possible on the disk.`,
+			},
+			"messages": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `Indicates the number of the accumulated messages.`,
+			},
+			"consumers": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `Indicates the number of the consumers.`,
+			},
+		},
+	}
+}
+
+func resourceDmsRabbitMQQueueCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	vhost := d.Get("vhost").(string)
+	name := d.Get("name").(string)
+	params := map[string]interface{}{
+		"name":                    name,
+		"durable":                 d.Get("durable"),
+		"auto_delete":             d.Get("auto_delete"),
+		"dead_letter_exchange":    utils.ValueIngoreEmpty(d.Get("dead_letter_exchange")),
+		"dead_letter_routing_key": utils.ValueIngoreEmpty(d.Get("dead_letter_routing_key")),
+		"message_ttl":             utils.ValueIngoreEmpty(d.Get("message_ttl")),
+		"lazy_mode":               utils.ValueIngoreEmpty(d.Get("lazy_mode")),
+	}
+	createPath := buildRabbitMQPath(client, instanceId, vhost, "queues")
+	if _, err = common.RequestApi(client, "POST", createPath, nil, params); err != nil {
+		return diag.Errorf("error creating RabbitMQ queue: %s", err)
+	}
+
+	d.SetId(strings.Join([]string{instanceId, vhost, name}, ","))
+
+	return resourceDmsRabbitMQQueueRead(ctx, d, meta)
+}
+
+func resourceDmsRabbitMQQueueRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dms", region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	getPath := buildRabbitMQPath(client, d.Get("instance_id").(string), d.Get("vhost").(string),
+		"queues/"+url.PathEscape(d.Get("name").(string)))
+	queue, err := common.RequestApi(client, "GET", getPath, nil, nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving RabbitMQ queue")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("durable", utils.PathSearch("durable", queue, nil)),
+		d.Set("auto_delete", utils.PathSearch("auto_delete", queue, nil)),
+		d.Set("dead_letter_exchange", utils.PathSearch(`arguments."x-dead-letter-exchange"`, queue, nil)),
+		d.Set("dead_letter_routing_key", utils.PathSearch(`arguments."x-dead-letter-routing-key"`, queue, nil)),
+		d.Set("message_ttl", utils.PathSearch(`arguments."x-message-ttl"`, queue, nil)),
+		d.Set("lazy_mode", utils.PathSearch(`arguments."x-queue-mode"`, queue, nil)),
+		d.Set("messages", utils.PathSearch("messages", queue, nil)),
+		d.Set("consumers", utils.PathSearch("consumers", queue, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDmsRabbitMQQueueDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	params := map[string]interface{}{
+		"name": []string{d.Get("name").(string)},
+	}
+	deletePath := buildRabbitMQPath(client, d.Get("instance_id").(string), d.Get("vhost").(string), "queues")
+	if _, err = common.RequestApi(client, "DELETE", deletePath, nil, params); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting RabbitMQ queue")
+	}
+
+	return nil
+}
+
+func resourceDmsRabbitMQQueueImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseRabbitMQResourceID(d.Id(), "<instance_id>,<vhost>,<name>", 3)
+	if err != nil {
+		return nil, err
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", parts[0]),
+		d.Set("vhost", parts[1]),
+		d.Set("name", parts[2]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
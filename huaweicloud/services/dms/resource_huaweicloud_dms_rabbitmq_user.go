@@ -0,0 +1,220 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDmsRabbitMQUser is the impl for huaweicloud_dms_rabbitmq_user resource, which manages the user and its
+// permissions on the virtual hosts.
+// The resource ID is in the format of <instance_id>/<access_key>.
+func ResourceDmsRabbitMQUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsRabbitMQUserCreate,
+		ReadContext:   resourceDmsRabbitMQUserRead,
+		UpdateContext: resourceDmsRabbitMQUserUpdate,
+		DeleteContext: resourceDmsRabbitMQUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsInstanceUserImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the RabbitMQ instance.`,
+			},
+			"access_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(4, 64),
+				Description:  `Specifies the name of the user.`,
+			},
+			"secret_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(8, 32),
+				Description:  `Specifies the password of the user.`,
+			},
+			"vhosts": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: `Specifies the permissions of the user on the virtual hosts.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vhost": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the name of the virtual host.`,
+						},
+						"conf": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the regular expression of the resources that can be configured.`,
+						},
+						"write": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the regular expression of the resources that can be written.`,
+						},
+						"read": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the regular expression of the resources that can be read.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// buildRabbitMQUserVhosts returns a generic slice so that an empty list is still sent to revoke all permissions.
+func buildRabbitMQUserVhosts(d *schema.ResourceData) []interface{} {
+	rawVhosts := d.Get("vhosts").(*schema.Set).List()
+	vhosts := make([]interface{}, 0, len(rawVhosts))
+	for _, v := range rawVhosts {
+		vhost := v.(map[string]interface{})
+		vhosts = append(vhosts, map[string]interface{}{
+			"vhost": vhost["vhost"],
+			"conf":  vhost["conf"],
+			"write": vhost["write"],
+			"read":  vhost["read"],
+		})
+	}
+	return vhosts
+}
+
+func resourceDmsRabbitMQUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	accessKey := d.Get("access_key").(string)
+	params := map[string]interface{}{
+		"access_key": accessKey,
+		"secret_key": d.Get("secret_key"),
+		"vhosts":     buildRabbitMQUserVhosts(d),
+	}
+	createPath := buildDmsInstancePath(client, instanceId, "users")
+	if _, err = common.RequestApi(client, "POST", createPath, nil, params); err != nil {
+		return diag.Errorf("error creating RabbitMQ user: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceId, accessKey))
+
+	return resourceDmsRabbitMQUserRead(ctx, d, meta)
+}
+
+func getRabbitMQUser(client *golangsdk.ServiceClient, instanceId, accessKey string) (interface{}, error) {
+	listPath := buildDmsInstancePath(client, instanceId, "users")
+	offset := 0
+	for {
+		respBody, err := common.RequestApi(client, "GET", fmt.Sprintf("%s?limit=100&offset=%d", listPath, offset), nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		users := utils.PathSearch("users", respBody, make([]interface{}, 0)).([]interface{})
+		user := utils.PathSearch(fmt.Sprintf("[?access_key=='%s']|[0]", accessKey), users, nil)
+		if user != nil {
+			return user, nil
+		}
+		if len(users) < 100 {
+			return nil, golangsdk.ErrDefault404{}
+		}
+		offset += len(users)
+	}
+}
+
+func resourceDmsRabbitMQUserRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dms", region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	user, err := getRabbitMQUser(client, d.Get("instance_id").(string), d.Get("access_key").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving RabbitMQ user")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("vhosts", flattenRabbitMQUserVhosts(user)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenRabbitMQUserVhosts(user interface{}) []interface{} {
+	rawVhosts := utils.PathSearch("vhosts", user, make([]interface{}, 0)).([]interface{})
+	vhosts := make([]interface{}, 0, len(rawVhosts))
+	for _, vhost := range rawVhosts {
+		vhosts = append(vhosts, map[string]interface{}{
+			"vhost": utils.PathSearch("vhost", vhost, nil),
+			"conf":  utils.PathSearch("conf", vhost, nil),
+			"write": utils.PathSearch("write", vhost, nil),
+			"read":  utils.PathSearch("read", vhost, nil),
+		})
+	}
+	return vhosts
+}
+
+func resourceDmsRabbitMQUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	params := map[string]interface{}{
+		"access_key": d.Get("access_key"),
+		"secret_key": d.Get("secret_key"),
+		"vhosts":     buildRabbitMQUserVhosts(d),
+	}
+	updatePath := buildDmsInstancePath(client, d.Get("instance_id").(string), "users/"+d.Get("access_key").(string))
+	if _, err = common.RequestApi(client, "PUT", updatePath, nil, params); err != nil {
+		return diag.Errorf("error updating RabbitMQ user (%s): %s", d.Id(), err)
+	}
+
+	return resourceDmsRabbitMQUserRead(ctx, d, meta)
+}
+
+func resourceDmsRabbitMQUserDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	deletePath := buildDmsInstancePath(client, d.Get("instance_id").(string), "users/"+d.Get("access_key").(string))
+	if _, err = common.RequestApi(client, "DELETE", deletePath, nil, nil); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting RabbitMQ user")
+	}
+
+	return nil
+}
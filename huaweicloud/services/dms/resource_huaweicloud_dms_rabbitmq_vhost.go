@@ -0,0 +1,191 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDmsRabbitMQVhost is the impl for huaweicloud_dms_rabbitmq_vhost resource.
+// The resource ID is in the format of <instance_id>,<name>, since the name of the virtual host may contain slashes.
+func ResourceDmsRabbitMQVhost() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsRabbitMQVhostCreate,
+		ReadContext:   resourceDmsRabbitMQVhostRead,
+		DeleteContext: resourceDmsRabbitMQVhostDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsRabbitMQVhostImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the RabbitMQ instance.`,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 255),
+				Description:  `Specifies the name of the virtual host.`,
+			},
+			"tracing": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `Indicates whether the message tracing is enabled.`,
+			},
+		},
+	}
+}
+
+// buildRabbitMQPath builds the request URL of the management APIs of the RabbitMQ instance. The slashes in the name
+// of the virtual host are escaped as the API requires.
+func buildRabbitMQPath(client *golangsdk.ServiceClient, instanceId, vhost, subPath string) string {
+	path := "v2/rabbitmq/{project_id}/instances/{instance_id}/vhosts"
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	path = strings.ReplaceAll(path, "{instance_id}", instanceId)
+	if vhost != "" {
+		path += "/" + strings.ReplaceAll(vhost, "/", "__F_SLASH__")
+	}
+	if subPath != "" {
+		path += "/" + subPath
+	}
+	return path
+}
+
+// parseRabbitMQResourceID splits the ID of the RabbitMQ resources separated by commas.
+func parseRabbitMQResourceID(id, format string, count int) ([]string, error) {
+	parts := strings.SplitN(id, ",", count)
+	if len(parts) != count {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be %s", format)
+	}
+	return parts, nil
+}
+
+// listRabbitMQItems queries all pages of the RabbitMQ management list API and returns the elements of the items.
+func listRabbitMQItems(client *golangsdk.ServiceClient, listPath string) ([]interface{}, error) {
+	result := make([]interface{}, 0)
+	offset := 0
+	for {
+		respBody, err := common.RequestApi(client, "GET", fmt.Sprintf("%s?limit=100&offset=%d", listPath, offset), nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		items := utils.PathSearch("items", respBody, make([]interface{}, 0)).([]interface{})
+		result = append(result, items...)
+		total := int(utils.PathSearch("total", respBody, float64(0)).(float64))
+		if len(items) == 0 || len(result) >= total {
+			return result, nil
+		}
+		offset += len(items)
+	}
+}
+
+// getRabbitMQItem queries the element with the specified name from the RabbitMQ management list API.
+func getRabbitMQItem(client *golangsdk.ServiceClient, listPath, name string) (interface{}, error) {
+	items, err := listRabbitMQItems(client, listPath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range items {
+		if utils.PathSearch("name", item, "").(string) == name {
+			return item, nil
+		}
+	}
+	return nil, golangsdk.ErrDefault404{}
+}
+
+func resourceDmsRabbitMQVhostCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	params := map[string]interface{}{
+		"name": name,
+	}
+	if _, err = common.RequestApi(client, "POST", buildRabbitMQPath(client, instanceId, "", ""), nil, params); err != nil {
+		return diag.Errorf("error creating RabbitMQ virtual host: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s,%s", instanceId, name))
+
+	return resourceDmsRabbitMQVhostRead(ctx, d, meta)
+}
+
+func resourceDmsRabbitMQVhostRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dms", region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	listPath := buildRabbitMQPath(client, d.Get("instance_id").(string), "", "")
+	vhost, err := getRabbitMQItem(client, listPath, d.Get("name").(string))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving RabbitMQ virtual host")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("tracing", utils.PathSearch("tracing", vhost, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDmsRabbitMQVhostDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	params := map[string]interface{}{
+		"name": []string{d.Get("name").(string)},
+	}
+	deletePath := buildRabbitMQPath(client, d.Get("instance_id").(string), "", "")
+	if _, err = common.RequestApi(client, "DELETE", deletePath, nil, params); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting RabbitMQ virtual host")
+	}
+
+	return nil
+}
+
+func resourceDmsRabbitMQVhostImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseRabbitMQResourceID(d.Id(), "<instance_id>,<name>", 2)
+	if err != nil {
+		return nil, err
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", parts[0]),
+		d.Set("name", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
@@ -0,0 +1,201 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDmsRocketMQConsumerGroup is the impl for huaweicloud_dms_rocketmq_consumer_group resource.
+// The resource ID is in the format of <instance_id>/<name>.
+func ResourceDmsRocketMQConsumerGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsRocketMQConsumerGroupCreate,
+		ReadContext:   resourceDmsRocketMQConsumerGroupRead,
+		UpdateContext: resourceDmsRocketMQConsumerGroupUpdate,
+		DeleteContext: resourceDmsRocketMQConsumerGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsInstanceSubResourceImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the RocketMQ instance.`,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 64),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9_%|-]*$`),
+						"only letters, digits, percent signs (%), vertical bars (|), hyphens (-) and "+
+							"underscores (_) are allowed"),
+				),
+				Description: `Specifies the name of the consumer group.`,
+			},
+			"brokers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the names of the brokers on which the consumer group is created.`,
+			},
+			"retry_max_times": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 16),
+				Description:  `Specifies the maximum number of retries of the consumption.`,
+			},
+			"broadcast": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: `Specifies whether to broadcast the messages to all consumers of the group.`,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: `Specifies whether the consumer group is allowed to consume messages.`,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+				Description:  `Specifies the description of the consumer group.`,
+			},
+		},
+	}
+}
+
+func resourceDmsRocketMQConsumerGroupCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	params := map[string]interface{}{
+		"name":           name,
+		"broadcast":      d.Get("broadcast"),
+		"retry_max_time": utils.ValueIngoreEmpty(d.Get("retry_max_times")),
+		"description":    utils.ValueIngoreEmpty(d.Get("description")),
+	}
+	if brokers := d.Get("brokers").([]interface{}); len(brokers) > 0 {
+		params["brokers"] = brokers
+	}
+	_, err = common.RequestApi(client, "POST", buildDmsInstancePath(client, instanceId, "groups"), nil, params)
+	if err != nil {
+		return diag.Errorf("error creating RocketMQ consumer group: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceId, name))
+
+	// The consumer group is always enabled after it is created.
+	if !d.Get("enabled").(bool) {
+		if err = updateRocketMQConsumerGroup(client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDmsRocketMQConsumerGroupRead(ctx, d, meta)
+}
+
+func resourceDmsRocketMQConsumerGroupRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dms", region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	getPath := buildDmsInstancePath(client, d.Get("instance_id").(string), "groups/"+d.Get("name").(string))
+	respBody, err := common.RequestApi(client, "GET", getPath, nil, nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving RocketMQ consumer group")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("brokers", utils.PathSearch("brokers", respBody, nil)),
+		d.Set("retry_max_times", utils.PathSearch("retry_max_time", respBody, nil)),
+		d.Set("broadcast", utils.PathSearch("broadcast", respBody, nil)),
+		d.Set("enabled", utils.PathSearch("enabled", respBody, nil)),
+		d.Set("description", utils.PathSearch("description", respBody, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func updateRocketMQConsumerGroup(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	params := map[string]interface{}{
+		"enabled":        d.Get("enabled"),
+		"broadcast":      d.Get("broadcast"),
+		"retry_max_time": utils.ValueIngoreEmpty(d.Get("retry_max_times")),
+		"description":    d.Get("description"),
+	}
+	updatePath := buildDmsInstancePath(client, d.Get("instance_id").(string), "groups/"+d.Get("name").(string))
+	if _, err := common.RequestApi(client, "PUT", updatePath, nil, params); err != nil {
+		return fmt.Errorf("error updating RocketMQ consumer group (%s): %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceDmsRocketMQConsumerGroupUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	if d.HasChanges("retry_max_times", "broadcast", "enabled", "description") {
+		if err = updateRocketMQConsumerGroup(client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDmsRocketMQConsumerGroupRead(ctx, d, meta)
+}
+
+func resourceDmsRocketMQConsumerGroupDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	deletePath := buildDmsInstancePath(client, d.Get("instance_id").(string), "groups/"+d.Get("name").(string))
+	if _, err = common.RequestApi(client, "DELETE", deletePath, nil, nil); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting RocketMQ consumer group")
+	}
+
+	return nil
+}
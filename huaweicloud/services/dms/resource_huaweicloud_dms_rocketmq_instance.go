@@ -223,8 +223,9 @@ func ResourceDmsRocketMQInstance() *schema.Resource {
 			},
 			"enable_acl": {
 				Type:        schema.TypeBool,
+				Optional:    true,
 				Computed:    true,
-				Description: `Indicates whether access control is enabled.`,
+				Description: `Specifies whether access control is enabled.`,
 			},
 			"namesrv_address": {
 				Type:        schema.TypeString,
@@ -351,6 +352,7 @@ func buildCreateRocketmqInstanceBodyParams(d *schema.ResourceData, config *confi
 		"enable_publicip":   utils.ValueIngoreEmpty(d.Get("enable_publicip")),
 		"publicip_id":       utils.ValueIngoreEmpty(d.Get("publicip_id")),
 		"broker_num":        utils.ValueIngoreEmpty(d.Get("broker_num")),
+		"enable_acl":        utils.ValueIngoreEmpty(d.Get("enable_acl")),
 	}
 	return bodyParams
 }
@@ -365,6 +367,7 @@ func resourceDmsRocketMQInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 		"security_group_id",
 		"retention_policy",
 		"cross_vpc_accesses",
+		"enable_acl",
 	}
 
 	if d.HasChanges(updateRocketmqInstancehasChanges...) {
@@ -408,6 +411,9 @@ func buildUpdateRocketmqInstanceBodyParams(d *schema.ResourceData, config *confi
 		"security_group_id": utils.ValueIngoreEmpty(d.Get("security_group_id")),
 		"retention_policy":  utils.ValueIngoreEmpty(d.Get("retention_policy")),
 	}
+	if d.HasChange("enable_acl") {
+		bodyParams["enable_acl"] = d.Get("enable_acl")
+	}
 	if d.HasChange("name") {
 		bodyParams["name"] = utils.ValueIngoreEmpty(d.Get("name"))
 	}
@@ -0,0 +1,235 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDmsRocketMQTopic is the impl for huaweicloud_dms_rocketmq_topic resource.
+// The resource ID is in the format of <instance_id>/<name>.
+func ResourceDmsRocketMQTopic() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsRocketMQTopicCreate,
+		ReadContext:   resourceDmsRocketMQTopicRead,
+		UpdateContext: resourceDmsRocketMQTopicUpdate,
+		DeleteContext: resourceDmsRocketMQTopicDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsInstanceSubResourceImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the RocketMQ instance.`,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(3, 64),
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9_%|-]*$`),
+						"only letters, digits, percent signs (%), vertical bars (|), hyphens (-) and "+
+							"underscores (_) are allowed"),
+				),
+				Description: `Specifies the name of the topic.`,
+			},
+			"queue_num": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 50),
+				Description:  `Specifies the number of queues created on each broker.`,
+			},
+			"brokers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the names of the brokers on which the topic is created.`,
+			},
+			"queues": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Specifies the number of queues created on the specified brokers.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"broker": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: `Specifies the name of the broker.`,
+						},
+						"queue_num": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							ForceNew:    true,
+							Description: `Specifies the number of queues created on the broker.`,
+						},
+					},
+				},
+			},
+			"permission": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"all", "sub", "pub"}, false),
+				Description:  `Specifies the permission of the topic.`,
+			},
+			"total_read_queue_num": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: `Specifies the total number of read queues.`,
+			},
+			"total_write_queue_num": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: `Specifies the total number of write queues.`,
+			},
+		},
+	}
+}
+
+func buildRocketMQTopicQueues(d *schema.ResourceData) []map[string]interface{} {
+	rawQueues := d.Get("queues").([]interface{})
+	if len(rawQueues) == 0 {
+		return nil
+	}
+
+	queues := make([]map[string]interface{}, 0, len(rawQueues))
+	for _, v := range rawQueues {
+		queue := v.(map[string]interface{})
+		queues = append(queues, map[string]interface{}{
+			"broker":    queue["broker"],
+			"queue_num": queue["queue_num"],
+		})
+	}
+	return queues
+}
+
+func resourceDmsRocketMQTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	params := map[string]interface{}{
+		"name":       name,
+		"queue_num":  utils.ValueIngoreEmpty(d.Get("queue_num")),
+		"queues":     buildRocketMQTopicQueues(d),
+		"permission": utils.ValueIngoreEmpty(d.Get("permission")),
+	}
+	if brokers := d.Get("brokers").([]interface{}); len(brokers) > 0 {
+		params["brokers"] = brokers
+	}
+	_, err = common.RequestApi(client, "POST", buildDmsInstancePath(client, instanceId, "topics"), nil, params)
+	if err != nil {
+		return diag.Errorf("error creating RocketMQ topic: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceId, name))
+
+	// The number of read and write queues can only be changed after the topic is created.
+	if d.Get("total_read_queue_num").(int) > 0 || d.Get("total_write_queue_num").(int) > 0 {
+		if err = updateRocketMQTopic(client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDmsRocketMQTopicRead(ctx, d, meta)
+}
+
+func resourceDmsRocketMQTopicRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dms", region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	getPath := buildDmsInstancePath(client, d.Get("instance_id").(string), "topics/"+d.Get("name").(string))
+	respBody, err := common.RequestApi(client, "GET", getPath, nil, nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving RocketMQ topic")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("brokers", utils.PathSearch("brokers[].broker_name", respBody, nil)),
+		d.Set("permission", utils.PathSearch("permission", respBody, nil)),
+		d.Set("total_read_queue_num", utils.PathSearch("total_read_queue_num", respBody, nil)),
+		d.Set("total_write_queue_num", utils.PathSearch("total_write_queue_num", respBody, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func updateRocketMQTopic(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	params := map[string]interface{}{
+		"permission":            utils.ValueIngoreEmpty(d.Get("permission")),
+		"total_read_queue_num":  utils.ValueIngoreEmpty(d.Get("total_read_queue_num")),
+		"total_write_queue_num": utils.ValueIngoreEmpty(d.Get("total_write_queue_num")),
+	}
+	updatePath := buildDmsInstancePath(client, d.Get("instance_id").(string), "topics/"+d.Get("name").(string))
+	if _, err := common.RequestApi(client, "PUT", updatePath, nil, params); err != nil {
+		return fmt.Errorf("error updating RocketMQ topic (%s): %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceDmsRocketMQTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	if d.HasChanges("permission", "total_read_queue_num", "total_write_queue_num") {
+		if err = updateRocketMQTopic(client, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceDmsRocketMQTopicRead(ctx, d, meta)
+}
+
+func resourceDmsRocketMQTopicDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	deletePath := buildDmsInstancePath(client, d.Get("instance_id").(string), "topics/"+d.Get("name").(string))
+	if _, err = common.RequestApi(client, "DELETE", deletePath, nil, nil); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting RocketMQ topic")
+	}
+
+	return nil
+}
@@ -0,0 +1,232 @@
+package dms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+var (
+	rocketMQTopicPerms = []string{"PUB|SUB", "PUB", "SUB", "DENY"}
+	rocketMQGroupPerms = []string{"SUB", "DENY"}
+)
+
+// ResourceDmsRocketMQUser is the impl for huaweicloud_dms_rocketmq_user resource, the user only takes effect when the
+// ACL of the RocketMQ instance is enabled.
+// The resource ID is in the format of <instance_id>/<access_key>.
+func ResourceDmsRocketMQUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDmsRocketMQUserCreate,
+		ReadContext:   resourceDmsRocketMQUserRead,
+		UpdateContext: resourceDmsRocketMQUserUpdate,
+		DeleteContext: resourceDmsRocketMQUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDmsInstanceUserImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the RocketMQ instance.`,
+			},
+			"access_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(7, 64),
+				Description:  `Specifies the name of the user.`,
+			},
+			"secret_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(8, 32),
+				Description:  `Specifies the secret key of the user.`,
+			},
+			"white_remote_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the IP address whitelist of the user.`,
+			},
+			"admin": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: `Specifies whether the user is an administrator.`,
+			},
+			"default_topic_perm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(rocketMQTopicPerms, false),
+				Description:  `Specifies the default permission on the topics.`,
+			},
+			"default_group_perm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(rocketMQGroupPerms, false),
+				Description:  `Specifies the default permission on the consumer groups.`,
+			},
+			"topic_perms": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        rocketMQUserPermSchema(rocketMQTopicPerms),
+				Description: `Specifies the special permissions on the topics.`,
+			},
+			"group_perms": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        rocketMQUserPermSchema(rocketMQGroupPerms),
+				Description: `Specifies the special permissions on the consumer groups.`,
+			},
+		},
+	}
+}
+
+func rocketMQUserPermSchema(perms []string) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the name of the topic or consumer group.`,
+			},
+			"perm": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(perms, false),
+				Description:  `Specifies the permission on the topic or consumer group.`,
+			},
+		},
+	}
+}
+
+// buildRocketMQUserPerms returns a generic slice so that an empty list is still sent to clear the permissions.
+func buildRocketMQUserPerms(rawPerms []interface{}) []interface{} {
+	perms := make([]interface{}, 0, len(rawPerms))
+	for _, v := range rawPerms {
+		perm := v.(map[string]interface{})
+		perms = append(perms, map[string]interface{}{
+			"name": perm["name"],
+			"perm": perm["perm"],
+		})
+	}
+	return perms
+}
+
+func buildRocketMQUserBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"access_key":           d.Get("access_key"),
+		"secret_key":           d.Get("secret_key"),
+		"white_remote_address": d.Get("white_remote_address"),
+		"admin":                d.Get("admin"),
+		"default_topic_perm":   utils.ValueIngoreEmpty(d.Get("default_topic_perm")),
+		"default_group_perm":   utils.ValueIngoreEmpty(d.Get("default_group_perm")),
+		"topic_perms":          buildRocketMQUserPerms(d.Get("topic_perms").(*schema.Set).List()),
+		"group_perms":          buildRocketMQUserPerms(d.Get("group_perms").(*schema.Set).List()),
+	}
+}
+
+func resourceDmsRocketMQUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	createPath := buildDmsInstancePath(client, instanceId, "users")
+	if _, err = common.RequestApi(client, "POST", createPath, nil, buildRocketMQUserBodyParams(d)); err != nil {
+		return diag.Errorf("error creating RocketMQ user: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceId, d.Get("access_key").(string)))
+
+	return resourceDmsRocketMQUserRead(ctx, d, meta)
+}
+
+func resourceDmsRocketMQUserRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dms", region)
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	getPath := buildDmsInstancePath(client, d.Get("instance_id").(string), "users/"+d.Get("access_key").(string))
+	respBody, err := common.RequestApi(client, "GET", getPath, nil, nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving RocketMQ user")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("white_remote_address", utils.PathSearch("white_remote_address", respBody, nil)),
+		d.Set("admin", utils.PathSearch("admin", respBody, nil)),
+		d.Set("default_topic_perm", utils.PathSearch("default_topic_perm", respBody, nil)),
+		d.Set("default_group_perm", utils.PathSearch("default_group_perm", respBody, nil)),
+		d.Set("topic_perms", flattenRocketMQUserPerms(utils.PathSearch("topic_perms", respBody, nil))),
+		d.Set("group_perms", flattenRocketMQUserPerms(utils.PathSearch("group_perms", respBody, nil))),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenRocketMQUserPerms(rawPerms interface{}) []interface{} {
+	permList, _ := rawPerms.([]interface{})
+	perms := make([]interface{}, 0, len(permList))
+	for _, perm := range permList {
+		perms = append(perms, map[string]interface{}{
+			"name": utils.PathSearch("name", perm, nil),
+			"perm": utils.PathSearch("perm", perm, nil),
+		})
+	}
+	return perms
+}
+
+func resourceDmsRocketMQUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	updatePath := buildDmsInstancePath(client, d.Get("instance_id").(string), "users/"+d.Get("access_key").(string))
+	if _, err = common.RequestApi(client, "PUT", updatePath, nil, buildRocketMQUserBodyParams(d)); err != nil {
+		return diag.Errorf("error updating RocketMQ user (%s): %s", d.Id(), err)
+	}
+
+	return resourceDmsRocketMQUserRead(ctx, d, meta)
+}
+
+func resourceDmsRocketMQUserDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dms", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DMS client: %s", err)
+	}
+
+	deletePath := buildDmsInstancePath(client, d.Get("instance_id").(string), "users/"+d.Get("access_key").(string))
+	if _, err = common.RequestApi(client, "DELETE", deletePath, nil, nil); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting RocketMQ user")
+	}
+
+	return nil
+}
@@ -0,0 +1,299 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceCustomLine() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCustomLineCreate,
+		ReadContext:   resourceCustomLineRead,
+		UpdateContext: resourceCustomLineUpdate,
+		DeleteContext: resourceCustomLineDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 80),
+				Description:  `Specifies the custom line name.`,
+			},
+			"ip_segments": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    50,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the IP address ranges, in the format of start IP-end IP.`,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+				Description:  `Specifies the description of the custom line.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The status of the custom line.`,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceCustomLineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createCustomLine: create a DNS custom line
+	var (
+		createCustomLineHttpUrl = "v2.1/customlines"
+		createCustomLineProduct = "dns"
+	)
+	createCustomLineClient, err := cfg.NewServiceClient(createCustomLineProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DNS Client: %s", err)
+	}
+
+	createCustomLinePath := createCustomLineClient.Endpoint + createCustomLineHttpUrl
+
+	createCustomLineOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			202,
+		},
+	}
+	createCustomLineOpt.JSONBody = utils.RemoveNil(buildCustomLineBodyParams(d))
+	createCustomLineResp, err := createCustomLineClient.Request("POST", createCustomLinePath, &createCustomLineOpt)
+	if err != nil {
+		return diag.Errorf("error creating DNS custom line: %s", err)
+	}
+
+	createCustomLineRespBody, err := utils.FlattenResponse(createCustomLineResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := utils.PathSearch("line_id", createCustomLineRespBody, "").(string)
+	if id == "" {
+		return diag.Errorf("error creating DNS custom line: ID is not found in API response")
+	}
+	d.SetId(id)
+
+	err = waitForCustomLineStatus(ctx, createCustomLineClient, d.Id(), []string{"ACTIVE"},
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error waiting for the creation of DNS custom line (%s) to complete: %s", d.Id(), err)
+	}
+	return resourceCustomLineRead(ctx, d, meta)
+}
+
+func buildCustomLineBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"name":        d.Get("name"),
+		"ip_segments": utils.ExpandToStringList(d.Get("ip_segments").([]interface{})),
+		"description": d.Get("description"),
+	}
+}
+
+// getCustomLine queries the custom line by its ID, an ErrDefault404 is returned if the line is not found.
+func getCustomLine(client *golangsdk.ServiceClient, lineID string) (interface{}, error) {
+	getCustomLinePath := client.Endpoint + "v2.1/customlines?line_id={line_id}"
+	getCustomLinePath = strings.ReplaceAll(getCustomLinePath, "{line_id}", lineID)
+
+	getCustomLineOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getCustomLineResp, err := client.Request("GET", getCustomLinePath, &getCustomLineOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	getCustomLineRespBody, err := utils.FlattenResponse(getCustomLineResp)
+	if err != nil {
+		return nil, err
+	}
+
+	line := utils.PathSearch(fmt.Sprintf("lines[?line_id=='%s']|[0]", lineID), getCustomLineRespBody, nil)
+	if line == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return line, nil
+}
+
+func resourceCustomLineRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	var mErr *multierror.Error
+
+	client, err := cfg.NewServiceClient("dns", region)
+	if err != nil {
+		return diag.Errorf("error creating DNS Client: %s", err)
+	}
+
+	line, err := getCustomLine(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DNS custom line")
+	}
+
+	mErr = multierror.Append(
+		mErr,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", line, nil)),
+		d.Set("ip_segments", utils.PathSearch("ip_segments", line, nil)),
+		d.Set("description", utils.PathSearch("description", line, nil)),
+		d.Set("status", utils.PathSearch("status", line, nil)),
+		d.Set("created_at", utils.PathSearch("created_at", line, nil)),
+		d.Set("updated_at", utils.PathSearch("updated_at", line, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceCustomLineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	if d.HasChanges("name", "ip_segments", "description") {
+		// updateCustomLine: update the DNS custom line
+		var (
+			updateCustomLineHttpUrl = "v2.1/customlines/{line_id}"
+			updateCustomLineProduct = "dns"
+		)
+		updateCustomLineClient, err := cfg.NewServiceClient(updateCustomLineProduct, region)
+		if err != nil {
+			return diag.Errorf("error creating DNS Client: %s", err)
+		}
+
+		updateCustomLinePath := updateCustomLineClient.Endpoint + updateCustomLineHttpUrl
+		updateCustomLinePath = strings.ReplaceAll(updateCustomLinePath, "{line_id}", d.Id())
+
+		updateCustomLineOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes: []int{
+				200, 202,
+			},
+		}
+		updateCustomLineOpt.JSONBody = utils.RemoveNil(buildCustomLineBodyParams(d))
+		_, err = updateCustomLineClient.Request("PUT", updateCustomLinePath, &updateCustomLineOpt)
+		if err != nil {
+			return diag.Errorf("error updating DNS custom line: %s", err)
+		}
+
+		err = waitForCustomLineStatus(ctx, updateCustomLineClient, d.Id(), []string{"ACTIVE"},
+			d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("error waiting for the update of DNS custom line (%s) to complete: %s", d.Id(), err)
+		}
+	}
+	return resourceCustomLineRead(ctx, d, meta)
+}
+
+func resourceCustomLineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// deleteCustomLine: delete the DNS custom line
+	var (
+		deleteCustomLineHttpUrl = "v2.1/customlines/{line_id}"
+		deleteCustomLineProduct = "dns"
+	)
+	deleteCustomLineClient, err := cfg.NewServiceClient(deleteCustomLineProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating DNS Client: %s", err)
+	}
+
+	deleteCustomLinePath := deleteCustomLineClient.Endpoint + deleteCustomLineHttpUrl
+	deleteCustomLinePath = strings.ReplaceAll(deleteCustomLinePath, "{line_id}", d.Id())
+
+	deleteCustomLineOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 202,
+		},
+	}
+	_, err = deleteCustomLineClient.Request("DELETE", deleteCustomLinePath, &deleteCustomLineOpt)
+	if err != nil {
+		return diag.Errorf("error deleting DNS custom line: %s", err)
+	}
+
+	err = waitForCustomLineStatus(ctx, deleteCustomLineClient, d.Id(), []string{"DELETED"},
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("error waiting for the deletion of DNS custom line (%s) to complete: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func waitForCustomLineStatus(ctx context.Context, client *golangsdk.ServiceClient, lineID string, target []string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  target,
+		Refresh: func() (interface{}, string, error) {
+			line, err := getCustomLine(client, lineID)
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return "", "DELETED", nil
+				}
+				return nil, "ERROR", err
+			}
+
+			status := utils.PathSearch("status", line, "").(string)
+			if status == "ERROR" {
+				return line, status, fmt.Errorf("the custom line is in ERROR status")
+			}
+			// status maybe one of PENDING_CREATE, PENDING_UPDATE, PENDING_DELETE, ACTIVE and ERROR
+			if strings.HasPrefix(status, "PENDING") {
+				return line, "PENDING", nil
+			}
+			return line, status, nil
+		},
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
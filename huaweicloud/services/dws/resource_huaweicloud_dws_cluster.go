@@ -2,6 +2,7 @@ package dws
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/chnsz/golangsdk"
@@ -10,10 +11,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils/fmtp"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils/logp"
 )
 
 func ResourceDwsCluster() *schema.Resource {
@@ -22,6 +25,7 @@ func ResourceDwsCluster() *schema.Resource {
 		ReadContext:   resourceDwsClusterRead,
 		DeleteContext: resourceDwsClusterDelete,
 		UpdateContext: resourceDwsClusterUpdate,
+		CustomizeDiff: validateDwsClusterNodeNumber,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -54,7 +58,6 @@ func ResourceDwsCluster() *schema.Resource {
 			"node_type": {
 				Type:     schema.TypeString,
 				Required: true,
-				ForceNew: true,
 			},
 
 			"number_of_node": {
@@ -139,6 +142,55 @@ func ResourceDwsCluster() *schema.Resource {
 
 			"tags": common.TagsForceNewSchema(),
 
+			"restore": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"snapshot_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+
+			"parameters": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"cn", "dn"}, false),
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"restart_for_parameters": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"restart_required": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
 			"created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -227,6 +279,10 @@ func resourceDwsClusterCreate(ctx context.Context, d *schema.ResourceData, meta
 		return fmtp.DiagErrorf("error creating DWS v1 client, err=%s", err)
 	}
 
+	if _, ok := d.GetOk("restore"); ok {
+		return resourceDwsClusterRestore(ctx, d, meta)
+	}
+
 	opts := &cluster.CreateOpts{
 		Name:                d.Get("name").(string),
 		AvailabilityZone:    d.Get("availability_zone").(string),
@@ -267,6 +323,70 @@ func resourceDwsClusterCreate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(checkCreateErr)
 	}
 
+	if err = createDwsClusterParameters(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceDwsClusterRead(ctx, d, meta)
+}
+
+// resourceDwsClusterRestore restores the snapshot to a new cluster, the network and the access settings of the new
+// cluster are taken from the configuration, and the others are inherited from the snapshot.
+func resourceDwsClusterRestore(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*config.Config)
+	region := config.GetRegion(d)
+	client, err := config.DwsV1Client(region)
+	if err != nil {
+		return fmtp.DiagErrorf("error creating DWS v1 client, err=%s", err)
+	}
+	restoreClient, err := config.NewServiceClient("dws", region)
+	if err != nil {
+		return diag.Errorf("error creating DWS client: %s", err)
+	}
+
+	publicIP, err := expandDwsClusterPublicIP(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	restoreOpts := map[string]interface{}{
+		"name":                  d.Get("name"),
+		"subnet_id":             d.Get("network_id"),
+		"security_group_id":     d.Get("security_group_id"),
+		"vpc_id":                d.Get("vpc_id"),
+		"availability_zone":     utils.ValueIngoreEmpty(d.Get("availability_zone")),
+		"port":                  utils.ValueIngoreEmpty(d.Get("port")),
+		"enterprise_project_id": utils.ValueIngoreEmpty(config.GetEnterpriseProjectID(d)),
+	}
+	if publicIP.PublicBindType != "" {
+		restoreOpts["public_ip"] = map[string]interface{}{
+			"public_bind_type": publicIP.PublicBindType,
+			"eip_id":           utils.ValueIngoreEmpty(publicIP.EipID),
+		}
+	}
+	params := map[string]interface{}{
+		"restore": restoreOpts,
+	}
+	snapshotId := d.Get("restore.0.snapshot_id").(string)
+	respBody, err := common.RequestApi(restoreClient, "POST",
+		fmt.Sprintf("v1.0/{project_id}/snapshots/%s/actions", snapshotId), nil, params)
+	if err != nil {
+		return diag.Errorf("error restoring DWS snapshot (%s) to a new cluster: %s", snapshotId, err)
+	}
+
+	clusterId := utils.PathSearch("cluster.id", respBody, "").(string)
+	if clusterId == "" {
+		return diag.Errorf("unable to find the cluster ID from the API response")
+	}
+	d.SetId(clusterId)
+
+	if err = checkClusterCreateResult(ctx, client, clusterId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err = createDwsClusterParameters(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceDwsClusterRead(ctx, d, meta)
 }
 
@@ -311,6 +431,10 @@ func resourceDwsClusterRead(ctx context.Context, d *schema.ResourceData, meta in
 		return fmtp.DiagErrorf("Error setting vault fields: %s", setSdErr)
 	}
 
+	if err = setDwsClusterParameters(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return nil
 }
 
@@ -367,6 +491,9 @@ func resourceDwsClusterUpdate(ctx context.Context, d *schema.ResourceData, meta
 	if d.HasChange("number_of_node") {
 		oldValue, newValue := d.GetChange("number_of_node")
 		num := newValue.(int) - oldValue.(int)
+		if num < 0 {
+			return fmtp.DiagErrorf("the number of nodes can only be increased. cluster_id=%s", clusterId)
+		}
 		_, extendErr := cluster.Resize(client, clusterId, num)
 		if extendErr != nil {
 			return fmtp.DiagErrorf("Extend DWS cluster failed.cluster_id=%s,error=%s", clusterId, extendErr)
@@ -377,6 +504,13 @@ func resourceDwsClusterUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	// classic resize, the data is redistributed to the nodes of the new type
+	if d.HasChange("node_type") {
+		if err = resizeDwsClusterFlavor(ctx, client, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	// change pwd
 	if d.HasChange("user_pwd") {
 		newValue := d.Get("user_pwd")
@@ -397,6 +531,12 @@ func resourceDwsClusterUpdate(ctx context.Context, d *schema.ResourceData, meta
 
 	}
 
+	if d.HasChanges("parameters", "restart_for_parameters") {
+		if err = updateDwsClusterParameters(ctx, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceDwsClusterRead(ctx, d, meta)
 }
 
@@ -458,7 +598,7 @@ func expandDwsClusterPublicIP(d *schema.ResourceData) (*cluster.PublicIpOpts, er
 func checkClusterCreateResult(ctx context.Context, client *golangsdk.ServiceClient, clusterId string,
 	timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
-		Pending: []string{"CREATING", "Pending"},
+		Pending: []string{"CREATING", "RESTORING", "Pending"},
 		Target:  []string{"AVAILABLE"},
 		Refresh: func() (interface{}, string, error) {
 			resp, err := cluster.Get(client, clusterId)
@@ -571,3 +711,201 @@ func checkAndWaitClusterStateAvailable(ctx context.Context, client *golangsdk.Se
 	}
 	return nil
 }
+
+// validateDwsClusterNodeNumber rejects the decrease of the node number at plan time, the cluster can only be expanded.
+func validateDwsClusterNodeNumber(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	oldNum, newNum := d.GetChange("number_of_node")
+	if newNum.(int) < oldNum.(int) {
+		return fmtp.Errorf("the number of nodes can not be decreased from %d to %d", oldNum, newNum)
+	}
+	return nil
+}
+
+func resizeDwsClusterFlavor(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData,
+	meta interface{}) error {
+	config := meta.(*config.Config)
+	resizeClient, err := config.NewServiceClient("dws", config.GetRegion(d))
+	if err != nil {
+		return fmtp.Errorf("error creating DWS client: %s", err)
+	}
+
+	oldType, newType := d.GetChange("node_type")
+	params := map[string]interface{}{
+		"source_flavor_id": oldType,
+		"target_flavor_id": newType,
+	}
+	_, err = common.RequestApi(resizeClient, "POST", fmt.Sprintf("v1.0/{project_id}/clusters/%s/resize-flavor", d.Id()),
+		nil, params)
+	if err != nil {
+		return fmtp.Errorf("error changing the node type of DWS cluster (%s): %s", d.Id(), err)
+	}
+
+	return checkAndWaitClusterStateAvailable(ctx, client, d.Id(), true, d.Timeout(schema.TimeoutUpdate))
+}
+
+// getDwsClusterParameterGroup queries the parameter group bound to the cluster, each cluster has only one group.
+// The status of the group is In-Sync, Applying, Pending-Reboot or Sync-Failure.
+func getDwsClusterParameterGroup(client *golangsdk.ServiceClient, clusterId string) (interface{}, error) {
+	respBody, err := common.RequestApi(client, "GET", fmt.Sprintf("v1.0/{project_id}/clusters/%s/configurations",
+		clusterId), nil, nil)
+	if err != nil {
+		return nil, fmtp.Errorf("error retrieving parameter group of DWS cluster (%s): %s", clusterId, err)
+	}
+
+	group := utils.PathSearch("configurations[0]", respBody, nil)
+	if group == nil {
+		return nil, fmtp.Errorf("unable to find the parameter group of DWS cluster (%s)", clusterId)
+	}
+	return group, nil
+}
+
+func buildDwsParameterGroupPath(clusterId string, group interface{}) string {
+	return fmt.Sprintf("v1.0/{project_id}/clusters/%s/configurations/%s", clusterId,
+		utils.PathSearch("id", group, ""))
+}
+
+func waitForDwsParameterGroupApplied(ctx context.Context, client *golangsdk.ServiceClient, clusterId string,
+	timeout time.Duration) (string, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"Applying"},
+		Target:  []string{"In-Sync", "Pending-Reboot"},
+		Refresh: func() (interface{}, string, error) {
+			group, err := getDwsClusterParameterGroup(client, clusterId)
+			if err != nil {
+				return nil, "failed", err
+			}
+
+			status := utils.PathSearch("status", group, "").(string)
+			if status == "Sync-Failure" {
+				return nil, "failed", fmtp.Errorf("failed to apply the parameters: %v",
+					utils.PathSearch("fail_reason", group, nil))
+			}
+			return group, status, nil
+		},
+		Timeout:      timeout,
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+
+	group, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return "", fmtp.Errorf("error waiting for the parameters of DWS cluster (%s) to be applied: %s", clusterId, err)
+	}
+	return utils.PathSearch("status", group, "").(string), nil
+}
+
+func createDwsClusterParameters(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	if d.Get("parameters").(*schema.Set).Len() == 0 {
+		return nil
+	}
+	return updateDwsClusterParameters(ctx, d, meta)
+}
+
+func buildDwsClusterParametersBodyParams(d *schema.ResourceData) map[string]interface{} {
+	oldRaw, newRaw := d.GetChange("parameters")
+	changes := newRaw.(*schema.Set).Difference(oldRaw.(*schema.Set)).List()
+	if len(changes) == 0 {
+		return nil
+	}
+
+	configurations := make([]map[string]interface{}, len(changes))
+	for i, v := range changes {
+		param := v.(map[string]interface{})
+		configurations[i] = map[string]interface{}{
+			"name":  param["name"],
+			"type":  param["type"],
+			"value": param["value"],
+		}
+	}
+	return map[string]interface{}{
+		"configurations": configurations,
+	}
+}
+
+// updateDwsClusterParameters modifies the CN and DN parameters in the parameter group of the cluster. When some of the
+// parameters are static, the group becomes Pending-Reboot and the cluster is rebooted if restart_for_parameters is set.
+func updateDwsClusterParameters(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*config.Config)
+	region := config.GetRegion(d)
+	client, err := config.NewServiceClient("dws", region)
+	if err != nil {
+		return fmtp.Errorf("error creating DWS client: %s", err)
+	}
+
+	group, err := getDwsClusterParameterGroup(client, d.Id())
+	if err != nil {
+		return err
+	}
+	if params := buildDwsClusterParametersBodyParams(d); params != nil {
+		logp.Printf("[DEBUG] Update parameters of DWS cluster (%s): %#v", d.Id(), params)
+		_, err = common.RequestApi(client, "PUT", buildDwsParameterGroupPath(d.Id(), group), nil, params)
+		if err != nil {
+			return fmtp.Errorf("error updating parameters of DWS cluster (%s): %s", d.Id(), err)
+		}
+	}
+
+	status, err := waitForDwsParameterGroupApplied(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+	if status != "Pending-Reboot" || !d.Get("restart_for_parameters").(bool) {
+		return nil
+	}
+
+	_, err = common.RequestApi(client, "POST", fmt.Sprintf("v1.0/{project_id}/clusters/%s/restart", d.Id()),
+		nil, map[string]interface{}{"restart": map[string]interface{}{}})
+	if err != nil {
+		return fmtp.Errorf("error rebooting DWS cluster (%s): %s", d.Id(), err)
+	}
+	v1Client, err := config.DwsV1Client(region)
+	if err != nil {
+		return fmtp.Errorf("error creating DWS v1 client, err=%s", err)
+	}
+	return checkAndWaitClusterStateAvailable(ctx, v1Client, d.Id(), false, d.Timeout(schema.TimeoutUpdate))
+}
+
+func setDwsClusterParameters(d *schema.ResourceData, meta interface{}) error {
+	rawParams := d.Get("parameters").(*schema.Set).List()
+	if len(rawParams) == 0 {
+		return nil
+	}
+
+	config := meta.(*config.Config)
+	client, err := config.NewServiceClient("dws", config.GetRegion(d))
+	if err != nil {
+		return fmtp.Errorf("error creating DWS client: %s", err)
+	}
+	group, err := getDwsClusterParameterGroup(client, d.Id())
+	if err != nil {
+		return err
+	}
+	respBody, err := common.RequestApi(client, "GET", buildDwsParameterGroupPath(d.Id(), group), nil, nil)
+	if err != nil {
+		return fmtp.Errorf("error retrieving parameters of DWS cluster (%s): %s", d.Id(), err)
+	}
+
+	// The group returns hundreds of parameters, each with a CN value and a DN value, look up the configured ones.
+	params := make([]map[string]interface{}, 0, len(rawParams))
+	for _, v := range rawParams {
+		param := v.(map[string]interface{})
+		expression := fmt.Sprintf("configurations[?name=='%s']|[0].values[?type=='%s']|[0].value",
+			param["name"], param["type"])
+		if value := utils.PathSearch(expression, respBody, nil); value != nil {
+			params = append(params, map[string]interface{}{
+				"name":  param["name"],
+				"type":  param["type"],
+				"value": value,
+			})
+		}
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("parameters", params),
+		d.Set("restart_required", utils.PathSearch("status", group, "") == "Pending-Reboot"),
+	)
+	return mErr.ErrorOrNil()
+}
@@ -0,0 +1,209 @@
+package dws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDwsEventSubscription is the impl for huaweicloud_dws_event_subscription resource, which sends the DWS
+// events to the SMN topic.
+func ResourceDwsEventSubscription() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDwsEventSubscriptionCreate,
+		ReadContext:   resourceDwsEventSubscriptionRead,
+		UpdateContext: resourceDwsEventSubscriptionUpdate,
+		DeleteContext: resourceDwsEventSubscriptionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+				Description:  `Specifies the name of the event subscription.`,
+			},
+			"notification_target": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the URN of the SMN topic which receives the events.`,
+			},
+			"notification_target_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the name of the SMN topic which receives the events.`,
+			},
+			"notification_target_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "SMN",
+				Description: `Specifies the type of the notification target.`,
+			},
+			"enable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: `Specifies whether the event subscription is enabled.`,
+			},
+			"source_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"cluster", "backup", "disaster-recovery",
+				}, false),
+				Description: `Specifies the source type of the events.`,
+			},
+			"source_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `Specifies the ID of the event source.`,
+			},
+			"category": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `Specifies the categories of the events, separated by commas.`,
+			},
+			"severity": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `Specifies the severities of the events, separated by commas.`,
+			},
+			"time_zone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `Specifies the time zone of the events in the notifications.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the status of the SMN topic.`,
+			},
+		},
+	}
+}
+
+func buildDwsEventSubscriptionParams(d *schema.ResourceData) map[string]interface{} {
+	enable := "0"
+	if d.Get("enable").(bool) {
+		enable = "1"
+	}
+	return map[string]interface{}{
+		"name":                     d.Get("name"),
+		"notification_target":      d.Get("notification_target"),
+		"notification_target_name": d.Get("notification_target_name"),
+		"notification_target_type": d.Get("notification_target_type"),
+		"enable":                   enable,
+		"source_type":              utils.ValueIngoreEmpty(d.Get("source_type")),
+		"source_id":                utils.ValueIngoreEmpty(d.Get("source_id")),
+		"category":                 utils.ValueIngoreEmpty(d.Get("category")),
+		"severity":                 utils.ValueIngoreEmpty(d.Get("severity")),
+		"time_zone":                utils.ValueIngoreEmpty(d.Get("time_zone")),
+	}
+}
+
+func resourceDwsEventSubscriptionCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dws", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DWS client: %s", err)
+	}
+
+	respBody, err := common.RequestApi(client, "POST", "v2/{project_id}/event-subs", nil,
+		buildDwsEventSubscriptionParams(d))
+	if err != nil {
+		return diag.Errorf("error creating DWS event subscription: %s", err)
+	}
+
+	subscriptionId := utils.PathSearch("id", respBody, "").(string)
+	if subscriptionId == "" {
+		return diag.Errorf("unable to find the event subscription ID from the API response")
+	}
+	d.SetId(subscriptionId)
+
+	return resourceDwsEventSubscriptionRead(ctx, d, meta)
+}
+
+func resourceDwsEventSubscriptionRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dws", region)
+	if err != nil {
+		return diag.Errorf("error creating DWS client: %s", err)
+	}
+
+	respBody, err := common.RequestApi(client, "GET", fmt.Sprintf("v2/{project_id}/event-subs/%s", d.Id()), nil, nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DWS event subscription")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", respBody, nil)),
+		d.Set("notification_target", utils.PathSearch("notification_target", respBody, nil)),
+		d.Set("notification_target_name", utils.PathSearch("notification_target_name", respBody, nil)),
+		d.Set("notification_target_type", utils.PathSearch("notification_target_type", respBody, nil)),
+		d.Set("enable", fmt.Sprint(utils.PathSearch("enable", respBody, "")) == "1"),
+		d.Set("source_type", utils.PathSearch("source_type", respBody, nil)),
+		d.Set("source_id", utils.PathSearch("source_id", respBody, nil)),
+		d.Set("category", utils.PathSearch("category", respBody, nil)),
+		d.Set("severity", utils.PathSearch("severity", respBody, nil)),
+		d.Set("time_zone", utils.PathSearch("time_zone", respBody, nil)),
+		d.Set("status", utils.PathSearch("status", respBody, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDwsEventSubscriptionUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dws", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DWS client: %s", err)
+	}
+
+	updatePath := fmt.Sprintf("v2/{project_id}/event-subs/%s", d.Id())
+	if _, err = common.RequestApi(client, "PUT", updatePath, nil, buildDwsEventSubscriptionParams(d)); err != nil {
+		return diag.Errorf("error updating DWS event subscription (%s): %s", d.Id(), err)
+	}
+
+	return resourceDwsEventSubscriptionRead(ctx, d, meta)
+}
+
+func resourceDwsEventSubscriptionDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dws", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DWS client: %s", err)
+	}
+
+	_, err = common.RequestApi(client, "DELETE", fmt.Sprintf("v2/{project_id}/event-subs/%s", d.Id()), nil, nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DWS event subscription")
+	}
+
+	return nil
+}
@@ -0,0 +1,297 @@
+package dws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDwsLogicalCluster is the impl for huaweicloud_dws_logical_cluster resource, which divides the rings of the
+// cluster into a logical cluster.
+// The resource ID is in the format of <cluster_id>/<logical_cluster_id>.
+func ResourceDwsLogicalCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDwsLogicalClusterCreate,
+		ReadContext:   resourceDwsLogicalClusterRead,
+		DeleteContext: resourceDwsLogicalClusterDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDwsClusterSubResourceImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the cluster.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the logical cluster.`,
+			},
+			"cluster_rings": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the rings which make up the logical cluster.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ring_hosts": {
+							Type:        schema.TypeList,
+							Required:    true,
+							ForceNew:    true,
+							Description: `Specifies the hosts in the ring.`,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host_name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										ForceNew:    true,
+										Description: `Specifies the name of the host.`,
+									},
+									"back_ip": {
+										Type:        schema.TypeString,
+										Required:    true,
+										ForceNew:    true,
+										Description: `Specifies the backend IP address of the host.`,
+									},
+									"cpu_cores": {
+										Type:        schema.TypeInt,
+										Required:    true,
+										ForceNew:    true,
+										Description: `Specifies the number of the CPU cores of the host.`,
+									},
+									"memory": {
+										Type:        schema.TypeFloat,
+										Required:    true,
+										ForceNew:    true,
+										Description: `Specifies the memory size of the host, in GB.`,
+									},
+									"disk_size": {
+										Type:        schema.TypeFloat,
+										Required:    true,
+										ForceNew:    true,
+										Description: `Specifies the disk size of the host, in GB.`,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the status of the logical cluster.`,
+			},
+			"first_logical_cluster": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: `Indicates whether the logical cluster is the first one of the cluster.`,
+			},
+		},
+	}
+}
+
+func buildDwsLogicalClustersPath(clusterId string) string {
+	return fmt.Sprintf("v2/{project_id}/clusters/%s/logical-clusters", clusterId)
+}
+
+func buildDwsLogicalClusterRings(d *schema.ResourceData) []map[string]interface{} {
+	rawRings := d.Get("cluster_rings").([]interface{})
+	rings := make([]map[string]interface{}, 0, len(rawRings))
+	for _, r := range rawRings {
+		rawHosts := r.(map[string]interface{})["ring_hosts"].([]interface{})
+		hosts := make([]map[string]interface{}, 0, len(rawHosts))
+		for _, h := range rawHosts {
+			host := h.(map[string]interface{})
+			hosts = append(hosts, map[string]interface{}{
+				"host_name": host["host_name"],
+				"back_ip":   host["back_ip"],
+				"cpu_cores": host["cpu_cores"],
+				"memory":    host["memory"],
+				"disk_size": host["disk_size"],
+			})
+		}
+		rings = append(rings, map[string]interface{}{
+			"ring_hosts": hosts,
+		})
+	}
+	return rings
+}
+
+func resourceDwsLogicalClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dws", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DWS client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	name := d.Get("name").(string)
+	params := map[string]interface{}{
+		"logical_cluster_name": name,
+		"cluster_rings":        buildDwsLogicalClusterRings(d),
+	}
+	if _, err = common.RequestApi(client, "POST", buildDwsLogicalClustersPath(clusterId), nil, params); err != nil {
+		return diag.Errorf("error creating DWS logical cluster: %s", err)
+	}
+
+	// the creation API does not return the ID, so the logical cluster is queried by the name.
+	logicalCluster, err := getDwsLogicalCluster(client, clusterId, fmt.Sprintf("logical_cluster_name=='%s'", name))
+	if err != nil {
+		return diag.Errorf("error retrieving DWS logical cluster (%s): %s", name, err)
+	}
+	logicalClusterId := utils.PathSearch("logical_cluster_id", logicalCluster, "").(string)
+	d.SetId(fmt.Sprintf("%s/%s", clusterId, logicalClusterId))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"Creating", "PENDING"},
+		Target:       []string{"Normal"},
+		Refresh:      dwsLogicalClusterStatusRefreshFunc(client, clusterId, logicalClusterId),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        30 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for DWS logical cluster (%s) to become normal: %s", name, err)
+	}
+
+	return resourceDwsLogicalClusterRead(ctx, d, meta)
+}
+
+func getDwsLogicalCluster(client *golangsdk.ServiceClient, clusterId, filter string) (interface{}, error) {
+	respBody, err := common.RequestApi(client, "GET", buildDwsLogicalClustersPath(clusterId), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	logicalCluster := utils.PathSearch(fmt.Sprintf("logical_clusters[?%s]|[0]", filter), respBody, nil)
+	if logicalCluster == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return logicalCluster, nil
+}
+
+func dwsLogicalClusterStatusRefreshFunc(client *golangsdk.ServiceClient, clusterId,
+	logicalClusterId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		filter := fmt.Sprintf("logical_cluster_id=='%s'", logicalClusterId)
+		logicalCluster, err := getDwsLogicalCluster(client, clusterId, filter)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		status := utils.PathSearch("status", logicalCluster, "").(string)
+		if strings.Contains(status, "Failed") {
+			return logicalCluster, status, fmt.Errorf("the logical cluster (%s) is in %s status",
+				logicalClusterId, status)
+		}
+		return logicalCluster, status, nil
+	}
+}
+
+func flattenDwsLogicalClusterRings(logicalCluster interface{}) []map[string]interface{} {
+	rawRings := utils.PathSearch("cluster_rings", logicalCluster, make([]interface{}, 0)).([]interface{})
+	rings := make([]map[string]interface{}, 0, len(rawRings))
+	for _, r := range rawRings {
+		rawHosts := utils.PathSearch("ring_hosts", r, make([]interface{}, 0)).([]interface{})
+		hosts := make([]map[string]interface{}, 0, len(rawHosts))
+		for _, h := range rawHosts {
+			hosts = append(hosts, map[string]interface{}{
+				"host_name": utils.PathSearch("host_name", h, nil),
+				"back_ip":   utils.PathSearch("back_ip", h, nil),
+				"cpu_cores": utils.PathSearch("cpu_cores", h, nil),
+				"memory":    utils.PathSearch("memory", h, nil),
+				"disk_size": utils.PathSearch("disk_size", h, nil),
+			})
+		}
+		rings = append(rings, map[string]interface{}{
+			"ring_hosts": hosts,
+		})
+	}
+	return rings
+}
+
+func resourceDwsLogicalClusterRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dws", region)
+	if err != nil {
+		return diag.Errorf("error creating DWS client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	logicalClusterId := strings.TrimPrefix(d.Id(), clusterId+"/")
+	logicalCluster, err := getDwsLogicalCluster(client, clusterId,
+		fmt.Sprintf("logical_cluster_id=='%s'", logicalClusterId))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DWS logical cluster")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("logical_cluster_name", logicalCluster, nil)),
+		d.Set("cluster_rings", flattenDwsLogicalClusterRings(logicalCluster)),
+		d.Set("status", utils.PathSearch("status", logicalCluster, nil)),
+		d.Set("first_logical_cluster", utils.PathSearch("first_logical_cluster", logicalCluster, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDwsLogicalClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dws", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DWS client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	logicalClusterId := strings.TrimPrefix(d.Id(), clusterId+"/")
+	deletePath := buildDwsLogicalClustersPath(clusterId) + "/" + logicalClusterId
+	if _, err = common.RequestApi(client, "DELETE", deletePath, nil, nil); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DWS logical cluster")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"Normal", "Deleting"},
+		Target:       []string{"DELETED"},
+		Refresh:      dwsLogicalClusterStatusRefreshFunc(client, clusterId, logicalClusterId),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        30 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for DWS logical cluster (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
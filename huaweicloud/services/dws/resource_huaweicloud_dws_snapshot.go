@@ -0,0 +1,216 @@
+package dws
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDwsSnapshot is the impl for huaweicloud_dws_snapshot resource, which creates a manual snapshot of the
+// cluster.
+func ResourceDwsSnapshot() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDwsSnapshotCreate,
+		ReadContext:   resourceDwsSnapshotRead,
+		DeleteContext: resourceDwsSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the cluster.`,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(4, 64),
+				Description:  `Specifies the name of the snapshot.`,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+				Description:  `Specifies the description of the snapshot.`,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the type of the snapshot.`,
+			},
+			"size": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: `Indicates the size of the snapshot, in GB.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the status of the snapshot.`,
+			},
+			"started_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the time when the snapshot starts to be created.`,
+			},
+			"finished_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the time when the snapshot creation is complete.`,
+			},
+		},
+	}
+}
+
+func resourceDwsSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dws", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DWS client: %s", err)
+	}
+
+	params := map[string]interface{}{
+		"snapshot": map[string]interface{}{
+			"name":        d.Get("name"),
+			"cluster_id":  d.Get("cluster_id"),
+			"description": utils.ValueIngoreEmpty(d.Get("description")),
+		},
+	}
+	respBody, err := common.RequestApi(client, "POST", "v1.0/{project_id}/snapshots", nil, params)
+	if err != nil {
+		return diag.Errorf("error creating DWS snapshot: %s", err)
+	}
+
+	snapshotId := utils.PathSearch("snapshot.id", respBody, "").(string)
+	if snapshotId == "" {
+		return diag.Errorf("unable to find the snapshot ID from the API response")
+	}
+	d.SetId(snapshotId)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"CREATING"},
+		Target:       []string{"AVAILABLE"},
+		Refresh:      dwsSnapshotStatusRefreshFunc(client, snapshotId),
+		Timeout:      d.Timeout(schema.TimeoutCreate),
+		Delay:        30 * time.Second,
+		PollInterval: 15 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for DWS snapshot (%s) to become available: %s", snapshotId, err)
+	}
+
+	return resourceDwsSnapshotRead(ctx, d, meta)
+}
+
+func getDwsSnapshot(client *golangsdk.ServiceClient, snapshotId string) (interface{}, error) {
+	respBody, err := common.RequestApi(client, "GET", fmt.Sprintf("v1.0/{project_id}/snapshots/%s", snapshotId), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := utils.PathSearch("snapshot", respBody, nil)
+	if snapshot == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return snapshot, nil
+}
+
+func dwsSnapshotStatusRefreshFunc(client *golangsdk.ServiceClient, snapshotId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, err := getDwsSnapshot(client, snapshotId)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		status := utils.PathSearch("status", snapshot, "").(string)
+		if status == "UNAVAILABLE" {
+			return snapshot, status, fmt.Errorf("the snapshot (%s) is unavailable", snapshotId)
+		}
+		return snapshot, status, nil
+	}
+}
+
+func resourceDwsSnapshotRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dws", region)
+	if err != nil {
+		return diag.Errorf("error creating DWS client: %s", err)
+	}
+
+	snapshot, err := getDwsSnapshot(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DWS snapshot")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("cluster_id", utils.PathSearch("cluster_id", snapshot, nil)),
+		d.Set("name", utils.PathSearch("name", snapshot, nil)),
+		d.Set("description", utils.PathSearch("description", snapshot, nil)),
+		d.Set("type", utils.PathSearch("type", snapshot, nil)),
+		d.Set("size", utils.PathSearch("size", snapshot, nil)),
+		d.Set("status", utils.PathSearch("status", snapshot, nil)),
+		d.Set("started_at", utils.PathSearch("started", snapshot, nil)),
+		d.Set("finished_at", utils.PathSearch("finished", snapshot, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDwsSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dws", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DWS client: %s", err)
+	}
+
+	_, err = common.RequestApi(client, "DELETE", fmt.Sprintf("v1.0/{project_id}/snapshots/%s", d.Id()), nil, nil)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DWS snapshot")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"AVAILABLE", "DELETING"},
+		Target:       []string{"DELETED"},
+		Refresh:      dwsSnapshotStatusRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for DWS snapshot (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package dws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDwsSnapshotPolicy is the impl for huaweicloud_dws_snapshot_policy resource, which manages an automated
+// snapshot policy of the cluster.
+// The resource ID is in the format of <cluster_id>/<policy_id>.
+func ResourceDwsSnapshotPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDwsSnapshotPolicyCreate,
+		ReadContext:   resourceDwsSnapshotPolicyRead,
+		DeleteContext: resourceDwsSnapshotPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDwsClusterSubResourceImportState,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the cluster.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the name of the snapshot policy.`,
+			},
+			"strategy": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the cron expression of the snapshot policy.`,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"full", "increment"}, false),
+				Description:  `Specifies the type of the snapshots created by the policy.`,
+			},
+			"keep_days": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: `Specifies the retention days of the automated snapshots of the cluster.`,
+			},
+		},
+	}
+}
+
+func buildDwsSnapshotPoliciesPath(clusterId string) string {
+	return fmt.Sprintf("v1.0/{project_id}/clusters/%s/snapshot-policies", clusterId)
+}
+
+func resourceDwsSnapshotPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dws", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DWS client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	// the policies of the cluster are saved by one API, so the operations on the same cluster are serialized.
+	config.MutexKV.Lock(clusterId)
+	defer config.MutexKV.Unlock(clusterId)
+
+	name := d.Get("name").(string)
+	params := map[string]interface{}{
+		"keep_day": utils.ValueIngoreEmpty(d.Get("keep_days")),
+		"backup_strategies": []map[string]interface{}{
+			{
+				"policy_name":     name,
+				"backup_strategy": d.Get("strategy"),
+				"backup_type":     d.Get("type"),
+			},
+		},
+	}
+	if _, err = common.RequestApi(client, "PUT", buildDwsSnapshotPoliciesPath(clusterId), nil, params); err != nil {
+		return diag.Errorf("error creating DWS snapshot policy: %s", err)
+	}
+
+	policy, err := getDwsSnapshotPolicy(client, clusterId, fmt.Sprintf("policy_name=='%s'", name))
+	if err != nil {
+		return diag.Errorf("error retrieving DWS snapshot policy (%s): %s", name, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", clusterId, utils.PathSearch("policy_id", policy, "")))
+
+	return resourceDwsSnapshotPolicyRead(ctx, d, meta)
+}
+
+// getDwsSnapshotPolicy returns the keep days of the cluster and the first policy matching the filter.
+func getDwsSnapshotPolicy(client *golangsdk.ServiceClient, clusterId, filter string) (interface{}, error) {
+	respBody, err := common.RequestApi(client, "GET", buildDwsSnapshotPoliciesPath(clusterId), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := utils.PathSearch(fmt.Sprintf("backup_strategies[?%s]|[0]", filter), respBody, nil)
+	if policy == nil {
+		return nil, golangsdk.ErrDefault404{}
+	}
+	return map[string]interface{}{
+		"keep_day":        utils.PathSearch("keep_day", respBody, nil),
+		"policy_id":       utils.PathSearch("policy_id", policy, nil),
+		"policy_name":     utils.PathSearch("policy_name", policy, nil),
+		"backup_strategy": utils.PathSearch("backup_strategy", policy, nil),
+		"backup_type":     utils.PathSearch("backup_type", policy, nil),
+	}, nil
+}
+
+func resourceDwsSnapshotPolicyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("dws", region)
+	if err != nil {
+		return diag.Errorf("error creating DWS client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	policyId := strings.TrimPrefix(d.Id(), clusterId+"/")
+	policy, err := getDwsSnapshotPolicy(client, clusterId, fmt.Sprintf("policy_id=='%s'", policyId))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving DWS snapshot policy")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("policy_name", policy, nil)),
+		d.Set("strategy", utils.PathSearch("backup_strategy", policy, nil)),
+		d.Set("type", utils.PathSearch("backup_type", policy, nil)),
+		d.Set("keep_days", utils.PathSearch("keep_day", policy, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDwsSnapshotPolicyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NewServiceClient("dws", cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating DWS client: %s", err)
+	}
+
+	clusterId := d.Get("cluster_id").(string)
+	config.MutexKV.Lock(clusterId)
+	defer config.MutexKV.Unlock(clusterId)
+
+	policyId := strings.TrimPrefix(d.Id(), clusterId+"/")
+	deletePath := buildDwsSnapshotPoliciesPath(clusterId) + "/" + policyId
+	if _, err = common.RequestApi(client, "DELETE", deletePath, nil, nil); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DWS snapshot policy")
+	}
+
+	return nil
+}
+
+// resourceDwsClusterSubResourceImportState imports the resources belonging to a cluster by <cluster_id>/<id>.
+func resourceDwsClusterSubResourceImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <cluster_id>/<id>")
+	}
+
+	return []*schema.ResourceData{d}, d.Set("cluster_id", parts[0])
+}
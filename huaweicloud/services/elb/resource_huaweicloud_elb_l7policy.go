@@ -6,15 +6,18 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/chnsz/golangsdk"
 	"github.com/chnsz/golangsdk/openstack/elb/v3/l7policies"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
 )
 
 func ResourceL7PolicyV3() *schema.Resource {
@@ -27,6 +30,8 @@ func ResourceL7PolicyV3() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: validateL7PolicyAction,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -57,9 +62,241 @@ func ResourceL7PolicyV3() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"action": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "REDIRECT_TO_POOL",
+				ValidateFunc: validation.StringInSlice([]string{
+					"REDIRECT_TO_POOL", "REDIRECT_TO_LISTENER", "REDIRECT_TO_URL", "FIXED_RESPONSE",
+				}, false),
+			},
+
+			"priority": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 10000),
+			},
+
 			"redirect_pool_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"redirect_pools_config", "redirect_listener_id", "redirect_url_config", "fixed_response_config"},
+			},
+
+			"redirect_pools_config": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pool_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+					},
+				},
+			},
+
+			"redirect_pools_extend_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rewrite_url_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"rewrite_url_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"path": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"query": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"insert_headers_config": l7PolicyInsertHeadersSchema(),
+						"remove_headers_config": l7PolicyRemoveHeadersSchema(),
+					},
+				},
+			},
+
+			"redirect_listener_id": {
 				Type:     schema.TypeString,
-				Required: true,
+				Optional: true,
+				Computed: true,
+			},
+
+			"redirect_url_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status_code": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"301", "302", "303", "307", "308",
+							}, false),
+						},
+						"protocol": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"HTTP", "HTTPS", "${protocol}",
+							}, false),
+						},
+						"host": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"port": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"query": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"insert_headers_config": l7PolicyInsertHeadersSchema(),
+						"remove_headers_config": l7PolicyRemoveHeadersSchema(),
+					},
+				},
+			},
+
+			"fixed_response_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"status_code": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"content_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"text/plain", "text/css", "text/html", "application/javascript", "application/json",
+							}, false),
+						},
+						"message_body": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"insert_headers_config": l7PolicyInsertHeadersSchema(),
+						"remove_headers_config": l7PolicyRemoveHeadersSchema(),
+					},
+				},
+			},
+
+			"provisioning_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func l7PolicyInsertHeadersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"configs": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"key": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"value_type": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"USER_DEFINED", "REFERENCE_HEADER", "SYSTEM_DEFINED",
+								}, false),
+							},
+							"value": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func l7PolicyRemoveHeadersSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"configs": {
+					Type:     schema.TypeSet,
+					Required: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"key": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -72,28 +309,40 @@ func resourceL7PolicyV3Create(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("error creating elb client: %s", err)
 	}
 
-	createOpts := l7policies.CreateOpts{
-		Name:           d.Get("name").(string),
-		Description:    d.Get("description").(string),
-		Action:         "REDIRECT_TO_POOL",
-		ListenerID:     d.Get("listener_id").(string),
-		RedirectPoolID: d.Get("redirect_pool_id").(string),
-	}
+	createOpts := buildL7PolicyBodyParams(d)
+	createOpts["listener_id"] = d.Get("listener_id")
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
-	l7Policy, err := l7policies.Create(lbClient, createOpts).Extract()
+	// the advanced forwarding parameters are not supported by the SDK, so the request is built by ourselves
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{201},
+		JSONBody: map[string]interface{}{
+			"l7policy": utils.RemoveNil(createOpts),
+		},
+	}
+	resp, err := lbClient.Request("POST", lbClient.ServiceURL("elb", "l7policies"), &createOpt)
 	if err != nil {
 		return diag.Errorf("error creating L7 Policy: %s", err)
 	}
 
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	policyID := utils.PathSearch("l7policy.id", respBody, "").(string)
+	if policyID == "" {
+		return diag.Errorf("error creating L7 Policy: ID is not found in API response")
+	}
+
 	timeout := d.Timeout(schema.TimeoutCreate)
 	// Wait for L7 Policy to become active before continuing
-	err = waitForElbV3Policy(ctx, lbClient, l7Policy.ID, "ACTIVE", nil, timeout)
+	err = waitForElbV3Policy(ctx, lbClient, policyID, "ACTIVE", nil, timeout)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(l7Policy.ID)
+	d.SetId(policyID)
 
 	return resourceL7PolicyV3Read(ctx, d, meta)
 }
@@ -105,18 +354,39 @@ func resourceL7PolicyV3Read(_ context.Context, d *schema.ResourceData, meta inte
 		return diag.Errorf("error creating elb client: %s", err)
 	}
 
-	l7Policy, err := l7policies.Get(lbClient, d.Id()).Extract()
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := lbClient.Request("GET", lbClient.ServiceURL("elb", "l7policies", d.Id()), &getOpt)
 	if err != nil {
 		return common.CheckDeletedDiag(d, err, "L7 Policy")
 	}
 
+	l7Policy, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[DEBUG] Retrieved L7 Policy %s: %#v", d.Id(), l7Policy)
 
 	mErr := multierror.Append(nil,
-		d.Set("description", l7Policy.Description),
-		d.Set("name", l7Policy.Name),
-		d.Set("listener_id", l7Policy.ListenerID),
-		d.Set("redirect_pool_id", l7Policy.RedirectPoolID),
+		d.Set("description", utils.PathSearch("l7policy.description", l7Policy, nil)),
+		d.Set("name", utils.PathSearch("l7policy.name", l7Policy, nil)),
+		d.Set("listener_id", utils.PathSearch("l7policy.listener_id", l7Policy, nil)),
+		d.Set("action", utils.PathSearch("l7policy.action", l7Policy, nil)),
+		d.Set("priority", utils.PathSearch("l7policy.priority", l7Policy, nil)),
+		d.Set("redirect_pool_id", utils.PathSearch("l7policy.redirect_pool_id", l7Policy, nil)),
+		d.Set("redirect_pools_config", flattenL7PolicyRedirectPools(
+			utils.PathSearch("l7policy.redirect_pools_config", l7Policy, nil))),
+		d.Set("redirect_pools_extend_config", flattenL7PolicyRedirectPoolsExtend(
+			utils.PathSearch("l7policy.redirect_pools_extend_config", l7Policy, nil))),
+		d.Set("redirect_listener_id", utils.PathSearch("l7policy.redirect_listener_id", l7Policy, nil)),
+		d.Set("redirect_url_config", flattenL7PolicyRedirectURL(
+			utils.PathSearch("l7policy.redirect_url_config", l7Policy, nil))),
+		d.Set("fixed_response_config", flattenL7PolicyFixedResponse(
+			utils.PathSearch("l7policy.fixed_response_config", l7Policy, nil))),
+		d.Set("provisioning_status", utils.PathSearch("l7policy.provisioning_status", l7Policy, nil)),
 		d.Set("region", config.GetRegion(d)),
 	)
 	if err := mErr.ErrorOrNil(); err != nil {
@@ -133,23 +403,17 @@ func resourceL7PolicyV3Update(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("error creating elb client: %s", err)
 	}
 
-	var updateOpts l7policies.UpdateOpts
-
-	if d.HasChange("name") {
-		name := d.Get("name").(string)
-		updateOpts.Name = &name
-	}
-	if d.HasChange("description") {
-		description := d.Get("description").(string)
-		updateOpts.Description = &description
-	}
-	if d.HasChange("redirect_pool_id") {
-		redirectPoolID := d.Get("redirect_pool_id").(string)
-		updateOpts.RedirectPoolID = &redirectPoolID
-	}
+	updateOpts := buildL7PolicyBodyParams(d)
 
 	log.Printf("[DEBUG] Updating L7 Policy %s with options: %#v", d.Id(), updateOpts)
-	_, err = l7policies.Update(lbClient, d.Id(), updateOpts).Extract()
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		JSONBody: map[string]interface{}{
+			"l7policy": utils.RemoveNil(updateOpts),
+		},
+	}
+	_, err = lbClient.Request("PUT", lbClient.ServiceURL("elb", "l7policies", d.Id()), &updateOpt)
 	if err != nil {
 		return diag.Errorf("unable to update L7 Policy %s: %s", d.Id(), err)
 	}
@@ -185,6 +449,300 @@ func resourceL7PolicyV3Delete(ctx context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
+// l7PolicyActionParams lists the forwarding parameters that can be specified for each action.
+var l7PolicyActionParams = map[string][]string{
+	"REDIRECT_TO_POOL":     {"redirect_pool_id", "redirect_pools_config", "redirect_pools_extend_config"},
+	"REDIRECT_TO_LISTENER": {"redirect_listener_id"},
+	"REDIRECT_TO_URL":      {"redirect_url_config"},
+	"FIXED_RESPONSE":       {"fixed_response_config"},
+}
+
+// isL7PolicyParamConfigured checks whether the parameter is specified in the raw configuration, the blocks that are
+// not specified are empty collections rather than null values.
+func isL7PolicyParamConfigured(rawConfig cty.Value, param string) bool {
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+	v := rawConfig.GetAttr(param)
+	if v.IsNull() {
+		return false
+	}
+	if v.IsKnown() && v.CanIterateElements() {
+		return v.LengthInt() > 0
+	}
+	return true
+}
+
+// validateL7PolicyAction checks whether the forwarding configuration matches the action, the action defaults to
+// REDIRECT_TO_POOL and the parameters of other actions are not sent.
+func validateL7PolicyAction(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	action := d.Get("action").(string)
+	rawConfig := d.GetRawConfig()
+	for act, params := range l7PolicyActionParams {
+		if act == action {
+			continue
+		}
+		for _, param := range params {
+			if isL7PolicyParamConfigured(rawConfig, param) {
+				return fmt.Errorf("'%s' can not be specified when the action is '%s'", param, action)
+			}
+		}
+	}
+	return nil
+}
+
+// buildL7PolicyBodyParams builds the request body of the policy, only the parameters related to the action are
+// sent because the API rejects the parameters of other actions.
+func buildL7PolicyBodyParams(d *schema.ResourceData) map[string]interface{} {
+	action := d.Get("action").(string)
+	bodyParams := map[string]interface{}{
+		"name":        d.Get("name"),
+		"description": d.Get("description"),
+		"action":      action,
+		"priority":    utils.ValueIngoreEmpty(d.Get("priority")),
+	}
+
+	switch action {
+	case "REDIRECT_TO_POOL":
+		// redirect_pools_config is computed and kept in the state after switching back to redirect_pool_id, so which
+		// one to send is decided by the configuration.
+		if isL7PolicyParamConfigured(d.GetRawConfig(), "redirect_pools_config") {
+			bodyParams["redirect_pools_config"] = buildL7PolicyRedirectPools(
+				d.Get("redirect_pools_config").(*schema.Set).List())
+		} else {
+			bodyParams["redirect_pool_id"] = d.Get("redirect_pool_id")
+		}
+		if v, ok := d.GetOk("redirect_pools_extend_config"); ok {
+			bodyParams["redirect_pools_extend_config"] = buildL7PolicyRedirectPoolsExtend(v.([]interface{}))
+		}
+	case "REDIRECT_TO_LISTENER":
+		bodyParams["redirect_listener_id"] = d.Get("redirect_listener_id")
+	case "REDIRECT_TO_URL":
+		bodyParams["redirect_url_config"] = buildL7PolicyRedirectURL(d.Get("redirect_url_config").([]interface{}))
+	case "FIXED_RESPONSE":
+		bodyParams["fixed_response_config"] = buildL7PolicyFixedResponse(d.Get("fixed_response_config").([]interface{}))
+	}
+
+	return bodyParams
+}
+
+func buildL7PolicyRedirectPools(rawPools []interface{}) []map[string]interface{} {
+	pools := make([]map[string]interface{}, len(rawPools))
+	for i, v := range rawPools {
+		raw := v.(map[string]interface{})
+		pools[i] = map[string]interface{}{
+			"pool_id": raw["pool_id"],
+			"weight":  raw["weight"],
+		}
+	}
+	return pools
+}
+
+func buildL7PolicyRedirectPoolsExtend(rawParams []interface{}) interface{} {
+	if len(rawParams) == 0 || rawParams[0] == nil {
+		return nil
+	}
+
+	raw := rawParams[0].(map[string]interface{})
+	params := map[string]interface{}{
+		"rewrite_url_enable":    raw["rewrite_url_enabled"],
+		"insert_headers_config": buildL7PolicyInsertHeaders(raw["insert_headers_config"].([]interface{})),
+		"remove_headers_config": buildL7PolicyRemoveHeaders(raw["remove_headers_config"].([]interface{})),
+	}
+	if rewrite := raw["rewrite_url_config"].([]interface{}); len(rewrite) > 0 && rewrite[0] != nil {
+		rewriteRaw := rewrite[0].(map[string]interface{})
+		params["rewrite_url_config"] = map[string]interface{}{
+			"host":  utils.ValueIngoreEmpty(rewriteRaw["host"]),
+			"path":  utils.ValueIngoreEmpty(rewriteRaw["path"]),
+			"query": utils.ValueIngoreEmpty(rewriteRaw["query"]),
+		}
+	}
+	return params
+}
+
+func buildL7PolicyRedirectURL(rawParams []interface{}) interface{} {
+	if len(rawParams) == 0 || rawParams[0] == nil {
+		return nil
+	}
+
+	raw := rawParams[0].(map[string]interface{})
+	return map[string]interface{}{
+		"status_code":           raw["status_code"],
+		"protocol":              utils.ValueIngoreEmpty(raw["protocol"]),
+		"host":                  utils.ValueIngoreEmpty(raw["host"]),
+		"port":                  utils.ValueIngoreEmpty(raw["port"]),
+		"path":                  utils.ValueIngoreEmpty(raw["path"]),
+		"query":                 utils.ValueIngoreEmpty(raw["query"]),
+		"insert_headers_config": buildL7PolicyInsertHeaders(raw["insert_headers_config"].([]interface{})),
+		"remove_headers_config": buildL7PolicyRemoveHeaders(raw["remove_headers_config"].([]interface{})),
+	}
+}
+
+func buildL7PolicyFixedResponse(rawParams []interface{}) interface{} {
+	if len(rawParams) == 0 || rawParams[0] == nil {
+		return nil
+	}
+
+	raw := rawParams[0].(map[string]interface{})
+	return map[string]interface{}{
+		"status_code":           raw["status_code"],
+		"content_type":          utils.ValueIngoreEmpty(raw["content_type"]),
+		"message_body":          raw["message_body"],
+		"insert_headers_config": buildL7PolicyInsertHeaders(raw["insert_headers_config"].([]interface{})),
+		"remove_headers_config": buildL7PolicyRemoveHeaders(raw["remove_headers_config"].([]interface{})),
+	}
+}
+
+func buildL7PolicyInsertHeaders(rawParams []interface{}) interface{} {
+	if len(rawParams) == 0 || rawParams[0] == nil {
+		return nil
+	}
+
+	rawConfigs := rawParams[0].(map[string]interface{})["configs"].(*schema.Set).List()
+	configs := make([]map[string]interface{}, len(rawConfigs))
+	for i, v := range rawConfigs {
+		raw := v.(map[string]interface{})
+		configs[i] = map[string]interface{}{
+			"key":        raw["key"],
+			"value_type": raw["value_type"],
+			"value":      raw["value"],
+		}
+	}
+	return map[string]interface{}{
+		"configs": configs,
+	}
+}
+
+func buildL7PolicyRemoveHeaders(rawParams []interface{}) interface{} {
+	if len(rawParams) == 0 || rawParams[0] == nil {
+		return nil
+	}
+
+	rawConfigs := rawParams[0].(map[string]interface{})["configs"].(*schema.Set).List()
+	configs := make([]map[string]interface{}, len(rawConfigs))
+	for i, v := range rawConfigs {
+		configs[i] = map[string]interface{}{
+			"key": v.(map[string]interface{})["key"],
+		}
+	}
+	return map[string]interface{}{
+		"configs": configs,
+	}
+}
+
+func flattenL7PolicyRedirectPools(resp interface{}) []interface{} {
+	curArray, ok := resp.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	rst := make([]interface{}, 0, len(curArray))
+	for _, v := range curArray {
+		rst = append(rst, map[string]interface{}{
+			"pool_id": utils.PathSearch("pool_id", v, nil),
+			"weight":  utils.PathSearch("weight", v, nil),
+		})
+	}
+	return rst
+}
+
+func flattenL7PolicyRedirectPoolsExtend(resp interface{}) []interface{} {
+	if resp == nil {
+		return nil
+	}
+
+	rst := map[string]interface{}{
+		"rewrite_url_enabled":   utils.PathSearch("rewrite_url_enable", resp, nil),
+		"insert_headers_config": flattenL7PolicyInsertHeaders(utils.PathSearch("insert_headers_config", resp, nil)),
+		"remove_headers_config": flattenL7PolicyRemoveHeaders(utils.PathSearch("remove_headers_config", resp, nil)),
+	}
+	if rewrite := utils.PathSearch("rewrite_url_config", resp, nil); rewrite != nil {
+		rst["rewrite_url_config"] = []interface{}{
+			map[string]interface{}{
+				"host":  utils.PathSearch("host", rewrite, nil),
+				"path":  utils.PathSearch("path", rewrite, nil),
+				"query": utils.PathSearch("query", rewrite, nil),
+			},
+		}
+	}
+	return []interface{}{rst}
+}
+
+func flattenL7PolicyRedirectURL(resp interface{}) []interface{} {
+	if resp == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"status_code":           utils.PathSearch("status_code", resp, nil),
+			"protocol":              utils.PathSearch("protocol", resp, nil),
+			"host":                  utils.PathSearch("host", resp, nil),
+			"port":                  utils.PathSearch("port", resp, nil),
+			"path":                  utils.PathSearch("path", resp, nil),
+			"query":                 utils.PathSearch("query", resp, nil),
+			"insert_headers_config": flattenL7PolicyInsertHeaders(utils.PathSearch("insert_headers_config", resp, nil)),
+			"remove_headers_config": flattenL7PolicyRemoveHeaders(utils.PathSearch("remove_headers_config", resp, nil)),
+		},
+	}
+}
+
+func flattenL7PolicyFixedResponse(resp interface{}) []interface{} {
+	if resp == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"status_code":           utils.PathSearch("status_code", resp, nil),
+			"content_type":          utils.PathSearch("content_type", resp, nil),
+			"message_body":          utils.PathSearch("message_body", resp, nil),
+			"insert_headers_config": flattenL7PolicyInsertHeaders(utils.PathSearch("insert_headers_config", resp, nil)),
+			"remove_headers_config": flattenL7PolicyRemoveHeaders(utils.PathSearch("remove_headers_config", resp, nil)),
+		},
+	}
+}
+
+func flattenL7PolicyInsertHeaders(resp interface{}) []interface{} {
+	curArray, ok := utils.PathSearch("configs", resp, nil).([]interface{})
+	if !ok || len(curArray) == 0 {
+		return nil
+	}
+
+	configs := make([]interface{}, 0, len(curArray))
+	for _, v := range curArray {
+		configs = append(configs, map[string]interface{}{
+			"key":        utils.PathSearch("key", v, nil),
+			"value_type": utils.PathSearch("value_type", v, nil),
+			"value":      utils.PathSearch("value", v, nil),
+		})
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"configs": configs,
+		},
+	}
+}
+
+func flattenL7PolicyRemoveHeaders(resp interface{}) []interface{} {
+	curArray, ok := utils.PathSearch("configs", resp, nil).([]interface{})
+	if !ok || len(curArray) == 0 {
+		return nil
+	}
+
+	configs := make([]interface{}, 0, len(curArray))
+	for _, v := range curArray {
+		configs = append(configs, map[string]interface{}{
+			"key": utils.PathSearch("key", v, nil),
+		})
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"configs": configs,
+		},
+	}
+}
+
 func waitForElbV3Policy(ctx context.Context, elbClient *golangsdk.ServiceClient,
 	id string, target string, pending []string, timeout time.Duration) error {
 
@@ -17,6 +17,7 @@ import (
 	"github.com/chnsz/golangsdk/openstack/elb/v3/l7policies"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
 	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
 )
 
 func ResourceL7RuleV3() *schema.Resource {
@@ -47,7 +48,7 @@ func ResourceL7RuleV3() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 				ValidateFunc: validation.StringInSlice([]string{
-					"HOST_NAME", "PATH",
+					"HOST_NAME", "PATH", "METHOD", "HEADER", "QUERY_STRING", "SOURCE_IP",
 				}, true),
 			},
 
@@ -66,8 +67,10 @@ func ResourceL7RuleV3() *schema.Resource {
 			},
 
 			"value": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"conditions"},
 				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
 					if len(v.(string)) == 0 {
 						errors = append(errors, fmt.Errorf("'value' field should not be empty"))
@@ -75,6 +78,25 @@ func ResourceL7RuleV3() *schema.Resource {
 					return
 				},
 			},
+
+			"conditions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -90,26 +112,44 @@ func resourceL7RuleV3Create(ctx context.Context, d *schema.ResourceData, meta in
 	ruleType := d.Get("type").(string)
 	compareType := d.Get("compare_type").(string)
 
-	createOpts := l7policies.CreateRuleOpts{
-		RuleType:    l7policies.RuleType(ruleType),
-		CompareType: l7policies.CompareType(compareType),
-		Value:       d.Get("value").(string),
+	createOpts := map[string]interface{}{
+		"type":         ruleType,
+		"compare_type": compareType,
+		"value":        utils.ValueIngoreEmpty(d.Get("value")),
+		"conditions":   buildL7RuleConditions(d.Get("conditions").(*schema.Set).List()),
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
-	l7Rule, err := l7policies.CreateRule(lbClient, l7policyID, createOpts).Extract()
+	// the conditions are not supported by the SDK, so the request is built by ourselves
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{201},
+		JSONBody: map[string]interface{}{
+			"rule": utils.RemoveNil(createOpts),
+		},
+	}
+	resp, err := lbClient.Request("POST", lbClient.ServiceURL("elb", "l7policies", l7policyID, "rules"), &createOpt)
 	if err != nil {
 		return diag.Errorf("error creating L7 Rule: %s", err)
 	}
 
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ruleID := utils.PathSearch("rule.id", respBody, "").(string)
+	if ruleID == "" {
+		return diag.Errorf("error creating L7 Rule: ID is not found in API response")
+	}
+
 	timeout := d.Timeout(schema.TimeoutCreate)
 	// Wait for L7 Rule to become active before continuing
-	err = waitForElbV3Rule(ctx, lbClient, l7policyID, l7Rule.ID, "ACTIVE", nil, timeout)
+	err = waitForElbV3Rule(ctx, lbClient, l7policyID, ruleID, "ACTIVE", nil, timeout)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(l7Rule.ID)
+	d.SetId(ruleID)
 
 	return resourceL7RuleV3Read(ctx, d, meta)
 }
@@ -123,18 +163,28 @@ func resourceL7RuleV3Read(_ context.Context, d *schema.ResourceData, meta interf
 
 	l7policyID := d.Get("l7policy_id").(string)
 
-	l7Rule, err := l7policies.GetRule(lbClient, l7policyID, d.Id()).Extract()
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := lbClient.Request("GET", lbClient.ServiceURL("elb", "l7policies", l7policyID, "rules", d.Id()), &getOpt)
 	if err != nil {
 		return common.CheckDeletedDiag(d, err, "L7 Rule")
 	}
 
+	l7Rule, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	log.Printf("[DEBUG] Retrieved L7 Rule %s: %#v", d.Id(), l7Rule)
 
 	mErr := multierror.Append(nil,
 		d.Set("l7policy_id", l7policyID),
-		d.Set("type", l7Rule.RuleType),
-		d.Set("compare_type", l7Rule.CompareType),
-		d.Set("value", l7Rule.Value),
+		d.Set("type", utils.PathSearch("rule.type", l7Rule, nil)),
+		d.Set("compare_type", utils.PathSearch("rule.compare_type", l7Rule, nil)),
+		d.Set("value", utils.PathSearch("rule.value", l7Rule, nil)),
+		d.Set("conditions", flattenL7RuleConditions(utils.PathSearch("rule.conditions", l7Rule, nil))),
 	)
 	if err := mErr.ErrorOrNil(); err != nil {
 		return diag.Errorf("error setting Dedicated ELB l7rule fields: %s", err)
@@ -151,17 +201,25 @@ func resourceL7RuleV3Update(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	l7policyID := d.Get("l7policy_id").(string)
-	var updateOpts l7policies.UpdateRuleOpts
-
-	if d.HasChange("compare_type") {
-		updateOpts.CompareType = l7policies.CompareType(d.Get("compare_type").(string))
+	updateOpts := map[string]interface{}{
+		"compare_type": d.Get("compare_type"),
 	}
 	if d.HasChange("value") {
-		updateOpts.Value = d.Get("value").(string)
+		updateOpts["value"] = utils.ValueIngoreEmpty(d.Get("value"))
+	}
+	if d.HasChange("conditions") {
+		updateOpts["conditions"] = buildL7RuleConditions(d.Get("conditions").(*schema.Set).List())
 	}
 
 	log.Printf("[DEBUG] Updating L7 Rule %s with options: %#v", d.Id(), updateOpts)
-	_, err = l7policies.UpdateRule(lbClient, l7policyID, d.Id(), updateOpts).Extract()
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		JSONBody: map[string]interface{}{
+			"rule": utils.RemoveNil(updateOpts),
+		},
+	}
+	_, err = lbClient.Request("PUT", lbClient.ServiceURL("elb", "l7policies", l7policyID, "rules", d.Id()), &updateOpt)
 	if err != nil {
 		return diag.Errorf("unable to update L7 Rule %s: %s", d.Id(), err)
 	}
@@ -199,6 +257,38 @@ func resourceL7RuleV3Delete(ctx context.Context, d *schema.ResourceData, meta in
 	return nil
 }
 
+func buildL7RuleConditions(rawConditions []interface{}) []map[string]interface{} {
+	if len(rawConditions) == 0 {
+		return nil
+	}
+
+	conditions := make([]map[string]interface{}, len(rawConditions))
+	for i, v := range rawConditions {
+		raw := v.(map[string]interface{})
+		conditions[i] = map[string]interface{}{
+			"key":   utils.ValueIngoreEmpty(raw["key"]),
+			"value": raw["value"],
+		}
+	}
+	return conditions
+}
+
+func flattenL7RuleConditions(resp interface{}) []interface{} {
+	curArray, ok := resp.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	rst := make([]interface{}, 0, len(curArray))
+	for _, v := range curArray {
+		rst = append(rst, map[string]interface{}{
+			"key":   utils.PathSearch("key", v, nil),
+			"value": utils.PathSearch("value", v, nil),
+		})
+	}
+	return rst
+}
+
 func waitForElbV3Rule(ctx context.Context, elbClient *golangsdk.ServiceClient, l7policyID string,
 	id string, target string, pending []string, timeout time.Duration) error {
 
@@ -0,0 +1,294 @@
+package elb
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/elb/v3/pools"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/helper/hashcode"
+)
+
+// ResourceMembersV3 manages all members of a dedicated ELB pool through the batch member APIs,
+// the resource ID is the pool ID.
+func ResourceMembersV3() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMembersV3Create,
+		ReadContext:   resourceMembersV3Read,
+		UpdateContext: resourceMembersV3Update,
+		DeleteContext: resourceMembersV3Delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceELBMembersImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"pool_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"members": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Set:      resourceELBMembersHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"protocol_port": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 65535),
+						},
+						"subnet_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The IPv4 or IPv6 subnet ID of the subnet in which to access the member",
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(0, 100),
+						},
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceELBMembersHash only hashes the configurable fields so that the computed ones do not cause a diff.
+func resourceELBMembersHash(v interface{}) int {
+	m := v.(map[string]interface{})
+	return hashcode.String(fmt.Sprintf("%s-%d-%s-%s-%d", m["address"], m["protocol_port"], m["subnet_id"],
+		m["name"], m["weight"]))
+}
+
+// elbMemberKey identifies a backend server in a pool, the members with the same address and port are treated as
+// the same member and only the name and weight are updated.
+func elbMemberKey(m map[string]interface{}) string {
+	return fmt.Sprintf("%s:%d", m["address"], m["protocol_port"])
+}
+
+func buildELBMembersCreateOpts(members []interface{}) []map[string]interface{} {
+	rst := make([]map[string]interface{}, len(members))
+	for i, v := range members {
+		m := v.(map[string]interface{})
+		member := map[string]interface{}{
+			"address":       m["address"],
+			"protocol_port": m["protocol_port"],
+			"weight":        m["weight"],
+		}
+		if name := m["name"].(string); name != "" {
+			member["name"] = name
+		}
+		// Must omit if not set
+		if subnetID := m["subnet_id"].(string); subnetID != "" {
+			member["subnet_cidr_id"] = subnetID
+		}
+		rst[i] = member
+	}
+	return rst
+}
+
+func batchOperateELBMembers(client *golangsdk.ServiceClient, poolID, action string,
+	members []map[string]interface{}) error {
+	if len(members) == 0 {
+		return nil
+	}
+
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 201, 204},
+		JSONBody: map[string]interface{}{
+			"members": members,
+		},
+	}
+	log.Printf("[DEBUG] Batch %s members of pool %s: %#v", action, poolID, members)
+	_, err := client.Request("POST", client.ServiceURL("elb", "pools", poolID, "members", action), &opt)
+	return err
+}
+
+func resourceMembersV3Create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*config.Config)
+	elbClient, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating elb client: %s", err)
+	}
+
+	poolID := d.Get("pool_id").(string)
+	createOpts := buildELBMembersCreateOpts(d.Get("members").(*schema.Set).List())
+	if err = batchOperateELBMembers(elbClient, poolID, "batch-add", createOpts); err != nil {
+		return diag.Errorf("error creating members of pool %s: %s", poolID, err)
+	}
+
+	d.SetId(poolID)
+
+	return resourceMembersV3Read(ctx, d, meta)
+}
+
+func resourceMembersV3Read(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*config.Config)
+	elbClient, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating elb client: %s", err)
+	}
+
+	// make sure the pool still exists, the member list of a deleted pool is not an error
+	if _, err = pools.Get(elbClient, d.Id()).Extract(); err != nil {
+		return common.CheckDeletedDiag(d, err, "pool")
+	}
+
+	allPages, err := pools.ListMembers(elbClient, d.Id(), pools.ListMembersOpts{}).AllPages()
+	if err != nil {
+		return diag.Errorf("error retrieving members of pool %s: %s", d.Id(), err)
+	}
+	members, err := pools.ExtractMembers(allPages)
+	if err != nil {
+		return diag.Errorf("error extracting members of pool %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Retrieved %d members of pool %s", len(members), d.Id())
+
+	mErr := multierror.Append(nil,
+		d.Set("region", config.GetRegion(d)),
+		d.Set("pool_id", d.Id()),
+		d.Set("members", flattenELBMembers(members)),
+	)
+	if err := mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error setting Dedicated ELB members fields: %s", err)
+	}
+
+	return nil
+}
+
+func flattenELBMembers(members []pools.Member) []interface{} {
+	rst := make([]interface{}, 0, len(members))
+	for _, member := range members {
+		rst = append(rst, map[string]interface{}{
+			"address":       member.Address,
+			"protocol_port": member.ProtocolPort,
+			"subnet_id":     member.SubnetID,
+			"name":          member.Name,
+			"weight":        member.Weight,
+			"id":            member.ID,
+		})
+	}
+	return rst
+}
+
+func resourceMembersV3Update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*config.Config)
+	elbClient, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating elb client: %s", err)
+	}
+
+	oldRaw, newRaw := d.GetChange("members")
+	oldMembers := make(map[string]map[string]interface{})
+	for _, v := range oldRaw.(*schema.Set).List() {
+		m := v.(map[string]interface{})
+		oldMembers[elbMemberKey(m)] = m
+	}
+
+	var toAdd []interface{}
+	toUpdate := make([]map[string]interface{}, 0)
+	for _, v := range newRaw.(*schema.Set).List() {
+		m := v.(map[string]interface{})
+		old, ok := oldMembers[elbMemberKey(m)]
+		if !ok || old["subnet_id"] != m["subnet_id"] {
+			toAdd = append(toAdd, m)
+			continue
+		}
+		delete(oldMembers, elbMemberKey(m))
+		if old["name"] != m["name"] || old["weight"] != m["weight"] {
+			toUpdate = append(toUpdate, map[string]interface{}{
+				"id":     old["id"],
+				"name":   m["name"],
+				"weight": m["weight"],
+			})
+		}
+	}
+
+	toRemove := make([]map[string]interface{}, 0, len(oldMembers))
+	for _, m := range oldMembers {
+		toRemove = append(toRemove, map[string]interface{}{
+			"id": m["id"],
+		})
+	}
+
+	// the members are removed first to release the address and port which may be added again with a new subnet
+	poolID := d.Id()
+	if err = batchOperateELBMembers(elbClient, poolID, "batch-delete", toRemove); err != nil {
+		return diag.Errorf("error removing members from pool %s: %s", poolID, err)
+	}
+	if err = batchOperateELBMembers(elbClient, poolID, "batch-update", toUpdate); err != nil {
+		return diag.Errorf("error updating members of pool %s: %s", poolID, err)
+	}
+	if err = batchOperateELBMembers(elbClient, poolID, "batch-add", buildELBMembersCreateOpts(toAdd)); err != nil {
+		return diag.Errorf("error adding members to pool %s: %s", poolID, err)
+	}
+
+	return resourceMembersV3Read(ctx, d, meta)
+}
+
+func resourceMembersV3Delete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*config.Config)
+	elbClient, err := config.ElbV3Client(config.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating elb client: %s", err)
+	}
+
+	members := d.Get("members").(*schema.Set).List()
+	deleteOpts := make([]map[string]interface{}, 0, len(members))
+	for _, v := range members {
+		deleteOpts = append(deleteOpts, map[string]interface{}{
+			"id": v.(map[string]interface{})["id"],
+		})
+	}
+
+	if err = batchOperateELBMembers(elbClient, d.Id(), "batch-delete", deleteOpts); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting members")
+	}
+	return nil
+}
+
+func resourceELBMembersImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	err := d.Set("pool_id", d.Id())
+	return []*schema.ResourceData{d}, err
+}
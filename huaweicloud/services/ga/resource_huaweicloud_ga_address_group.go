@@ -0,0 +1,436 @@
+package ga
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceIpAddressGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIpAddressGroupCreate,
+		UpdateContext: resourceIpAddressGroupUpdate,
+		ReadContext:   resourceIpAddressGroupRead,
+		DeleteContext: resourceIpAddressGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the IP address group name.`,
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9-]+$`),
+						"the input is invalid"),
+					validation.StringLenBetween(1, 64),
+				),
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the description of the IP address group.`,
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexp.MustCompile(`^[^<>]*$`),
+						"the input is invalid"),
+					validation.StringLenBetween(0, 255),
+				),
+			},
+			"ip_addresses": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the IP address or CIDR block.`,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: `Specifies the description of the IP address.`,
+						},
+					},
+				},
+				Description: `Specifies the IP addresses of the IP address group.`,
+			},
+			"listeners": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the ID of the associated listener.`,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the listener type, whitelist or blacklist.`,
+							ValidateFunc: validation.StringInSlice([]string{
+								"WHITE", "BLACK",
+							}, false),
+						},
+					},
+				},
+				Description: `Specifies the listeners associated with the IP address group for access control.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The status of the IP address group.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Specifies when the IP address group was created.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Specifies when the IP address group was updated.`,
+			},
+		},
+	}
+}
+
+func buildIpAddressGroupIpList(rawParams []interface{}) []map[string]interface{} {
+	rst := make([]map[string]interface{}, 0, len(rawParams))
+	for _, v := range rawParams {
+		raw := v.(map[string]interface{})
+		rst = append(rst, map[string]interface{}{
+			"cidr":        raw["cidr"],
+			"description": utils.ValueIngoreEmpty(raw["description"]),
+		})
+	}
+	return rst
+}
+
+func resourceIpAddressGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createIpAddressGroup: Create a GA IP Address Group.
+	var (
+		createIpAddressGroupHttpUrl = "v1/ip-groups"
+		createIpAddressGroupProduct = "ga"
+	)
+	client, err := cfg.NewServiceClient(createIpAddressGroupProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating IpAddressGroup Client: %s", err)
+	}
+
+	createIpAddressGroupPath := client.Endpoint + createIpAddressGroupHttpUrl
+
+	createIpAddressGroupOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			201,
+		},
+	}
+	createIpAddressGroupOpt.JSONBody = utils.RemoveNil(map[string]interface{}{
+		"ip_group": map[string]interface{}{
+			"name":        d.Get("name"),
+			"ip_version":  "IPv4",
+			"description": utils.ValueIngoreEmpty(d.Get("description")),
+			"ip_list":     buildIpAddressGroupIpList(d.Get("ip_addresses").(*schema.Set).List()),
+		},
+	})
+	createIpAddressGroupResp, err := client.Request("POST", createIpAddressGroupPath, &createIpAddressGroupOpt)
+	if err != nil {
+		return diag.Errorf("error creating IpAddressGroup: %s", err)
+	}
+
+	createIpAddressGroupRespBody, err := utils.FlattenResponse(createIpAddressGroupResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := utils.PathSearch("ip_group.id", createIpAddressGroupRespBody, "").(string)
+	if id == "" {
+		return diag.Errorf("error creating IpAddressGroup: ID is not found in API response")
+	}
+	d.SetId(id)
+
+	getPath := client.Endpoint + "v1/ip-groups/" + d.Id()
+	err = waitForGAResourceActive(ctx, client, getPath, "ip_group", d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error waiting for the Create of IpAddressGroup (%s) to complete: %s", d.Id(), err)
+	}
+
+	for _, v := range d.Get("listeners").(*schema.Set).List() {
+		err = associateIpAddressGroupListener(ctx, client, d, v.(map[string]interface{}),
+			d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceIpAddressGroupRead(ctx, d, meta)
+}
+
+// operateIpAddressGroup calls the action API of the IP address group, such as add-ips, and waits for the group to
+// become ACTIVE.
+func operateIpAddressGroup(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData,
+	action string, body map[string]interface{}, t time.Duration) error {
+	path := client.Endpoint + "v1/ip-groups/{id}/{action}"
+	path = strings.ReplaceAll(path, "{id}", d.Id())
+	path = strings.ReplaceAll(path, "{action}", action)
+
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 201, 204,
+		},
+		JSONBody: utils.RemoveNil(body),
+	}
+	if _, err := client.Request("POST", path, &opt); err != nil {
+		return err
+	}
+
+	return waitForGAResourceActive(ctx, client, client.Endpoint+"v1/ip-groups/"+d.Id(), "ip_group", t)
+}
+
+func associateIpAddressGroupListener(ctx context.Context, client *golangsdk.ServiceClient, d *schema.ResourceData,
+	listener map[string]interface{}, t time.Duration) error {
+	err := operateIpAddressGroup(ctx, client, d, "associate-listener", map[string]interface{}{
+		"listener_id": listener["id"],
+		"type":        listener["type"],
+	}, t)
+	if err != nil {
+		return fmt.Errorf("error associating listener (%s) with IpAddressGroup (%s): %s", listener["id"], d.Id(), err)
+	}
+	return nil
+}
+
+func disassociateIpAddressGroupListener(ctx context.Context, client *golangsdk.ServiceClient,
+	d *schema.ResourceData, listener map[string]interface{}, t time.Duration) error {
+	err := operateIpAddressGroup(ctx, client, d, "disassociate-listener", map[string]interface{}{
+		"listener_id": listener["id"],
+	}, t)
+	if err != nil {
+		return fmt.Errorf("error disassociating listener (%s) from IpAddressGroup (%s): %s", listener["id"], d.Id(),
+			err)
+	}
+	return nil
+}
+
+func resourceIpAddressGroupRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	var mErr *multierror.Error
+
+	// getIpAddressGroup: Query the GA IP Address Group detail
+	var (
+		getIpAddressGroupHttpUrl = "v1/ip-groups/{id}"
+		getIpAddressGroupProduct = "ga"
+	)
+	getIpAddressGroupClient, err := cfg.NewServiceClient(getIpAddressGroupProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating IpAddressGroup Client: %s", err)
+	}
+
+	getIpAddressGroupPath := getIpAddressGroupClient.Endpoint + getIpAddressGroupHttpUrl
+	getIpAddressGroupPath = strings.ReplaceAll(getIpAddressGroupPath, "{id}", d.Id())
+
+	getIpAddressGroupOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getIpAddressGroupResp, err := getIpAddressGroupClient.Request("GET", getIpAddressGroupPath,
+		&getIpAddressGroupOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving IpAddressGroup")
+	}
+
+	getIpAddressGroupRespBody, err := utils.FlattenResponse(getIpAddressGroupResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr = multierror.Append(
+		mErr,
+		d.Set("name", utils.PathSearch("ip_group.name", getIpAddressGroupRespBody, nil)),
+		d.Set("description", utils.PathSearch("ip_group.description", getIpAddressGroupRespBody, nil)),
+		d.Set("ip_addresses", flattenIpAddressGroupIpList(getIpAddressGroupRespBody)),
+		d.Set("listeners", flattenIpAddressGroupListeners(getIpAddressGroupRespBody)),
+		d.Set("status", utils.PathSearch("ip_group.status", getIpAddressGroupRespBody, nil)),
+		d.Set("created_at", utils.PathSearch("ip_group.created_at", getIpAddressGroupRespBody, nil)),
+		d.Set("updated_at", utils.PathSearch("ip_group.updated_at", getIpAddressGroupRespBody, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenIpAddressGroupIpList(resp interface{}) []interface{} {
+	curJson := utils.PathSearch("ip_group.ip_list", resp, make([]interface{}, 0))
+	curArray := curJson.([]interface{})
+	rst := make([]interface{}, 0, len(curArray))
+	for _, v := range curArray {
+		rst = append(rst, map[string]interface{}{
+			"cidr":        utils.PathSearch("cidr", v, nil),
+			"description": utils.PathSearch("description", v, nil),
+		})
+	}
+	return rst
+}
+
+func flattenIpAddressGroupListeners(resp interface{}) []interface{} {
+	curJson := utils.PathSearch("ip_group.associated_listeners", resp, make([]interface{}, 0))
+	curArray := curJson.([]interface{})
+	rst := make([]interface{}, 0, len(curArray))
+	for _, v := range curArray {
+		rst = append(rst, map[string]interface{}{
+			"id":   utils.PathSearch("id", v, nil),
+			"type": utils.PathSearch("type", v, nil),
+		})
+	}
+	return rst
+}
+
+func resourceIpAddressGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	client, err := cfg.NewServiceClient("ga", region)
+	if err != nil {
+		return diag.Errorf("error creating IpAddressGroup Client: %s", err)
+	}
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	if d.HasChanges("name", "description") {
+		// updateIpAddressGroup: Update the configuration of GA IP Address Group
+		updateIpAddressGroupPath := client.Endpoint + "v1/ip-groups/{id}"
+		updateIpAddressGroupPath = strings.ReplaceAll(updateIpAddressGroupPath, "{id}", d.Id())
+
+		updateIpAddressGroupOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes: []int{
+				200,
+			},
+			JSONBody: map[string]interface{}{
+				"ip_group": map[string]interface{}{
+					"name":        d.Get("name"),
+					"description": d.Get("description"),
+				},
+			},
+		}
+		_, err = client.Request("PUT", updateIpAddressGroupPath, &updateIpAddressGroupOpt)
+		if err != nil {
+			return diag.Errorf("error updating IpAddressGroup: %s", err)
+		}
+
+		err = waitForGAResourceActive(ctx, client, updateIpAddressGroupPath, "ip_group", timeout)
+		if err != nil {
+			return diag.Errorf("error waiting for the Update of IpAddressGroup (%s) to complete: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("ip_addresses") {
+		oldRaw, newRaw := d.GetChange("ip_addresses")
+		removeList := oldRaw.(*schema.Set).Difference(newRaw.(*schema.Set)).List()
+		addList := newRaw.(*schema.Set).Difference(oldRaw.(*schema.Set)).List()
+
+		if len(removeList) > 0 {
+			cidrs := make([]map[string]interface{}, 0, len(removeList))
+			for _, v := range removeList {
+				cidrs = append(cidrs, map[string]interface{}{
+					"cidr": v.(map[string]interface{})["cidr"],
+				})
+			}
+			err = operateIpAddressGroup(ctx, client, d, "remove-ips", map[string]interface{}{
+				"ip_list": cidrs,
+			}, timeout)
+			if err != nil {
+				return diag.Errorf("error removing IP addresses from IpAddressGroup (%s): %s", d.Id(), err)
+			}
+		}
+		if len(addList) > 0 {
+			err = operateIpAddressGroup(ctx, client, d, "add-ips", map[string]interface{}{
+				"ip_list": buildIpAddressGroupIpList(addList),
+			}, timeout)
+			if err != nil {
+				return diag.Errorf("error adding IP addresses to IpAddressGroup (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	if d.HasChange("listeners") {
+		oldRaw, newRaw := d.GetChange("listeners")
+		for _, v := range oldRaw.(*schema.Set).Difference(newRaw.(*schema.Set)).List() {
+			err = disassociateIpAddressGroupListener(ctx, client, d, v.(map[string]interface{}), timeout)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		for _, v := range newRaw.(*schema.Set).Difference(oldRaw.(*schema.Set)).List() {
+			err = associateIpAddressGroupListener(ctx, client, d, v.(map[string]interface{}), timeout)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+	return resourceIpAddressGroupRead(ctx, d, meta)
+}
+
+func resourceIpAddressGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	client, err := cfg.NewServiceClient("ga", region)
+	if err != nil {
+		return diag.Errorf("error creating IpAddressGroup Client: %s", err)
+	}
+
+	// the IP address group can not be deleted before the listeners are disassociated
+	for _, v := range d.Get("listeners").(*schema.Set).List() {
+		err = disassociateIpAddressGroupListener(ctx, client, d, v.(map[string]interface{}),
+			d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	deleteIpAddressGroupPath := client.Endpoint + "v1/ip-groups/{id}"
+	deleteIpAddressGroupPath = strings.ReplaceAll(deleteIpAddressGroupPath, "{id}", d.Id())
+
+	deleteIpAddressGroupOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			204,
+		},
+	}
+	_, err = client.Request("DELETE", deleteIpAddressGroupPath, &deleteIpAddressGroupOpt)
+	if err != nil {
+		return diag.Errorf("error deleting IpAddressGroup: %s", err)
+	}
+
+	err = waitForGAResourceDeleted(ctx, client, deleteIpAddressGroupPath, "ip_group",
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("error waiting for the Delete of IpAddressGroup (%s) to complete: %s", d.Id(), err)
+	}
+	return nil
+}
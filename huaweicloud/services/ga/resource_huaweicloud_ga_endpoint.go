@@ -0,0 +1,275 @@
+package ga
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEndpointCreate,
+		UpdateContext: resourceEndpointUpdate,
+		ReadContext:   resourceEndpointRead,
+		DeleteContext: resourceEndpointDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceEndpointImportState,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"endpoint_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the endpoint group to which the endpoint belongs.`,
+			},
+			"resource_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the backend resource, such as the EIP ID.`,
+			},
+			"resource_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "EIP",
+				Description: `Specifies the type of the backend resource.`,
+				ValidateFunc: validation.StringInSlice([]string{
+					"EIP",
+				}, false),
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the IP address of the backend resource.`,
+			},
+			"weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  `Specifies the weight of the endpoint based on which the listener distributes traffic.`,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The provisioning status of the endpoint.`,
+			},
+			"health_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The health check result of the endpoint.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Specifies when the endpoint was created.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Specifies when the endpoint was updated.`,
+			},
+		},
+	}
+}
+
+func buildEndpointPath(client *golangsdk.ServiceClient, groupID, endpointID string) string {
+	path := client.Endpoint + "v1/endpoint-groups/{endpoint_group_id}/endpoints/{id}"
+	path = strings.ReplaceAll(path, "{endpoint_group_id}", groupID)
+	return strings.ReplaceAll(path, "{id}", endpointID)
+}
+
+func resourceEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createEndpoint: Create a GA Endpoint.
+	var (
+		createEndpointHttpUrl = "v1/endpoint-groups/{endpoint_group_id}/endpoints"
+		createEndpointProduct = "ga"
+	)
+	createEndpointClient, err := cfg.NewServiceClient(createEndpointProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating Endpoint Client: %s", err)
+	}
+
+	groupID := d.Get("endpoint_group_id").(string)
+	createEndpointPath := createEndpointClient.Endpoint + createEndpointHttpUrl
+	createEndpointPath = strings.ReplaceAll(createEndpointPath, "{endpoint_group_id}", groupID)
+
+	createEndpointOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			201,
+		},
+	}
+	createEndpointOpt.JSONBody = utils.RemoveNil(map[string]interface{}{
+		"endpoint": map[string]interface{}{
+			"resource_id":   d.Get("resource_id"),
+			"resource_type": d.Get("resource_type"),
+			"ip_address":    d.Get("ip_address"),
+			"weight":        utils.ValueIngoreEmpty(d.Get("weight")),
+		},
+	})
+	createEndpointResp, err := createEndpointClient.Request("POST", createEndpointPath, &createEndpointOpt)
+	if err != nil {
+		return diag.Errorf("error creating Endpoint: %s", err)
+	}
+
+	createEndpointRespBody, err := utils.FlattenResponse(createEndpointResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := utils.PathSearch("endpoint.id", createEndpointRespBody, "").(string)
+	if id == "" {
+		return diag.Errorf("error creating Endpoint: ID is not found in API response")
+	}
+	d.SetId(id)
+
+	err = waitForGAResourceActive(ctx, createEndpointClient, buildEndpointPath(createEndpointClient, groupID, id),
+		"endpoint", d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error waiting for the Create of Endpoint (%s) to complete: %s", d.Id(), err)
+	}
+	return resourceEndpointRead(ctx, d, meta)
+}
+
+func resourceEndpointRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	var mErr *multierror.Error
+
+	getEndpointClient, err := cfg.NewServiceClient("ga", region)
+	if err != nil {
+		return diag.Errorf("error creating Endpoint Client: %s", err)
+	}
+
+	getEndpointOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getEndpointPath := buildEndpointPath(getEndpointClient, d.Get("endpoint_group_id").(string), d.Id())
+	getEndpointResp, err := getEndpointClient.Request("GET", getEndpointPath, &getEndpointOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving Endpoint")
+	}
+
+	getEndpointRespBody, err := utils.FlattenResponse(getEndpointResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr = multierror.Append(
+		mErr,
+		d.Set("endpoint_group_id", utils.PathSearch("endpoint.endpoint_group_id", getEndpointRespBody, nil)),
+		d.Set("resource_id", utils.PathSearch("endpoint.resource_id", getEndpointRespBody, nil)),
+		d.Set("resource_type", utils.PathSearch("endpoint.resource_type", getEndpointRespBody, nil)),
+		d.Set("ip_address", utils.PathSearch("endpoint.ip_address", getEndpointRespBody, nil)),
+		d.Set("weight", utils.PathSearch("endpoint.weight", getEndpointRespBody, nil)),
+		d.Set("status", utils.PathSearch("endpoint.status", getEndpointRespBody, nil)),
+		d.Set("health_state", utils.PathSearch("endpoint.health_state", getEndpointRespBody, nil)),
+		d.Set("created_at", utils.PathSearch("endpoint.created_at", getEndpointRespBody, nil)),
+		d.Set("updated_at", utils.PathSearch("endpoint.updated_at", getEndpointRespBody, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	if d.HasChange("weight") {
+		updateEndpointClient, err := cfg.NewServiceClient("ga", region)
+		if err != nil {
+			return diag.Errorf("error creating Endpoint Client: %s", err)
+		}
+
+		updateEndpointPath := buildEndpointPath(updateEndpointClient, d.Get("endpoint_group_id").(string), d.Id())
+		updateEndpointOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes: []int{
+				200,
+			},
+			JSONBody: map[string]interface{}{
+				"endpoint": map[string]interface{}{
+					"weight": d.Get("weight"),
+				},
+			},
+		}
+		_, err = updateEndpointClient.Request("PUT", updateEndpointPath, &updateEndpointOpt)
+		if err != nil {
+			return diag.Errorf("error updating Endpoint: %s", err)
+		}
+
+		err = waitForGAResourceActive(ctx, updateEndpointClient, updateEndpointPath, "endpoint",
+			d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("error waiting for the Update of Endpoint (%s) to complete: %s", d.Id(), err)
+		}
+	}
+	return resourceEndpointRead(ctx, d, meta)
+}
+
+func resourceEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	deleteEndpointClient, err := cfg.NewServiceClient("ga", region)
+	if err != nil {
+		return diag.Errorf("error creating Endpoint Client: %s", err)
+	}
+
+	deleteEndpointPath := buildEndpointPath(deleteEndpointClient, d.Get("endpoint_group_id").(string), d.Id())
+	deleteEndpointOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			204,
+		},
+	}
+	_, err = deleteEndpointClient.Request("DELETE", deleteEndpointPath, &deleteEndpointOpt)
+	if err != nil {
+		return diag.Errorf("error deleting Endpoint: %s", err)
+	}
+
+	err = waitForGAResourceDeleted(ctx, deleteEndpointClient, deleteEndpointPath, "endpoint",
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("error waiting for the Delete of Endpoint (%s) to complete: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func resourceEndpointImportState(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData,
+	error) {
+	parts := strings.SplitN(d.Id(), "/", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <endpoint_group_id>/<id>")
+	}
+
+	d.SetId(parts[1])
+	return []*schema.ResourceData{d}, d.Set("endpoint_group_id", parts[0])
+}
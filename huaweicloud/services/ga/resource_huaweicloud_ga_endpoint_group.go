@@ -0,0 +1,411 @@
+package ga
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceEndpointGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEndpointGroupCreate,
+		UpdateContext: resourceEndpointGroupUpdate,
+		ReadContext:   resourceEndpointGroupRead,
+		DeleteContext: resourceEndpointGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the endpoint group name.`,
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9-]+$`),
+						"the input is invalid"),
+					validation.StringLenBetween(1, 64),
+				),
+			},
+			"region_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the region where the endpoint group belongs.`,
+			},
+			"listeners": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the ID of the associated listener.`,
+						},
+					},
+				},
+				Description: `Specifies the listener associated with the endpoint group.`,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the description of the endpoint group.`,
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexp.MustCompile(`^[^<>]*$`),
+						"the input is invalid"),
+					validation.StringLenBetween(0, 255),
+				),
+			},
+			"traffic_dial_percentage": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  `Specifies the percentage of traffic distributed to the endpoint group.`,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The provisioning status of the endpoint group.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Specifies when the endpoint group was created.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Specifies when the endpoint group was updated.`,
+			},
+		},
+	}
+}
+
+func resourceEndpointGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createEndpointGroup: Create a GA Endpoint Group.
+	var (
+		createEndpointGroupHttpUrl = "v1/endpoint-groups"
+		createEndpointGroupProduct = "ga"
+	)
+	createEndpointGroupClient, err := cfg.NewServiceClient(createEndpointGroupProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating EndpointGroup Client: %s", err)
+	}
+
+	createEndpointGroupPath := createEndpointGroupClient.Endpoint + createEndpointGroupHttpUrl
+
+	createEndpointGroupOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			201,
+		},
+	}
+	createEndpointGroupOpt.JSONBody = utils.RemoveNil(buildCreateEndpointGroupBodyParams(d))
+	createEndpointGroupResp, err := createEndpointGroupClient.Request("POST", createEndpointGroupPath,
+		&createEndpointGroupOpt)
+	if err != nil {
+		return diag.Errorf("error creating EndpointGroup: %s", err)
+	}
+
+	createEndpointGroupRespBody, err := utils.FlattenResponse(createEndpointGroupResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := utils.PathSearch("endpoint_group.id", createEndpointGroupRespBody, "").(string)
+	if id == "" {
+		return diag.Errorf("error creating EndpointGroup: ID is not found in API response")
+	}
+	d.SetId(id)
+
+	getPath := createEndpointGroupClient.Endpoint + "v1/endpoint-groups/" + d.Id()
+	err = waitForGAResourceActive(ctx, createEndpointGroupClient, getPath, "endpoint_group",
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error waiting for the Create of EndpointGroup (%s) to complete: %s", d.Id(), err)
+	}
+	return resourceEndpointGroupRead(ctx, d, meta)
+}
+
+func buildCreateEndpointGroupBodyParams(d *schema.ResourceData) map[string]interface{} {
+	bodyParams := map[string]interface{}{
+		"endpoint_group": map[string]interface{}{
+			"name":                    d.Get("name"),
+			"region_id":               d.Get("region_id"),
+			"listeners":               buildEndpointGroupListeners(d.Get("listeners").([]interface{})),
+			"description":             utils.ValueIngoreEmpty(d.Get("description")),
+			"traffic_dial_percentage": utils.ValueIngoreEmpty(d.Get("traffic_dial_percentage")),
+		},
+	}
+	return bodyParams
+}
+
+func buildEndpointGroupListeners(rawParams []interface{}) []map[string]interface{} {
+	rst := make([]map[string]interface{}, 0, len(rawParams))
+	for _, v := range rawParams {
+		raw := v.(map[string]interface{})
+		rst = append(rst, map[string]interface{}{
+			"id": raw["id"],
+		})
+	}
+	return rst
+}
+
+// waitForGAResourceActive waits for the GA resource queried by the path to become ACTIVE, the key is the root
+// element of the response body, such as endpoint_group.
+func waitForGAResourceActive(ctx context.Context, client *golangsdk.ServiceClient, path, key string,
+	t time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			getOpt := golangsdk.RequestOpts{
+				KeepResponseBody: true,
+				OkCodes: []int{
+					200,
+				},
+			}
+			getResp, err := client.Request("GET", path, &getOpt)
+			if err != nil {
+				return nil, "ERROR", err
+			}
+
+			getRespBody, err := utils.FlattenResponse(getResp)
+			if err != nil {
+				return nil, "ERROR", err
+			}
+
+			status := utils.PathSearch(fmt.Sprintf("%s.status", key), getRespBody, "").(string)
+			if status == "ACTIVE" {
+				return getRespBody, "COMPLETED", nil
+			}
+			if status == "ERROR" {
+				return getRespBody, status, fmt.Errorf("the %s is in ERROR status", key)
+			}
+			return getRespBody, "PENDING", nil
+		},
+		Timeout:      t,
+		Delay:        10 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// waitForGAResourceDeleted waits for the GA resource queried by the path to be deleted.
+func waitForGAResourceDeleted(ctx context.Context, client *golangsdk.ServiceClient, path, key string,
+	t time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"PENDING"},
+		Target:  []string{"COMPLETED"},
+		Refresh: func() (interface{}, string, error) {
+			getOpt := golangsdk.RequestOpts{
+				KeepResponseBody: true,
+				OkCodes: []int{
+					200,
+				},
+			}
+			getResp, err := client.Request("GET", path, &getOpt)
+			if err != nil {
+				if _, ok := err.(golangsdk.ErrDefault404); ok {
+					return "deleted", "COMPLETED", nil
+				}
+				return nil, "ERROR", err
+			}
+
+			getRespBody, err := utils.FlattenResponse(getResp)
+			if err != nil {
+				return nil, "ERROR", err
+			}
+
+			status := utils.PathSearch(fmt.Sprintf("%s.status", key), getRespBody, "").(string)
+			if status == "ERROR" {
+				return getRespBody, status, fmt.Errorf("the %s is in ERROR status", key)
+			}
+			return getRespBody, "PENDING", nil
+		},
+		Timeout:      t,
+		Delay:        10 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourceEndpointGroupRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	var mErr *multierror.Error
+
+	// getEndpointGroup: Query the GA Endpoint Group detail
+	var (
+		getEndpointGroupHttpUrl = "v1/endpoint-groups/{id}"
+		getEndpointGroupProduct = "ga"
+	)
+	getEndpointGroupClient, err := cfg.NewServiceClient(getEndpointGroupProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating EndpointGroup Client: %s", err)
+	}
+
+	getEndpointGroupPath := getEndpointGroupClient.Endpoint + getEndpointGroupHttpUrl
+	getEndpointGroupPath = strings.ReplaceAll(getEndpointGroupPath, "{id}", d.Id())
+
+	getEndpointGroupOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getEndpointGroupResp, err := getEndpointGroupClient.Request("GET", getEndpointGroupPath, &getEndpointGroupOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving EndpointGroup")
+	}
+
+	getEndpointGroupRespBody, err := utils.FlattenResponse(getEndpointGroupResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr = multierror.Append(
+		mErr,
+		d.Set("name", utils.PathSearch("endpoint_group.name", getEndpointGroupRespBody, nil)),
+		d.Set("region_id", utils.PathSearch("endpoint_group.region_id", getEndpointGroupRespBody, nil)),
+		d.Set("listeners", flattenGetEndpointGroupResponseBodyListeners(getEndpointGroupRespBody)),
+		d.Set("description", utils.PathSearch("endpoint_group.description", getEndpointGroupRespBody, nil)),
+		d.Set("traffic_dial_percentage", utils.PathSearch("endpoint_group.traffic_dial_percentage",
+			getEndpointGroupRespBody, nil)),
+		d.Set("status", utils.PathSearch("endpoint_group.status", getEndpointGroupRespBody, nil)),
+		d.Set("created_at", utils.PathSearch("endpoint_group.created_at", getEndpointGroupRespBody, nil)),
+		d.Set("updated_at", utils.PathSearch("endpoint_group.updated_at", getEndpointGroupRespBody, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenGetEndpointGroupResponseBodyListeners(resp interface{}) []interface{} {
+	if resp == nil {
+		return nil
+	}
+	curJson := utils.PathSearch("endpoint_group.listeners", resp, make([]interface{}, 0))
+	curArray := curJson.([]interface{})
+	rst := make([]interface{}, 0, len(curArray))
+	for _, v := range curArray {
+		rst = append(rst, map[string]interface{}{
+			"id": utils.PathSearch("id", v, nil),
+		})
+	}
+	return rst
+}
+
+func resourceEndpointGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	updateEndpointGroupChanges := []string{
+		"name",
+		"description",
+		"traffic_dial_percentage",
+	}
+
+	if d.HasChanges(updateEndpointGroupChanges...) {
+		// updateEndpointGroup: Update the configuration of GA Endpoint Group
+		var (
+			updateEndpointGroupHttpUrl = "v1/endpoint-groups/{id}"
+			updateEndpointGroupProduct = "ga"
+		)
+		updateEndpointGroupClient, err := cfg.NewServiceClient(updateEndpointGroupProduct, region)
+		if err != nil {
+			return diag.Errorf("error creating EndpointGroup Client: %s", err)
+		}
+
+		updateEndpointGroupPath := updateEndpointGroupClient.Endpoint + updateEndpointGroupHttpUrl
+		updateEndpointGroupPath = strings.ReplaceAll(updateEndpointGroupPath, "{id}", d.Id())
+
+		updateEndpointGroupOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes: []int{
+				200,
+			},
+		}
+		updateEndpointGroupOpt.JSONBody = utils.RemoveNil(map[string]interface{}{
+			"endpoint_group": map[string]interface{}{
+				"name":                    d.Get("name"),
+				"description":             d.Get("description"),
+				"traffic_dial_percentage": d.Get("traffic_dial_percentage"),
+			},
+		})
+		_, err = updateEndpointGroupClient.Request("PUT", updateEndpointGroupPath, &updateEndpointGroupOpt)
+		if err != nil {
+			return diag.Errorf("error updating EndpointGroup: %s", err)
+		}
+
+		err = waitForGAResourceActive(ctx, updateEndpointGroupClient, updateEndpointGroupPath, "endpoint_group",
+			d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("error waiting for the Update of EndpointGroup (%s) to complete: %s", d.Id(), err)
+		}
+	}
+	return resourceEndpointGroupRead(ctx, d, meta)
+}
+
+func resourceEndpointGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// deleteEndpointGroup: Delete an existing GA Endpoint Group
+	var (
+		deleteEndpointGroupHttpUrl = "v1/endpoint-groups/{id}"
+		deleteEndpointGroupProduct = "ga"
+	)
+	deleteEndpointGroupClient, err := cfg.NewServiceClient(deleteEndpointGroupProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating EndpointGroup Client: %s", err)
+	}
+
+	deleteEndpointGroupPath := deleteEndpointGroupClient.Endpoint + deleteEndpointGroupHttpUrl
+	deleteEndpointGroupPath = strings.ReplaceAll(deleteEndpointGroupPath, "{id}", d.Id())
+
+	deleteEndpointGroupOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			204,
+		},
+	}
+	_, err = deleteEndpointGroupClient.Request("DELETE", deleteEndpointGroupPath, &deleteEndpointGroupOpt)
+	if err != nil {
+		return diag.Errorf("error deleting EndpointGroup: %s", err)
+	}
+
+	err = waitForGAResourceDeleted(ctx, deleteEndpointGroupClient, deleteEndpointGroupPath, "endpoint_group",
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("error waiting for the Delete of EndpointGroup (%s) to complete: %s", d.Id(), err)
+	}
+	return nil
+}
@@ -0,0 +1,296 @@
+package ga
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourceHealthCheck() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHealthCheckCreate,
+		UpdateContext: resourceHealthCheckUpdate,
+		ReadContext:   resourceHealthCheckRead,
+		DeleteContext: resourceHealthCheckDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"endpoint_group_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the endpoint group to which the health check belongs.`,
+			},
+			"protocol": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the health check protocol.`,
+				ValidateFunc: validation.StringInSlice([]string{
+					"TCP",
+				}, false),
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  `Specifies the health check port.`,
+				ValidateFunc: validation.IntBetween(1, 65535),
+			},
+			"interval": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  `Specifies the health check interval, in seconds.`,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  `Specifies the timeout duration of the health check, in seconds.`,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  `Specifies the maximum number of retries.`,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: `Specifies whether to enable the health check.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The provisioning status of the health check.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Specifies when the health check was created.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Specifies when the health check was updated.`,
+			},
+		},
+	}
+}
+
+func buildHealthCheckBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"protocol":    d.Get("protocol"),
+		"port":        d.Get("port"),
+		"interval":    d.Get("interval"),
+		"timeout":     d.Get("timeout"),
+		"max_retries": d.Get("max_retries"),
+		"enabled":     d.Get("enabled"),
+	}
+}
+
+func resourceHealthCheckCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createHealthCheck: Create a GA Health Check.
+	var (
+		createHealthCheckHttpUrl = "v1/health-checks"
+		createHealthCheckProduct = "ga"
+	)
+	createHealthCheckClient, err := cfg.NewServiceClient(createHealthCheckProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating HealthCheck Client: %s", err)
+	}
+
+	createHealthCheckPath := createHealthCheckClient.Endpoint + createHealthCheckHttpUrl
+
+	bodyParams := buildHealthCheckBodyParams(d)
+	bodyParams["endpoint_group_id"] = d.Get("endpoint_group_id")
+	createHealthCheckOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			201,
+		},
+		JSONBody: map[string]interface{}{
+			"health_check": bodyParams,
+		},
+	}
+	createHealthCheckResp, err := createHealthCheckClient.Request("POST", createHealthCheckPath, &createHealthCheckOpt)
+	if err != nil {
+		return diag.Errorf("error creating HealthCheck: %s", err)
+	}
+
+	createHealthCheckRespBody, err := utils.FlattenResponse(createHealthCheckResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := utils.PathSearch("health_check.id", createHealthCheckRespBody, "").(string)
+	if id == "" {
+		return diag.Errorf("error creating HealthCheck: ID is not found in API response")
+	}
+	d.SetId(id)
+
+	getPath := createHealthCheckClient.Endpoint + "v1/health-checks/" + d.Id()
+	err = waitForGAResourceActive(ctx, createHealthCheckClient, getPath, "health_check",
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error waiting for the Create of HealthCheck (%s) to complete: %s", d.Id(), err)
+	}
+	return resourceHealthCheckRead(ctx, d, meta)
+}
+
+func resourceHealthCheckRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	var mErr *multierror.Error
+
+	// getHealthCheck: Query the GA Health Check detail
+	var (
+		getHealthCheckHttpUrl = "v1/health-checks/{id}"
+		getHealthCheckProduct = "ga"
+	)
+	getHealthCheckClient, err := cfg.NewServiceClient(getHealthCheckProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating HealthCheck Client: %s", err)
+	}
+
+	getHealthCheckPath := getHealthCheckClient.Endpoint + getHealthCheckHttpUrl
+	getHealthCheckPath = strings.ReplaceAll(getHealthCheckPath, "{id}", d.Id())
+
+	getHealthCheckOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+	}
+	getHealthCheckResp, err := getHealthCheckClient.Request("GET", getHealthCheckPath, &getHealthCheckOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving HealthCheck")
+	}
+
+	getHealthCheckRespBody, err := utils.FlattenResponse(getHealthCheckResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr = multierror.Append(
+		mErr,
+		d.Set("endpoint_group_id", utils.PathSearch("health_check.endpoint_group_id", getHealthCheckRespBody, nil)),
+		d.Set("protocol", utils.PathSearch("health_check.protocol", getHealthCheckRespBody, nil)),
+		d.Set("port", utils.PathSearch("health_check.port", getHealthCheckRespBody, nil)),
+		d.Set("interval", utils.PathSearch("health_check.interval", getHealthCheckRespBody, nil)),
+		d.Set("timeout", utils.PathSearch("health_check.timeout", getHealthCheckRespBody, nil)),
+		d.Set("max_retries", utils.PathSearch("health_check.max_retries", getHealthCheckRespBody, nil)),
+		d.Set("enabled", utils.PathSearch("health_check.enabled", getHealthCheckRespBody, nil)),
+		d.Set("status", utils.PathSearch("health_check.status", getHealthCheckRespBody, nil)),
+		d.Set("created_at", utils.PathSearch("health_check.created_at", getHealthCheckRespBody, nil)),
+		d.Set("updated_at", utils.PathSearch("health_check.updated_at", getHealthCheckRespBody, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceHealthCheckUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	updateHealthCheckChanges := []string{
+		"protocol",
+		"port",
+		"interval",
+		"timeout",
+		"max_retries",
+		"enabled",
+	}
+
+	if d.HasChanges(updateHealthCheckChanges...) {
+		// updateHealthCheck: Update the configuration of GA Health Check
+		var (
+			updateHealthCheckHttpUrl = "v1/health-checks/{id}"
+			updateHealthCheckProduct = "ga"
+		)
+		updateHealthCheckClient, err := cfg.NewServiceClient(updateHealthCheckProduct, region)
+		if err != nil {
+			return diag.Errorf("error creating HealthCheck Client: %s", err)
+		}
+
+		updateHealthCheckPath := updateHealthCheckClient.Endpoint + updateHealthCheckHttpUrl
+		updateHealthCheckPath = strings.ReplaceAll(updateHealthCheckPath, "{id}", d.Id())
+
+		updateHealthCheckOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes: []int{
+				200,
+			},
+			JSONBody: map[string]interface{}{
+				"health_check": buildHealthCheckBodyParams(d),
+			},
+		}
+		_, err = updateHealthCheckClient.Request("PUT", updateHealthCheckPath, &updateHealthCheckOpt)
+		if err != nil {
+			return diag.Errorf("error updating HealthCheck: %s", err)
+		}
+
+		err = waitForGAResourceActive(ctx, updateHealthCheckClient, updateHealthCheckPath, "health_check",
+			d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("error waiting for the Update of HealthCheck (%s) to complete: %s", d.Id(), err)
+		}
+	}
+	return resourceHealthCheckRead(ctx, d, meta)
+}
+
+func resourceHealthCheckDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// deleteHealthCheck: Delete an existing GA Health Check
+	var (
+		deleteHealthCheckHttpUrl = "v1/health-checks/{id}"
+		deleteHealthCheckProduct = "ga"
+	)
+	deleteHealthCheckClient, err := cfg.NewServiceClient(deleteHealthCheckProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating HealthCheck Client: %s", err)
+	}
+
+	deleteHealthCheckPath := deleteHealthCheckClient.Endpoint + deleteHealthCheckHttpUrl
+	deleteHealthCheckPath = strings.ReplaceAll(deleteHealthCheckPath, "{id}", d.Id())
+
+	deleteHealthCheckOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			204,
+		},
+	}
+	_, err = deleteHealthCheckClient.Request("DELETE", deleteHealthCheckPath, &deleteHealthCheckOpt)
+	if err != nil {
+		return diag.Errorf("error deleting HealthCheck: %s", err)
+	}
+
+	err = waitForGAResourceDeleted(ctx, deleteHealthCheckClient, deleteHealthCheckPath, "health_check",
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("error waiting for the Delete of HealthCheck (%s) to complete: %s", d.Id(), err)
+	}
+	return nil
+}
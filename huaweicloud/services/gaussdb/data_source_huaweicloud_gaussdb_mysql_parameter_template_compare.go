@@ -0,0 +1,132 @@
+package gaussdb
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceGaussDBMysqlTemplateCompare() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceGaussDBMysqlTemplateCompareRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"source_configuration_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the ID of the source parameter template.`,
+			},
+			"target_configuration_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the ID of the target parameter template.`,
+			},
+			"differences": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Indicates the differences between the two parameter templates.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"parameter_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the parameter name.`,
+						},
+						"source_value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the parameter value in the source parameter template.`,
+						},
+						"target_value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: `Indicates the parameter value in the target parameter template.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceGaussDBMysqlTemplateCompareRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	var mErr *multierror.Error
+
+	// compareTemplates: Compare the two GaussDB MySQL parameter templates.
+	var (
+		compareTemplatesHttpUrl = "v3/{project_id}/configurations/comparison"
+		compareTemplatesProduct = "gaussdb"
+	)
+	compareTemplatesClient, err := cfg.NewServiceClient(compareTemplatesProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB Client: %s", err)
+	}
+
+	compareTemplatesPath := compareTemplatesClient.Endpoint + compareTemplatesHttpUrl
+	compareTemplatesPath = strings.ReplaceAll(compareTemplatesPath, "{project_id}", compareTemplatesClient.ProjectID)
+
+	compareTemplatesOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+		JSONBody: map[string]interface{}{
+			"source_configuration_id": d.Get("source_configuration_id"),
+			"target_configuration_id": d.Get("target_configuration_id"),
+		},
+	}
+	compareTemplatesResp, err := compareTemplatesClient.Request("POST", compareTemplatesPath, &compareTemplatesOpt)
+	if err != nil {
+		return diag.Errorf("error comparing GaussDB MySQL parameter templates: %s", err)
+	}
+
+	compareTemplatesRespBody, err := utils.FlattenResponse(compareTemplatesResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	uuid, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(uuid)
+
+	mErr = multierror.Append(
+		mErr,
+		d.Set("region", region),
+		d.Set("differences", flattenGaussDBMysqlTemplateDifferences(compareTemplatesRespBody)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenGaussDBMysqlTemplateDifferences(resp interface{}) []interface{} {
+	curJson := utils.PathSearch("differences", resp, make([]interface{}, 0))
+	curArray := curJson.([]interface{})
+	rst := make([]interface{}, 0, len(curArray))
+	for _, v := range curArray {
+		rst = append(rst, map[string]interface{}{
+			"parameter_name": utils.PathSearch("parameter_name", v, nil),
+			"source_value":   utils.PathSearch("source_value", v, nil),
+			"target_value":   utils.PathSearch("target_value", v, nil),
+		})
+	}
+	return rst
+}
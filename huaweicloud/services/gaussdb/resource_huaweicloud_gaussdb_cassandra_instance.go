@@ -179,6 +179,7 @@ func ResourceGeminiDBInstanceV3() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"restore": geminiDBRestoreSchema(),
 
 			"private_ips": {
 				Type:     schema.TypeList,
@@ -280,6 +281,68 @@ func resourceGaussDBCassandraInstanceCreate(d *schema.ResourceData, meta interfa
 	return resourceGeminiDBInstanceV3Create(d, meta, defaults)
 }
 
+func geminiDBRestoreSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"backup_id": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+// geminiDBCreateOpts is used to create a new instance, or restore a backup to a new instance.
+type geminiDBCreateOpts struct {
+	instances.CreateGeminiDBOpts
+	RestoreInfo *geminiDBRestoreInfo `json:"restore_info,omitempty"`
+}
+
+type geminiDBRestoreInfo struct {
+	BackupId string `json:"backup_id" required:"true"`
+}
+
+func (opts geminiDBCreateOpts) ToInstancesCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+func buildGeminiDBCreateOpts(d *schema.ResourceData, createOpts instances.CreateGeminiDBOpts) geminiDBCreateOpts {
+	opts := geminiDBCreateOpts{
+		CreateGeminiDBOpts: createOpts,
+	}
+	if backupId, ok := d.GetOk("restore.0.backup_id"); ok {
+		opts.RestoreInfo = &geminiDBRestoreInfo{
+			BackupId: backupId.(string),
+		}
+	}
+	return opts
+}
+
+func updateGeminiDBBackupStrategy(client *golangsdk.ServiceClient, d *schema.ResourceData) error {
+	var updateOpts backups.UpdateOpts
+	backupRaw := d.Get("backup_strategy").([]interface{})
+	rawMap := backupRaw[0].(map[string]interface{})
+	keepDays := rawMap["keep_days"].(int)
+	updateOpts.KeepDays = &keepDays
+	updateOpts.StartTime = rawMap["start_time"].(string)
+	// Fixed to "1,2,3,4,5,6,7"
+	updateOpts.Period = "1,2,3,4,5,6,7"
+	logp.Printf("[DEBUG] Update backup_strategy: %#v", updateOpts)
+
+	err := backups.Update(client, d.Id(), updateOpts).ExtractErr()
+	if err != nil {
+		return fmtp.Errorf("Error updating backup_strategy: %s", err)
+	}
+	return nil
+}
+
 func resourceGeminiDBDataStore(d *schema.ResourceData, defaults defaultValues) instances.DataStore {
 	var db instances.DataStore
 
@@ -429,7 +492,7 @@ func resourceGeminiDBInstanceV3Create(d *schema.ResourceData, meta interface{},
 	// Add password here so it wouldn't go in the above log entry
 	createOpts.Password = d.Get("password").(string)
 
-	instance, err := instances.Create(client, createOpts).Extract()
+	instance, err := instances.Create(client, buildGeminiDBCreateOpts(d, createOpts)).Extract()
 	if err != nil {
 		return fmtp.Errorf("Error creating GeminiDB instance : %s", err)
 	}
@@ -1029,19 +1092,8 @@ func resourceGeminiDBInstanceV3Update(d *schema.ResourceData, meta interface{},
 	}
 
 	if d.HasChange("backup_strategy") {
-		var updateOpts backups.UpdateOpts
-		backupRaw := d.Get("backup_strategy").([]interface{})
-		rawMap := backupRaw[0].(map[string]interface{})
-		keepDays := rawMap["keep_days"].(int)
-		updateOpts.KeepDays = &keepDays
-		updateOpts.StartTime = rawMap["start_time"].(string)
-		// Fixed to "1,2,3,4,5,6,7"
-		updateOpts.Period = "1,2,3,4,5,6,7"
-		logp.Printf("[DEBUG] Update backup_strategy: %#v", updateOpts)
-
-		err = backups.Update(client, d.Id(), updateOpts).ExtractErr()
-		if err != nil {
-			return fmtp.Errorf("Error updating backup_strategy: %s", err)
+		if err = updateGeminiDBBackupStrategy(client, d); err != nil {
+			return err
 		}
 	}
 
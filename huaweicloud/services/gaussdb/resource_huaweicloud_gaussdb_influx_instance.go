@@ -157,6 +157,7 @@ func ResourceGaussDBInfluxInstanceV3() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"restore": geminiDBRestoreSchema(),
 
 			"private_ips": {
 				Type:     schema.TypeList,
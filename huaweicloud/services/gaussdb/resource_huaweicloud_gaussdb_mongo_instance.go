@@ -158,6 +158,7 @@ func ResourceGaussDBMongoInstanceV3() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"restore": geminiDBRestoreSchema(),
 
 			"private_ips": {
 				Type:     schema.TypeList,
@@ -0,0 +1,248 @@
+package gaussdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceGaussDBMysqlAccount is the impl for huaweicloud_gaussdb_mysql_account resource.
+// The resource ID is in the format of <instance_id>/<name>/<host>.
+func ResourceGaussDBMysqlAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGaussDBMysqlAccountCreate,
+		ReadContext:   resourceGaussDBMysqlAccountRead,
+		UpdateContext: resourceGaussDBMysqlAccountUpdate,
+		DeleteContext: resourceGaussDBMysqlAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGaussDBMysqlAccountImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the GaussDB MySQL instance.`,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  `Specifies the database account name.`,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: `Specifies the password of the database account.`,
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "%",
+				Description: `Specifies the IP address that is allowed to access the database.`,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  `Specifies the description of the database account.`,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+		},
+	}
+}
+
+func resourceGaussDBMysqlAccountCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("gaussdb", region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceID)
+	defer config.MutexKV.Unlock(instanceID)
+
+	name := d.Get("name").(string)
+	host := d.Get("host").(string)
+	params := map[string]interface{}{
+		"users": []map[string]interface{}{
+			{
+				"name":     name,
+				"host":     host,
+				"password": d.Get("password"),
+				"comment":  utils.ValueIngoreEmpty(d.Get("description")),
+			},
+		},
+	}
+	_, err = requestGaussDBApi(client, "POST", buildGaussDBInstancePath(client, instanceID, "db-users"),
+		params, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error creating GaussDB MySQL account: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceID, name, host))
+
+	return resourceGaussDBMysqlAccountRead(ctx, d, meta)
+}
+
+func resourceGaussDBMysqlAccountRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("gaussdb", region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	host := d.Get("host").(string)
+	account, err := getGaussDBMysqlAccount(client, instanceID, name, host)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving GaussDB MySQL account")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("description", utils.PathSearch("comment", account, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func getGaussDBMysqlAccount(client *golangsdk.ServiceClient, instanceID, name, host string) (interface{}, error) {
+	listPath := buildGaussDBInstancePath(client, instanceID, "db-users?limit=100")
+	offset := 0
+	for {
+		respBody, err := requestGaussDBApi(client, "GET", fmt.Sprintf("%s&offset=%d", listPath, offset), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		users := utils.PathSearch("users", respBody, make([]interface{}, 0)).([]interface{})
+		user := utils.PathSearch(fmt.Sprintf("[?name=='%s' && host=='%s'] | [0]", name, host), users, nil)
+		if user != nil {
+			return user, nil
+		}
+		if len(users) < 100 {
+			return nil, golangsdk.ErrDefault404{}
+		}
+		offset += len(users)
+	}
+}
+
+func resourceGaussDBMysqlAccountUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("gaussdb", region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceID)
+	defer config.MutexKV.Unlock(instanceID)
+
+	user := map[string]interface{}{
+		"name": d.Get("name"),
+		"host": d.Get("host"),
+	}
+	if d.HasChange("password") {
+		user["password"] = d.Get("password")
+		params := map[string]interface{}{
+			"users": []map[string]interface{}{user},
+		}
+		_, err = requestGaussDBApi(client, "PUT", buildGaussDBInstancePath(client, instanceID,
+			"db-users/password"), params, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("error updating password of GaussDB MySQL account (%s): %s", d.Id(), err)
+		}
+		delete(user, "password")
+	}
+
+	if d.HasChange("description") {
+		user["comment"] = d.Get("description")
+		params := map[string]interface{}{
+			"users": []map[string]interface{}{user},
+		}
+		_, err = requestGaussDBApi(client, "PUT", buildGaussDBInstancePath(client, instanceID,
+			"db-users/comment"), params, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("error updating description of GaussDB MySQL account (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceGaussDBMysqlAccountRead(ctx, d, meta)
+}
+
+func resourceGaussDBMysqlAccountDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("gaussdb", region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceID)
+	defer config.MutexKV.Unlock(instanceID)
+
+	params := map[string]interface{}{
+		"users": []map[string]interface{}{
+			{
+				"name": d.Get("name"),
+				"host": d.Get("host"),
+			},
+		},
+	}
+	_, err = requestGaussDBApi(client, "DELETE", buildGaussDBInstancePath(client, instanceID, "db-users"),
+		params, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting GaussDB MySQL account")
+	}
+
+	return nil
+}
+
+func resourceGaussDBMysqlAccountImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseGaussDBSubResourceID(d.Id(), "<instance_id>/<name>/<host>", 3)
+	if err != nil {
+		return nil, err
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", parts[0]),
+		d.Set("name", parts[1]),
+		d.Set("host", parts[2]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
@@ -0,0 +1,262 @@
+package gaussdb
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/chnsz/golangsdk/openstack/taurusdb/v3/instances"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceGaussDBMysqlDatabase is the impl for huaweicloud_gaussdb_mysql_database resource.
+// The resource ID is in the format of <instance_id>/<name>.
+func ResourceGaussDBMysqlDatabase() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGaussDBMysqlDatabaseCreate,
+		ReadContext:   resourceGaussDBMysqlDatabaseRead,
+		UpdateContext: resourceGaussDBMysqlDatabaseUpdate,
+		DeleteContext: resourceGaussDBMysqlDatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the GaussDB MySQL instance.`,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the database name.`,
+				ValidateFunc: validation.All(
+					validation.StringMatch(regexp.MustCompile(`^[\w-$]+$`),
+						"the name can only consist of letters, digits, hyphens (-), underscores (_) and dollar signs ($)"),
+					validation.StringLenBetween(1, 64),
+				),
+			},
+			"character_set": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the character set of the database.`,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  `Specifies the database description.`,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+		},
+	}
+}
+
+// requestGaussDBApi sends the request to the v3 API of GaussDB and waits for the job to complete if there is one, the
+// response body is returned.
+func requestGaussDBApi(client *golangsdk.ServiceClient, method, path string, params map[string]interface{},
+	timeout time.Duration) (interface{}, error) {
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 202, 204,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	}
+	if params != nil {
+		opt.JSONBody = params
+	}
+	resp, err := client.Request(method, path, &opt)
+	if err != nil {
+		return nil, err
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	if jobID := utils.PathSearch("job_id", respBody, "").(string); jobID != "" {
+		if err = instances.WaitForJobSuccess(client, int(timeout/time.Second), jobID); err != nil {
+			return nil, err
+		}
+	}
+	return respBody, nil
+}
+
+func buildGaussDBInstancePath(client *golangsdk.ServiceClient, instanceID, subPath string) string {
+	path := client.Endpoint + "v3/{project_id}/instances/{instance_id}/" + subPath
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	return strings.ReplaceAll(path, "{instance_id}", instanceID)
+}
+
+func resourceGaussDBMysqlDatabaseCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("gaussdb", region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceID)
+	defer config.MutexKV.Unlock(instanceID)
+
+	name := d.Get("name").(string)
+	params := map[string]interface{}{
+		"databases": []map[string]interface{}{
+			{
+				"name":          name,
+				"character_set": d.Get("character_set"),
+				"comment":       utils.ValueIngoreEmpty(d.Get("description")),
+			},
+		},
+	}
+	_, err = requestGaussDBApi(client, "POST", buildGaussDBInstancePath(client, instanceID, "databases"),
+		params, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error creating GaussDB MySQL database: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, name))
+
+	return resourceGaussDBMysqlDatabaseRead(ctx, d, meta)
+}
+
+func parseGaussDBSubResourceID(id, format string, num int) ([]string, error) {
+	parts := strings.SplitN(id, "/", num)
+	if len(parts) != num {
+		return nil, fmt.Errorf("invalid id format, must be %s", format)
+	}
+	return parts, nil
+}
+
+func resourceGaussDBMysqlDatabaseRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("gaussdb", region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB Client: %s", err)
+	}
+
+	parts, err := parseGaussDBSubResourceID(d.Id(), "<instance_id>/<name>", 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceID, name := parts[0], parts[1]
+
+	database, err := getGaussDBMysqlDatabase(client, instanceID, name)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving GaussDB MySQL database")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", instanceID),
+		d.Set("name", name),
+		d.Set("character_set", utils.PathSearch("charset", database, nil)),
+		d.Set("description", utils.PathSearch("comment", database, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func getGaussDBMysqlDatabase(client *golangsdk.ServiceClient, instanceID, name string) (interface{}, error) {
+	listPath := buildGaussDBInstancePath(client, instanceID, "databases?limit=100")
+	offset := 0
+	for {
+		respBody, err := requestGaussDBApi(client, "GET", fmt.Sprintf("%s&offset=%d", listPath, offset), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		databases := utils.PathSearch("databases", respBody, make([]interface{}, 0)).([]interface{})
+		database := utils.PathSearch(fmt.Sprintf("[?name=='%s'] | [0]", name), databases, nil)
+		if database != nil {
+			return database, nil
+		}
+		if len(databases) < 100 {
+			return nil, golangsdk.ErrDefault404{}
+		}
+		offset += len(databases)
+	}
+}
+
+func resourceGaussDBMysqlDatabaseUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("gaussdb", region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceID)
+	defer config.MutexKV.Unlock(instanceID)
+
+	params := map[string]interface{}{
+		"database_list": []map[string]interface{}{
+			{
+				"name":    d.Get("name"),
+				"comment": d.Get("description"),
+			},
+		},
+	}
+	_, err = requestGaussDBApi(client, "PUT", buildGaussDBInstancePath(client, instanceID, "databases/comment"),
+		params, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("error updating GaussDB MySQL database (%s): %s", d.Id(), err)
+	}
+
+	return resourceGaussDBMysqlDatabaseRead(ctx, d, meta)
+}
+
+func resourceGaussDBMysqlDatabaseDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("gaussdb", region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceID)
+	defer config.MutexKV.Unlock(instanceID)
+
+	params := map[string]interface{}{
+		"databases": []string{d.Get("name").(string)},
+	}
+	_, err = requestGaussDBApi(client, "DELETE", buildGaussDBInstancePath(client, instanceID, "databases"),
+		params, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting GaussDB MySQL database")
+	}
+
+	return nil
+}
@@ -90,13 +90,11 @@ func ResourceGaussDBInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
 			"configuration_name": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
 			"enterprise_project_id": {
 				Type:     schema.TypeString,
@@ -307,10 +305,91 @@ func ResourceGaussDBInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"sql_filter_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"restore": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"backup_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"restore.0.restore_time"},
+						},
+						"restore_time": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// gaussDBCreateOpts is used to create a new instance, or restore the data of an existing instance to a new one.
+type gaussDBCreateOpts struct {
+	instances.CreateTaurusDBOpts
+	RestorePoint *gaussDBRestorePoint `json:"restore_point,omitempty"`
+}
+
+type gaussDBRestorePoint struct {
+	InstanceId  string `json:"instance_id" required:"true"`
+	Type        string `json:"type" required:"true"`
+	BackupId    string `json:"backup_id,omitempty"`
+	RestoreTime int    `json:"restore_time,omitempty"`
+}
+
+func (opts gaussDBCreateOpts) ToInstancesCreateMap() (map[string]interface{}, error) {
+	return golangsdk.BuildRequestBody(opts, "")
+}
+
+func resourceGaussDBRestorePoint(d *schema.ResourceData) *gaussDBRestorePoint {
+	restoreRaw := d.Get("restore").([]interface{})
+	if len(restoreRaw) == 0 {
+		return nil
+	}
+
+	restore := restoreRaw[0].(map[string]interface{})
+	restorePoint := gaussDBRestorePoint{
+		InstanceId: restore["instance_id"].(string),
+	}
+	if backupId := restore["backup_id"].(string); backupId != "" {
+		restorePoint.Type = "backup"
+		restorePoint.BackupId = backupId
+	} else {
+		restorePoint.Type = "timestamp"
+		restorePoint.RestoreTime = restore["restore_time"].(int)
+	}
+	return &restorePoint
+}
+
+func getGaussDBConfigurationIdByName(client *golangsdk.ServiceClient, name string) (string, error) {
+	configsList, err := configurations.List(client).Extract()
+	if err != nil {
+		return "", fmtp.Errorf("Unable to retrieve configurations: %s", err)
+	}
+	for _, conf := range configsList {
+		if conf.Name == name {
+			return conf.ID, nil
+		}
+	}
+	return "", fmtp.Errorf("Unable to find configuration named %s", name)
+}
+
 func resourceGaussDBDataStore(d *schema.ResourceData) instances.DataStoreOpt {
 	var db instances.DataStoreOpt
 
@@ -413,19 +492,9 @@ func resourceGaussDBInstanceCreate(d *schema.ResourceData, meta interface{}) err
 
 	// configuration
 	if d.Get("configuration_id") == "" && d.Get("configuration_name") != "" {
-		configsList, err := configurations.List(client).Extract()
+		createOpts.ConfigurationId, err = getGaussDBConfigurationIdByName(client, d.Get("configuration_name").(string))
 		if err != nil {
-			return fmtp.Errorf("Unable to retrieve configurations: %s", err)
-		}
-		confName := d.Get("configuration_name").(string)
-		for _, conf := range configsList {
-			if conf.Name == confName {
-				createOpts.ConfigurationId = conf.ID
-				break
-			}
-		}
-		if createOpts.ConfigurationId == "" {
-			return fmtp.Errorf("Unable to find configuration named %s", confName)
+			return err
 		}
 	}
 
@@ -472,7 +541,11 @@ func resourceGaussDBInstanceCreate(d *schema.ResourceData, meta interface{}) err
 	// Add password here so it wouldn't go in the above log entry
 	createOpts.Password = d.Get("password").(string)
 
-	instance, err := instances.Create(client, createOpts).Extract()
+	opts := gaussDBCreateOpts{
+		CreateTaurusDBOpts: createOpts,
+		RestorePoint:       resourceGaussDBRestorePoint(d),
+	}
+	instance, err := instances.Create(client, opts).Extract()
 	if err != nil {
 		return fmtp.Errorf("error creating GaussDB instance : %s", err)
 	}
@@ -526,6 +599,13 @@ func resourceGaussDBInstanceCreate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	if v, ok := d.GetOk("sql_filter_enabled"); ok {
+		err = switchSqlFilter(client, id, v.(bool), d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+	}
+
 	if common.HasFilledOpt(d, "backup_strategy") {
 		var updateOpts backups.UpdateOpts
 		backupRaw := d.Get("backup_strategy").([]interface{})
@@ -729,6 +809,15 @@ func resourceGaussDBInstanceRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("audit_log_enabled", status)
 	}
 
+	// set sql filter status
+	sqlFilterPath := buildGaussDBInstancePath(client, instanceID, "sql-filter/switch")
+	sqlFilterResp, err := requestGaussDBApi(client, "GET", sqlFilterPath, nil, 0)
+	if err != nil {
+		logp.Printf("[DEBUG] query Instance %s sql filter status failed: %s", instanceID, err)
+	} else {
+		d.Set("sql_filter_enabled", utils.PathSearch("switch_status", sqlFilterResp, "") == "ON")
+	}
+
 	// save tags
 	if resourceTags, err := tags.Get(client, "instances", d.Id()).Extract(); err == nil {
 		tagmap := utils.TagsToMap(resourceTags.Tags)
@@ -1026,6 +1115,27 @@ func resourceGaussDBInstanceUpdate(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	if d.HasChange("sql_filter_enabled") {
+		err = switchSqlFilter(client, instanceId, d.Get("sql_filter_enabled").(bool), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChanges("configuration_id", "configuration_name") {
+		configurationId := d.Get("configuration_id").(string)
+		if !d.HasChange("configuration_id") {
+			configurationId, err = getGaussDBConfigurationIdByName(client, d.Get("configuration_name").(string))
+			if err != nil {
+				return err
+			}
+		}
+		err = applyGaussDBConfiguration(client, instanceId, configurationId, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
 	// update tags
 	if d.HasChange("tags") {
 		tagErr := utils.UpdateResourceTags(client, d, "instances", d.Id())
@@ -1107,3 +1217,38 @@ func switchAuditLog(client *golangsdk.ServiceClient, instanceId string, v bool)
 
 	return nil
 }
+
+func switchSqlFilter(client *golangsdk.ServiceClient, instanceId string, v bool, timeout time.Duration) error {
+	flag := "OFF"
+	if v {
+		flag = "ON"
+	}
+	params := map[string]interface{}{
+		"switch_status": flag,
+	}
+
+	_, err := requestGaussDBApi(client, "PUT", buildGaussDBInstancePath(client, instanceId, "sql-filter/switch"),
+		params, timeout)
+	if err != nil {
+		return fmtp.Errorf("switch sql filter to %q failed: %s", flag, err)
+	}
+
+	return nil
+}
+
+func applyGaussDBConfiguration(client *golangsdk.ServiceClient, instanceId, configurationId string,
+	timeout time.Duration) error {
+	applyPath := client.Endpoint + "v3/{project_id}/configurations/{configuration_id}/apply"
+	applyPath = strings.ReplaceAll(applyPath, "{project_id}", client.ProjectID)
+	applyPath = strings.ReplaceAll(applyPath, "{configuration_id}", configurationId)
+	params := map[string]interface{}{
+		"instance_ids": []string{instanceId},
+	}
+
+	_, err := requestGaussDBApi(client, "PUT", applyPath, params, timeout)
+	if err != nil {
+		return fmtp.Errorf("error applying configuration (%s) to instance %s: %s", configurationId, instanceId, err)
+	}
+
+	return nil
+}
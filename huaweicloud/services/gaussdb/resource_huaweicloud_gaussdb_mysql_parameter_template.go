@@ -0,0 +1,286 @@
+package gaussdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceGaussDBMysqlTemplate is the impl for huaweicloud_gaussdb_mysql_parameter_template resource.
+// The template is applied to the instances through the configuration_id of huaweicloud_gaussdb_mysql_instance.
+func ResourceGaussDBMysqlTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGaussDBMysqlTemplateCreate,
+		ReadContext:   resourceGaussDBMysqlTemplateRead,
+		UpdateContext: resourceGaussDBMysqlTemplateUpdate,
+		DeleteContext: resourceGaussDBMysqlTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the parameter template name.`,
+			},
+			"datastore_engine": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "gaussdb-mysql",
+				Description: `Specifies the database engine.`,
+			},
+			"datastore_version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "8.0",
+				Description: `Specifies the database version.`,
+			},
+			"parameter_values": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: `Specifies the mapping between parameter names and parameter values.`,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the parameter template description.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the creation time of the parameter template.`,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the update time of the parameter template.`,
+			},
+		},
+	}
+}
+
+func resourceGaussDBMysqlTemplateCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// createGaussDBMysqlTemplate: create a GaussDB MySQL parameter template.
+	var (
+		createGaussDBMysqlTemplateHttpUrl = "v3/{project_id}/configurations"
+		createGaussDBMysqlTemplateProduct = "gaussdb"
+	)
+	createGaussDBMysqlTemplateClient, err := cfg.NewServiceClient(createGaussDBMysqlTemplateProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB Client: %s", err)
+	}
+
+	createGaussDBMysqlTemplatePath := createGaussDBMysqlTemplateClient.Endpoint + createGaussDBMysqlTemplateHttpUrl
+	createGaussDBMysqlTemplatePath = strings.ReplaceAll(createGaussDBMysqlTemplatePath, "{project_id}",
+		createGaussDBMysqlTemplateClient.ProjectID)
+
+	createGaussDBMysqlTemplateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+		JSONBody:    utils.RemoveNil(buildCreateGaussDBMysqlTemplateBodyParams(d)),
+	}
+	createGaussDBMysqlTemplateResp, err := createGaussDBMysqlTemplateClient.Request("POST",
+		createGaussDBMysqlTemplatePath, &createGaussDBMysqlTemplateOpt)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB MySQL parameter template: %s", err)
+	}
+
+	createGaussDBMysqlTemplateRespBody, err := utils.FlattenResponse(createGaussDBMysqlTemplateResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := utils.PathSearch("configurations.id", createGaussDBMysqlTemplateRespBody, "").(string)
+	if id == "" {
+		return diag.Errorf("unable to find the GaussDB MySQL parameter template ID from the API response")
+	}
+	d.SetId(id)
+
+	return resourceGaussDBMysqlTemplateRead(ctx, d, meta)
+}
+
+func buildCreateGaussDBMysqlTemplateBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"name":             d.Get("name"),
+		"description":      utils.ValueIngoreEmpty(d.Get("description")),
+		"parameter_values": utils.ValueIngoreEmpty(d.Get("parameter_values")),
+		"datastore": map[string]interface{}{
+			"type":    d.Get("datastore_engine"),
+			"version": d.Get("datastore_version"),
+		},
+	}
+}
+
+func resourceGaussDBMysqlTemplateRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	var mErr *multierror.Error
+
+	// getGaussDBMysqlTemplate: Query the GaussDB MySQL parameter template.
+	var (
+		getGaussDBMysqlTemplateHttpUrl = "v3/{project_id}/configurations/{configuration_id}"
+		getGaussDBMysqlTemplateProduct = "gaussdb"
+	)
+	getGaussDBMysqlTemplateClient, err := cfg.NewServiceClient(getGaussDBMysqlTemplateProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB Client: %s", err)
+	}
+
+	getGaussDBMysqlTemplatePath := getGaussDBMysqlTemplateClient.Endpoint + getGaussDBMysqlTemplateHttpUrl
+	getGaussDBMysqlTemplatePath = strings.ReplaceAll(getGaussDBMysqlTemplatePath, "{project_id}",
+		getGaussDBMysqlTemplateClient.ProjectID)
+	getGaussDBMysqlTemplatePath = strings.ReplaceAll(getGaussDBMysqlTemplatePath, "{configuration_id}", d.Id())
+
+	getGaussDBMysqlTemplateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	}
+	getGaussDBMysqlTemplateResp, err := getGaussDBMysqlTemplateClient.Request("GET", getGaussDBMysqlTemplatePath,
+		&getGaussDBMysqlTemplateOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving GaussDB MySQL parameter template")
+	}
+
+	getGaussDBMysqlTemplateRespBody, err := utils.FlattenResponse(getGaussDBMysqlTemplateResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr = multierror.Append(
+		mErr,
+		d.Set("region", region),
+		d.Set("name", utils.PathSearch("name", getGaussDBMysqlTemplateRespBody, nil)),
+		d.Set("description", utils.PathSearch("description", getGaussDBMysqlTemplateRespBody, nil)),
+		d.Set("datastore_version", utils.PathSearch("datastore_version_name", getGaussDBMysqlTemplateRespBody, nil)),
+		d.Set("parameter_values", flattenGaussDBMysqlTemplateValues(d, getGaussDBMysqlTemplateRespBody)),
+		d.Set("created_at", utils.PathSearch("created", getGaussDBMysqlTemplateRespBody, nil)),
+		d.Set("updated_at", utils.PathSearch("updated", getGaussDBMysqlTemplateRespBody, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+// flattenGaussDBMysqlTemplateValues only returns the values of the parameters which are specified.
+func flattenGaussDBMysqlTemplateValues(d *schema.ResourceData, resp interface{}) map[string]interface{} {
+	rst := make(map[string]interface{})
+	for name := range d.Get("parameter_values").(map[string]interface{}) {
+		expression := fmt.Sprintf("configuration_parameters[?name=='%s'].value | [0]", name)
+		if value := utils.PathSearch(expression, resp, nil); value != nil {
+			rst[name] = value
+		}
+	}
+	return rst
+}
+
+func resourceGaussDBMysqlTemplateUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// updateGaussDBMysqlTemplate: update the GaussDB MySQL parameter template.
+	var (
+		updateGaussDBMysqlTemplateHttpUrl = "v3/{project_id}/configurations/{configuration_id}"
+		updateGaussDBMysqlTemplateProduct = "gaussdb"
+	)
+	updateGaussDBMysqlTemplateClient, err := cfg.NewServiceClient(updateGaussDBMysqlTemplateProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB Client: %s", err)
+	}
+
+	updateGaussDBMysqlTemplatePath := updateGaussDBMysqlTemplateClient.Endpoint + updateGaussDBMysqlTemplateHttpUrl
+	updateGaussDBMysqlTemplatePath = strings.ReplaceAll(updateGaussDBMysqlTemplatePath, "{project_id}",
+		updateGaussDBMysqlTemplateClient.ProjectID)
+	updateGaussDBMysqlTemplatePath = strings.ReplaceAll(updateGaussDBMysqlTemplatePath, "{configuration_id}", d.Id())
+
+	updateGaussDBMysqlTemplateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+		JSONBody:    buildUpdateGaussDBMysqlTemplateBodyParams(d),
+	}
+	_, err = updateGaussDBMysqlTemplateClient.Request("PUT", updateGaussDBMysqlTemplatePath,
+		&updateGaussDBMysqlTemplateOpt)
+	if err != nil {
+		return diag.Errorf("error updating GaussDB MySQL parameter template: %s", err)
+	}
+
+	return resourceGaussDBMysqlTemplateRead(ctx, d, meta)
+}
+
+func buildUpdateGaussDBMysqlTemplateBodyParams(d *schema.ResourceData) map[string]interface{} {
+	bodyParams := map[string]interface{}{
+		"name":        d.Get("name"),
+		"description": d.Get("description"),
+	}
+	if d.HasChange("parameter_values") {
+		bodyParams["parameter_values"] = d.Get("parameter_values")
+	}
+	return bodyParams
+}
+
+func resourceGaussDBMysqlTemplateDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	// deleteGaussDBMysqlTemplate: delete the GaussDB MySQL parameter template.
+	var (
+		deleteGaussDBMysqlTemplateHttpUrl = "v3/{project_id}/configurations/{configuration_id}"
+		deleteGaussDBMysqlTemplateProduct = "gaussdb"
+	)
+	deleteGaussDBMysqlTemplateClient, err := cfg.NewServiceClient(deleteGaussDBMysqlTemplateProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB Client: %s", err)
+	}
+
+	deleteGaussDBMysqlTemplatePath := deleteGaussDBMysqlTemplateClient.Endpoint + deleteGaussDBMysqlTemplateHttpUrl
+	deleteGaussDBMysqlTemplatePath = strings.ReplaceAll(deleteGaussDBMysqlTemplatePath, "{project_id}",
+		deleteGaussDBMysqlTemplateClient.ProjectID)
+	deleteGaussDBMysqlTemplatePath = strings.ReplaceAll(deleteGaussDBMysqlTemplatePath, "{configuration_id}", d.Id())
+
+	deleteGaussDBMysqlTemplateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	}
+	_, err = deleteGaussDBMysqlTemplateClient.Request("DELETE", deleteGaussDBMysqlTemplatePath,
+		&deleteGaussDBMysqlTemplateOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting GaussDB MySQL parameter template")
+	}
+
+	return nil
+}
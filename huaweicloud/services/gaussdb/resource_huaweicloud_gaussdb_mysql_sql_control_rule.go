@@ -0,0 +1,228 @@
+package gaussdb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceGaussDBMysqlSqlControlRule is the impl for huaweicloud_gaussdb_mysql_sql_control_rule resource.
+// The resource ID is in the format of <instance_id>/<node_id>/<sql_type>/<pattern>.
+func ResourceGaussDBMysqlSqlControlRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGaussDBMysqlSqlControlRuleCreate,
+		ReadContext:   resourceGaussDBMysqlSqlControlRuleRead,
+		UpdateContext: resourceGaussDBMysqlSqlControlRuleUpdate,
+		DeleteContext: resourceGaussDBMysqlSqlControlRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceGaussDBMysqlSqlControlRuleImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the GaussDB MySQL instance.`,
+			},
+			"node_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the node on which the rule takes effect.`,
+			},
+			"sql_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"SELECT", "UPDATE", "DELETE",
+				}, false),
+				Description: `Specifies the SQL statement type.`,
+			},
+			"pattern": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the SQL statement concurrency control rule.`,
+			},
+			"max_concurrency": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 2147483647),
+				Description:  `Specifies the maximum number of concurrent SQL statements.`,
+			},
+		},
+	}
+}
+
+func resourceGaussDBMysqlSqlControlRuleCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("gaussdb", region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceID)
+	defer config.MutexKV.Unlock(instanceID)
+
+	err = setGaussDBMysqlSqlControlRule(client, d, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error creating GaussDB MySQL SQL control rule: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s", instanceID, d.Get("node_id"), d.Get("sql_type"), d.Get("pattern")))
+
+	return resourceGaussDBMysqlSqlControlRuleRead(ctx, d, meta)
+}
+
+func setGaussDBMysqlSqlControlRule(client *golangsdk.ServiceClient, d *schema.ResourceData,
+	timeout time.Duration) error {
+	params := map[string]interface{}{
+		"node_id": d.Get("node_id"),
+		"sql_filter_rules": []map[string]interface{}{
+			{
+				"sql_type": d.Get("sql_type"),
+				"patterns": []map[string]interface{}{
+					{
+						"pattern":         d.Get("pattern"),
+						"max_concurrency": d.Get("max_concurrency"),
+					},
+				},
+			},
+		},
+	}
+	path := buildGaussDBInstancePath(client, d.Get("instance_id").(string), "sql-filter/rules")
+	_, err := requestGaussDBApi(client, "PUT", path, params, timeout)
+	return err
+}
+
+func resourceGaussDBMysqlSqlControlRuleRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("gaussdb", region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	nodeID := d.Get("node_id").(string)
+	sqlType := d.Get("sql_type").(string)
+	pattern := d.Get("pattern").(string)
+
+	getPath := buildGaussDBInstancePath(client, instanceID, "sql-filter/rules")
+	getPath += fmt.Sprintf("?node_id=%s&sql_type=%s", nodeID, sqlType)
+	getRespBody, err := requestGaussDBApi(client, "GET", getPath, nil, 0)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving GaussDB MySQL SQL control rule")
+	}
+
+	searchPath := fmt.Sprintf("sql_filter_rules[?sql_type=='%s']|[0].patterns[?pattern=='%s']|[0]", sqlType,
+		strings.ReplaceAll(pattern, "'", "\\'"))
+	rule := utils.PathSearch(searchPath, getRespBody, nil)
+	if rule == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving GaussDB MySQL SQL control rule")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("max_concurrency", utils.PathSearch("max_concurrency", rule, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceGaussDBMysqlSqlControlRuleUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("gaussdb", region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceID)
+	defer config.MutexKV.Unlock(instanceID)
+
+	err = setGaussDBMysqlSqlControlRule(client, d, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return diag.Errorf("error updating GaussDB MySQL SQL control rule (%s): %s", d.Id(), err)
+	}
+
+	return resourceGaussDBMysqlSqlControlRuleRead(ctx, d, meta)
+}
+
+func resourceGaussDBMysqlSqlControlRuleDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("gaussdb", region)
+	if err != nil {
+		return diag.Errorf("error creating GaussDB Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceID)
+	defer config.MutexKV.Unlock(instanceID)
+
+	params := map[string]interface{}{
+		"node_id": d.Get("node_id"),
+		"sql_filter_rules": []map[string]interface{}{
+			{
+				"sql_type": d.Get("sql_type"),
+				"patterns": []string{d.Get("pattern").(string)},
+			},
+		},
+	}
+	_, err = requestGaussDBApi(client, "DELETE", buildGaussDBInstancePath(client, instanceID,
+		"sql-filter/rules"), params, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting GaussDB MySQL SQL control rule")
+	}
+
+	return nil
+}
+
+func resourceGaussDBMysqlSqlControlRuleImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts, err := parseGaussDBSubResourceID(d.Id(), "<instance_id>/<node_id>/<sql_type>/<pattern>", 4)
+	if err != nil {
+		return nil, err
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", parts[0]),
+		d.Set("node_id", parts[1]),
+		d.Set("sql_type", parts[2]),
+		d.Set("pattern", parts[3]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
@@ -0,0 +1,246 @@
+package gaussdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceGaussDBNoSQLAccount is the impl for huaweicloud_gaussdb_nosql_account resource, which manages the database
+// accounts of the GeminiDB (Cassandra, Mongo, Influx and Redis) instances.
+// The resource ID is in the format of <instance_id>/<name>.
+func ResourceGaussDBNoSQLAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGaussDBNoSQLAccountCreate,
+		ReadContext:   resourceGaussDBNoSQLAccountRead,
+		UpdateContext: resourceGaussDBNoSQLAccountUpdate,
+		DeleteContext: resourceGaussDBNoSQLAccountDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the GeminiDB instance.`,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 32),
+				Description:  `Specifies the name of the database account.`,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: `Specifies the password of the database account.`,
+			},
+			"roles": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the name of the role.`,
+						},
+						"db_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: `Specifies the name of the database (or keyspace) on which the role takes effect.`,
+						},
+					},
+				},
+				Description: `Specifies the roles granted to the database account.`,
+			},
+		},
+	}
+}
+
+func buildGaussDBNoSQLAccountRoles(d *schema.ResourceData) []map[string]interface{} {
+	rawRoles := d.Get("roles").(*schema.Set).List()
+	roles := make([]map[string]interface{}, 0, len(rawRoles))
+	for _, v := range rawRoles {
+		role := v.(map[string]interface{})
+		roles = append(roles, map[string]interface{}{
+			"name":    role["name"],
+			"db_name": utils.ValueIngoreEmpty(role["db_name"]),
+		})
+	}
+	return roles
+}
+
+func resourceGaussDBNoSQLAccountCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.GeminiDBV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating GeminiDB client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	name := d.Get("name").(string)
+	params := map[string]interface{}{
+		"users": []map[string]interface{}{
+			{
+				"name":     name,
+				"password": d.Get("password"),
+				"roles":    buildGaussDBNoSQLAccountRoles(d),
+			},
+		},
+	}
+	_, err = requestGaussDBApi(client, "POST", buildGaussDBInstancePath(client, instanceId, "db-users"), params,
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error creating GeminiDB account: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceId, name))
+
+	return resourceGaussDBNoSQLAccountRead(ctx, d, meta)
+}
+
+func resourceGaussDBNoSQLAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.GeminiDBV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating GeminiDB client: %s", err)
+	}
+
+	parts, err := parseGaussDBSubResourceID(d.Id(), "<instance_id>/<name>", 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceId, name := parts[0], parts[1]
+
+	getPath := buildGaussDBInstancePath(client, instanceId, "db-users?name="+name)
+	getRespBody, err := requestGaussDBApi(client, "GET", getPath, nil, 0)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving GeminiDB account")
+	}
+
+	user := utils.PathSearch(fmt.Sprintf("users[?name=='%s']|[0]", name), getRespBody, nil)
+	if user == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving GeminiDB account")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", instanceId),
+		d.Set("name", name),
+		d.Set("roles", flattenGaussDBNoSQLAccountRoles(user)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenGaussDBNoSQLAccountRoles(user interface{}) []interface{} {
+	rawRoles := utils.PathSearch("roles", user, make([]interface{}, 0)).([]interface{})
+	roles := make([]interface{}, 0, len(rawRoles))
+	for _, role := range rawRoles {
+		roles = append(roles, map[string]interface{}{
+			"name":    utils.PathSearch("name", role, nil),
+			"db_name": utils.PathSearch("db_name", role, nil),
+		})
+	}
+	return roles
+}
+
+func resourceGaussDBNoSQLAccountUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.GeminiDBV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating GeminiDB client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	if d.HasChange("password") {
+		params := map[string]interface{}{
+			"name":     d.Get("name"),
+			"password": d.Get("password"),
+		}
+		_, err = requestGaussDBApi(client, "PUT", buildGaussDBInstancePath(client, instanceId,
+			"db-users/password"), params, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("error updating password of GeminiDB account (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("roles") {
+		params := map[string]interface{}{
+			"name":  d.Get("name"),
+			"roles": buildGaussDBNoSQLAccountRoles(d),
+		}
+		_, err = requestGaussDBApi(client, "PUT", buildGaussDBInstancePath(client, instanceId,
+			"db-users/roles"), params, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("error updating roles of GeminiDB account (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceGaussDBNoSQLAccountRead(ctx, d, meta)
+}
+
+func resourceGaussDBNoSQLAccountDelete(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.GeminiDBV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating GeminiDB client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	params := map[string]interface{}{
+		"names": []string{d.Get("name").(string)},
+	}
+	_, err = requestGaussDBApi(client, "DELETE", buildGaussDBInstancePath(client, instanceId, "db-users"),
+		params, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting GeminiDB account")
+	}
+
+	return nil
+}
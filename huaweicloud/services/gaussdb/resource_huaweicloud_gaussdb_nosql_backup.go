@@ -0,0 +1,171 @@
+package gaussdb
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceGaussDBNoSQLBackup is the impl for huaweicloud_gaussdb_nosql_backup resource, which manages the manual
+// backups of the GeminiDB (Cassandra, Mongo, Influx and Redis) instances.
+func ResourceGaussDBNoSQLBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGaussDBNoSQLBackupCreate,
+		ReadContext:   resourceGaussDBNoSQLBackupRead,
+		DeleteContext: resourceGaussDBNoSQLBackupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the GeminiDB instance.`,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(4, 64),
+				Description:  `Specifies the name of the backup.`,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 256),
+				Description:  `Specifies the description of the backup.`,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the backup type.`,
+			},
+			"size": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: `Indicates the backup size in KB.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the backup status.`,
+			},
+			"begin_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the backup start time.`,
+			},
+			"end_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the backup end time.`,
+			},
+		},
+	}
+}
+
+func resourceGaussDBNoSQLBackupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.GeminiDBV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating GeminiDB client: %s", err)
+	}
+
+	params := map[string]interface{}{
+		"name":        d.Get("name"),
+		"description": utils.ValueIngoreEmpty(d.Get("description")),
+	}
+	createPath := buildGaussDBInstancePath(client, d.Get("instance_id").(string), "backups")
+	createRespBody, err := requestGaussDBApi(client, "POST", createPath, params, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error creating GeminiDB backup: %s", err)
+	}
+
+	backupId := utils.PathSearch("backup_id", createRespBody, "").(string)
+	if backupId == "" {
+		return diag.Errorf("unable to find the backup ID from the API response")
+	}
+	d.SetId(backupId)
+
+	return resourceGaussDBNoSQLBackupRead(ctx, d, meta)
+}
+
+func resourceGaussDBNoSQLBackupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.GeminiDBV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating GeminiDB client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v3/{project_id}/backups?backup_id={backup_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{backup_id}", d.Id())
+	getRespBody, err := requestGaussDBApi(client, "GET", getPath, nil, 0)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving GeminiDB backup")
+	}
+
+	backup := utils.PathSearch("backups|[0]", getRespBody, nil)
+	if backup == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving GeminiDB backup")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", utils.PathSearch("instance_id", backup, nil)),
+		d.Set("name", utils.PathSearch("name", backup, nil)),
+		d.Set("description", utils.PathSearch("description", backup, nil)),
+		d.Set("type", utils.PathSearch("type", backup, nil)),
+		d.Set("size", utils.PathSearch("size", backup, nil)),
+		d.Set("status", utils.PathSearch("status", backup, nil)),
+		d.Set("begin_time", utils.PathSearch("begin_time", backup, nil)),
+		d.Set("end_time", utils.PathSearch("end_time", backup, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceGaussDBNoSQLBackupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.GeminiDBV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating GeminiDB client: %s", err)
+	}
+
+	deletePath := client.Endpoint + "v3/{project_id}/backups/{backup_id}"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{backup_id}", d.Id())
+	_, err = requestGaussDBApi(client, "DELETE", deletePath, nil, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting GeminiDB backup")
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package gaussdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceGaussDBNoSQLDatabase is the impl for huaweicloud_gaussdb_nosql_database resource, which manages the
+// keyspaces of the Cassandra instances and the databases of the Influx instances.
+// The resource ID is in the format of <instance_id>/<name>.
+func ResourceGaussDBNoSQLDatabase() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGaussDBNoSQLDatabaseCreate,
+		ReadContext:   resourceGaussDBNoSQLDatabaseRead,
+		DeleteContext: resourceGaussDBNoSQLDatabaseDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the GeminiDB Cassandra or Influx instance.`,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 48),
+				Description:  `Specifies the name of the keyspace or the database.`,
+			},
+		},
+	}
+}
+
+func resourceGaussDBNoSQLDatabaseCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.GeminiDBV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating GeminiDB client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	name := d.Get("name").(string)
+	params := map[string]interface{}{
+		"databases": []map[string]interface{}{
+			{
+				"name": name,
+			},
+		},
+	}
+	_, err = requestGaussDBApi(client, "POST", buildGaussDBInstancePath(client, instanceId, "databases"), params,
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error creating GeminiDB database: %s", err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceId, name))
+
+	return resourceGaussDBNoSQLDatabaseRead(ctx, d, meta)
+}
+
+func resourceGaussDBNoSQLDatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.GeminiDBV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating GeminiDB client: %s", err)
+	}
+
+	parts, err := parseGaussDBSubResourceID(d.Id(), "<instance_id>/<name>", 2)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceId, name := parts[0], parts[1]
+
+	listPath := buildGaussDBInstancePath(client, instanceId, "databases?limit=100")
+	offset := 0
+	for {
+		listRespBody, err := requestGaussDBApi(client, "GET", fmt.Sprintf("%s&offset=%d", listPath, offset), nil, 0)
+		if err != nil {
+			return common.CheckDeletedDiag(d, err, "error retrieving GeminiDB database")
+		}
+
+		databases := utils.PathSearch("databases", listRespBody, make([]interface{}, 0)).([]interface{})
+		if utils.PathSearch(fmt.Sprintf("[?name=='%s']|[0]", name), databases, nil) != nil {
+			break
+		}
+		if len(databases) < 100 {
+			return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving GeminiDB database")
+		}
+		offset += len(databases)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("instance_id", instanceId),
+		d.Set("name", name),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceGaussDBNoSQLDatabaseDelete(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.GeminiDBV3Client(region)
+	if err != nil {
+		return diag.Errorf("error creating GeminiDB client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	config.MutexKV.Lock(instanceId)
+	defer config.MutexKV.Unlock(instanceId)
+
+	params := map[string]interface{}{
+		"databases": []string{d.Get("name").(string)},
+	}
+	_, err = requestGaussDBApi(client, "DELETE", buildGaussDBInstancePath(client, instanceId, "databases"),
+		params, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting GeminiDB database")
+	}
+
+	return nil
+}
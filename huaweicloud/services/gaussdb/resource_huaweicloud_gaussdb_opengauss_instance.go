@@ -51,6 +51,9 @@ func ResourceOpenGaussInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(45 * time.Minute),
 		},
 		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, v interface{}) error {
+			if err := validateOpenGaussScaling(d); err != nil {
+				return err
+			}
 			if d.HasChange("coordinator_num") {
 				return d.SetNewComputed("private_ips")
 			}
@@ -346,6 +349,57 @@ func resourceOpenGaussBackupStrategy(d *schema.ResourceData) *instances.BackupSt
 	return nil
 }
 
+// validateOpenGaussScaling checks whether the sharding number, the coordinator number and the volume size are shrunk,
+// which is not supported by the service, so that the error is raised during the plan instead of the apply.
+func validateOpenGaussScaling(d *schema.ResourceDiff) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	var mErr *multierror.Error
+	for _, key := range []string{"sharding_num", "coordinator_num", "volume.0.size"} {
+		if !d.HasChange(key) {
+			continue
+		}
+		oldVal, newVal := d.GetChange(key)
+		if newVal.(int) < oldVal.(int) {
+			mErr = multierror.Append(mErr, fmt.Errorf("shrinking %s from %d to %d is not supported, only expansion is "+
+				"allowed", key, oldVal, newVal))
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
+// gaussDBJobStatusRefreshFunc queries the job status, the job API is shared by openGauss and GeminiDB.
+func gaussDBJobStatusRefreshFunc(client *golangsdk.ServiceClient, jobId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		getJobPath := client.Endpoint + "v3/{project_id}/jobs?id={job_id}"
+		getJobPath = strings.ReplaceAll(getJobPath, "{project_id}", client.ProjectID)
+		getJobPath = strings.ReplaceAll(getJobPath, "{job_id}", jobId)
+		getJobOpt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes: []int{
+				200,
+			},
+		}
+		getJobResp, err := client.Request("GET", getJobPath, &getJobOpt)
+		if err != nil {
+			return nil, "", err
+		}
+		getJobRespBody, err := utils.FlattenResponse(getJobResp)
+		if err != nil {
+			return nil, "", err
+		}
+
+		status := utils.PathSearch("job.status", getJobRespBody, "").(string)
+		if status == "Failed" {
+			return getJobRespBody, status, fmt.Errorf("the job (%s) failed: %v", jobId,
+				utils.PathSearch("job.fail_reason", getJobRespBody, ""))
+		}
+		return getJobRespBody, status, nil
+	}
+}
+
 func OpenGaussInstanceStateRefreshFunc(client *golangsdk.ServiceClient, instanceID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		v, err := instances.GetInstanceByID(client, instanceID)
@@ -659,9 +713,6 @@ func resourceOpenGaussInstanceRead(_ context.Context, d *schema.ResourceData, me
 func expandOpenGaussShardingNumber(ctx context.Context, config *config.Config, client *golangsdk.ServiceClient,
 	d *schema.ResourceData) error {
 	old, newnum := d.GetChange("sharding_num")
-	if newnum.(int) < old.(int) {
-		return fmt.Errorf("error expanding shard for instance: new num must be larger than the old one.")
-	}
 	expandSize := newnum.(int) - old.(int)
 	opts := instances.UpdateOpts{
 		ExpandCluster: &instances.UpdateClusterOpts{
@@ -678,16 +729,14 @@ func expandOpenGaussShardingNumber(ctx context.Context, config *config.Config, c
 func expandOpenGaussCoordinatorNumber(ctx context.Context, config *config.Config, client *golangsdk.ServiceClient,
 	d *schema.ResourceData) error {
 	old, newnum := d.GetChange("coordinator_num")
-	if newnum.(int) < old.(int) {
-		return fmt.Errorf("error expanding coordinator for instance: new number must be larger than the old one.")
-	}
 	expandSize := newnum.(int) - old.(int)
 
+	// the new coordinators are distributed to the availability zones of the instance in turn
 	var coordinators []instances.Coordinator
 	azlist := strings.Split(d.Get("availability_zone").(string), ",")
 	for i := 0; i < expandSize; i++ {
 		coordinator := instances.Coordinator{
-			AzCode: azlist[0],
+			AzCode: azlist[(old.(int)+i)%len(azlist)],
 		}
 		coordinators = append(coordinators, coordinator)
 	}
@@ -738,6 +787,20 @@ func updateVolumeAndRelatedHaNumbers(ctx context.Context, config *config.Config,
 			return err
 		}
 	}
+	if resp.JobId != "" {
+		jobStateConf := &resource.StateChangeConf{
+			Pending:      []string{"Running"},
+			Target:       []string{"Completed"},
+			Refresh:      gaussDBJobStatusRefreshFunc(client, resp.JobId),
+			Timeout:      d.Timeout(schema.TimeoutUpdate),
+			Delay:        20 * time.Second,
+			PollInterval: 20 * time.Second,
+		}
+		if _, err = jobStateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("error waiting for the job (%s) of instance (%s) to complete: %s", resp.JobId,
+				instanceId, err)
+		}
+	}
 	stateConf := &resource.StateChangeConf{
 		Pending:                   []string{"MODIFYING", "EXPANDING", "BACKING UP"},
 		Target:                    []string{"ACTIVE"},
@@ -93,6 +93,7 @@ func ResourceGaussRedisInstanceV3() *schema.Resource {
 				Type:     schema.TypeBool,
 				Optional: true,
 			},
+			"restore": geminiDBRestoreSchema(),
 			"datastore": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -366,7 +367,7 @@ func resourceGaussRedisInstanceV3Create(d *schema.ResourceData, meta interface{}
 	// Add password here so it wouldn't go in the above log entry
 	createOpts.Password = d.Get("password").(string)
 
-	instance, err := instances.Create(client, createOpts).Extract()
+	instance, err := instances.Create(client, buildGeminiDBCreateOpts(d, createOpts)).Extract()
 	if err != nil {
 		return fmtp.Errorf("Error creating GeminiDB instance : %s", err)
 	}
@@ -760,6 +761,12 @@ func resourceGaussRedisInstanceV3Update(d *schema.ResourceData, meta interface{}
 		}
 	}
 
+	if d.HasChange("backup_strategy") {
+		if err := updateGeminiDBBackupStrategy(client, d); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("auto_renew") {
 		bssClient, err := config.BssV2Client(config.GetRegion(d))
 		if err != nil {
@@ -2,6 +2,7 @@ package mrs
 
 import (
 	"fmt"
+	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
@@ -183,41 +184,46 @@ func ResourceMRSClusterV2() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 				MaxItems: 1,
-				Elem:     nodeGroupSchemaResource("master_node_default_group", false, 1, 9),
+				Elem:     nodeGroupSchemaResource("master_node_default_group", false, false, 1, 9),
 			},
 			"analysis_core_nodes": {
 				Type:     schema.TypeList,
 				Optional: true,
 				ForceNew: true,
 				MaxItems: 1,
-				Elem:     nodeGroupSchemaResource("core_node_analysis_group", true, 1, 500),
+				Elem:     nodeGroupSchemaResource("core_node_analysis_group", true, false, 1, 500),
 			},
 			"streaming_core_nodes": {
 				Type:     schema.TypeList,
 				Optional: true,
 				ForceNew: true,
 				MaxItems: 1,
-				Elem:     nodeGroupSchemaResource("core_node_streaming_group", true, 1, 500),
+				Elem:     nodeGroupSchemaResource("core_node_streaming_group", true, false, 1, 500),
 			},
 			"analysis_task_nodes": {
 				Type:     schema.TypeList,
 				Optional: true,
 				ForceNew: true,
 				MaxItems: 1,
-				Elem:     nodeGroupSchemaResource("task_node_analysis_group", true, 1, 500),
+				Elem:     nodeGroupSchemaResource("task_node_analysis_group", true, true, 1, 500),
 			},
 			"streaming_task_nodes": {
 				Type:     schema.TypeList,
 				Optional: true,
 				ForceNew: true,
 				MaxItems: 1,
-				Elem:     nodeGroupSchemaResource("task_node_streaming_group", true, 1, 500),
+				Elem:     nodeGroupSchemaResource("task_node_streaming_group", true, true, 1, 500),
 			},
 			"custom_nodes": {
 				Type:     schema.TypeList,
 				Optional: true,
 				ForceNew: true,
-				Elem:     nodeGroupSchemaResource("", false, 1, 500),
+				Elem:     nodeGroupSchemaResource("", false, true, 1, 500),
+			},
+			"bootstrap_scripts": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     bootstrapScriptSchemaResource(),
 			},
 
 			"tags": {
@@ -260,7 +266,8 @@ func ResourceMRSClusterV2() *schema.Resource {
 /*
 when custom node,the groupName should been empty
 */
-func nodeGroupSchemaResource(groupName string, nodeScalable bool, minNodeNum, maxNodeNum int) *schema.Resource {
+func nodeGroupSchemaResource(groupName string, nodeScalable, autoScalable bool,
+	minNodeNum, maxNodeNum int) *schema.Resource {
 	nodeResource := schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"flavor": {
@@ -326,6 +333,16 @@ func nodeGroupSchemaResource(groupName string, nodeScalable bool, minNodeNum, ma
 		}
 	}
 
+	// the auto scaling policy can be configured for the task node groups and the custom node groups
+	if autoScalable {
+		nodeResource.Schema["auto_scaling_policy"] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem:     autoScalingPolicySchemaResource(),
+		}
+	}
+
 	if nodeScalable {
 		nodeResource.Schema["node_number"] = &schema.Schema{
 			Type:         schema.TypeInt,
@@ -344,6 +361,212 @@ func nodeGroupSchemaResource(groupName string, nodeScalable bool, minNodeNum, ma
 	return &nodeResource
 }
 
+func autoScalingPolicySchemaResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"auto_scaling_enable": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"min_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 500),
+			},
+			"max_capacity": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 500),
+			},
+			"resource_pool_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "default",
+			},
+			"resources_plans": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"period_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "daily",
+						},
+						"start_time": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"end_time": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"min_capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 500),
+						},
+						"max_capacity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 500),
+						},
+					},
+				},
+			},
+			"rules": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"adjustment_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"scale_out", "scale_in"}, false),
+						},
+						"cool_down_minutes": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"scaling_adjustment": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+						"trigger": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"metric_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"metric_value": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"comparison_operator": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ValidateFunc: validation.StringInSlice([]string{
+											"LT", "GT", "LTOE", "GTOE",
+										}, false),
+									},
+									"evaluation_periods": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntBetween(1, 288),
+									},
+								},
+							},
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"exec_scripts": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"uri": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"nodes": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"action_stage": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"before_scale_out", "before_scale_in", "after_scale_out", "after_scale_in",
+							}, false),
+						},
+						"fail_action": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"continue", "errorout"}, false),
+						},
+						"parameters": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"active_master": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func bootstrapScriptSchemaResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"uri": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"nodes": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"fail_action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"continue", "errorout"}, false),
+			},
+			"parameters": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"active_master": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"before_component_start": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
 // The 'component_list' type of the request body is string, before body build, it should be conversation from set to string.
 func buildMrsComponents(d *schema.ResourceData) string {
 	components := d.Get("component_list").(*schema.Set)
@@ -548,6 +771,7 @@ func resourceMRSClusterV2Create(d *schema.ResourceData, meta interface{}) error
 		SafeMode:             buildMrsSafeMode(d),
 		SecurityGroupsIds:    buildMrsSecurityGroupIds(d),
 		TemplateId:           d.Get("template_id").(string),
+		BootstrapScripts:     buildMrsClusterBootstrapScripts(d),
 	}
 	if v, ok := d.GetOk("node_key_pair"); ok {
 		createOpts.NodeKeypair = v.(string)
@@ -578,6 +802,9 @@ func resourceMRSClusterV2Create(d *schema.ResourceData, meta interface{}) error
 	if err = addTagsToMrsCluster(d, config); err != nil {
 		return fmtp.Errorf("Error waiting for MapReduce cluster (%s) to become ready: %s", d.Id(), err)
 	}
+	if err = updateMrsClusterAutoScalingPolicies(d, mrsV2Client); err != nil {
+		return err
+	}
 
 	return resourceMRSClusterV2Read(d, meta)
 }
@@ -686,7 +913,7 @@ func setMrsClsuterComponentList(d *schema.ResourceData, resp *cluster.Cluster) e
 }
 
 func setMrsClusterNodeGroups(d *schema.ResourceData, mrsV1Client *golangsdk.ServiceClient,
-	resp *cluster.Cluster) error {
+	resp *cluster.Cluster, policies interface{}) error {
 	var groupMapDecl = map[string]string{
 		masterGroup:        "master_nodes",
 		analysisCoreGroup:  "analysis_core_nodes",
@@ -725,6 +952,10 @@ func setMrsClusterNodeGroups(d *schema.ResourceData, mrsV1Client *golangsdk.Serv
 		}
 
 		groupMap["assigned_roles"] = node.AssignedRoles
+		policy := utils.PathSearch(fmt.Sprintf("[?node_group_name=='%s']|[0]", node.GroupName), policies, nil)
+		if policy != nil {
+			groupMap["auto_scaling_policy"] = flattenMrsAutoScalingPolicy(policy)
+		}
 		if node.DataVolumeCount != 0 {
 			groupMap["data_volume_type"] = node.DataVolumeType
 			groupMap["data_volume_size"] = node.DataVolumeSize
@@ -783,14 +1014,26 @@ func getMrsClusterFromServer(d *schema.ResourceData, client *golangsdk.ServiceCl
 
 func resourceMRSClusterV2Read(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*config.Config)
-	client, err := config.MrsV1Client(config.GetRegion(d))
+	region := config.GetRegion(d)
+	client, err := config.MrsV1Client(region)
 	if err != nil {
 		return fmtp.Errorf("Error creating HuaweiCloud MRS client: %s", err)
 	}
+	mrsV2Client, err := config.MrsV2Client(region)
+	if err != nil {
+		return fmtp.Errorf("Error creating Huaweicloud MRS V2 client: %s", err)
+	}
 	resp, err := getMrsClusterFromServer(d, client)
 	if err != nil {
 		return fmtp.Errorf("Error getting MapReduce form server: %s", err)
 	}
+	var policies interface{}
+	if hasMrsAutoScalingPolicies(d) {
+		policies, err = queryMrsClusterAutoScalingPolicies(mrsV2Client, d.Id())
+		if err != nil {
+			return err
+		}
+	}
 
 	logp.Printf("[DEBUG] Retrieved Cluster %s: %#v", d.Id(), resp)
 	d.SetId(resp.Clusterid)
@@ -818,7 +1061,8 @@ func resourceMRSClusterV2Read(d *schema.ResourceData, meta interface{}) error {
 		setMrsClsuterUpdateTimestamp(d, resp),
 		setMrsClsuterChargingTimestamp(d, resp),
 		setMrsClsuterCreateTimestamp(d, resp),
-		setMrsClusterNodeGroups(d, client, resp),
+		setMrsClusterNodeGroups(d, client, resp, policies),
+		d.Set("bootstrap_scripts", flattenMrsClusterBootstrapScripts(resp)),
 		setClsuterTags(d, client),
 	)
 	if err := mErr.ErrorOrNil(); err != nil {
@@ -943,18 +1187,22 @@ func updateMRSClusterNodes(d *schema.ResourceData, client *golangsdk.ServiceClie
 		if d.HasChange("analysis_core_nodes") {
 			oldRaws, newRaws := d.GetChange("analysis_core_nodes")
 			num := getNodeResizeNumber(oldRaws.([]interface{}), newRaws.([]interface{}))
-			err := resizeMRSClusterCoreNodes(client, d.Id(), analysisCoreGroup, num)
-			if err != nil {
-				return err
+			if num != 0 {
+				err := resizeMRSClusterCoreNodes(client, d.Id(), analysisCoreGroup, num)
+				if err != nil {
+					return err
+				}
 			}
 		}
 		if d.HasChange("analysis_task_nodes") {
 			oldRaws, newRaws := d.GetChange("analysis_task_nodes")
 			num := getNodeResizeNumber(oldRaws.([]interface{}), newRaws.([]interface{}))
-			err := resizeMRSClusterTaskNodes(client, d.Id(), analysisTaskGroup,
-				oldRaws.([]interface{}), newRaws.([]interface{}), num)
-			if err != nil {
-				return err
+			if num != 0 {
+				err := resizeMRSClusterTaskNodes(client, d.Id(), analysisTaskGroup,
+					oldRaws.([]interface{}), newRaws.([]interface{}), num)
+				if err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -962,18 +1210,22 @@ func updateMRSClusterNodes(d *schema.ResourceData, client *golangsdk.ServiceClie
 		if d.HasChange("streaming_core_nodes") {
 			oldRaws, newRaws := d.GetChange("streaming_core_nodes")
 			num := getNodeResizeNumber(oldRaws.([]interface{}), newRaws.([]interface{}))
-			err := resizeMRSClusterCoreNodes(client, d.Id(), streamingCoreGroup, num)
-			if err != nil {
-				return err
+			if num != 0 {
+				err := resizeMRSClusterCoreNodes(client, d.Id(), streamingCoreGroup, num)
+				if err != nil {
+					return err
+				}
 			}
 		}
 		if d.HasChange("streaming_task_nodes") {
 			oldRaws, newRaws := d.GetChange("streaming_task_nodes")
 			num := getNodeResizeNumber(oldRaws.([]interface{}), newRaws.([]interface{}))
-			err := resizeMRSClusterTaskNodes(client, d.Id(), streamingTaskGroup,
-				oldRaws.([]interface{}), newRaws.([]interface{}), num)
-			if err != nil {
-				return err
+			if num != 0 {
+				err := resizeMRSClusterTaskNodes(client, d.Id(), streamingTaskGroup,
+					oldRaws.([]interface{}), newRaws.([]interface{}), num)
+				if err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -983,6 +1235,9 @@ func updateMRSClusterNodes(d *schema.ResourceData, client *golangsdk.ServiceClie
 			oldRaws, newRaws := d.GetChange("custom_nodes")
 			scaleMap := parseCustomNodeResize(oldRaws.([]interface{}), newRaws.([]interface{}))
 			for k, num := range scaleMap {
+				if num == 0 {
+					continue
+				}
 				err := resizeMRSClusterCoreNodes(client, d.Id(), k, num)
 				if err != nil {
 					return err
@@ -1018,6 +1273,21 @@ func resourceMRSClusterV2Update(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	mrsV2Client, err := config.MrsV2Client(config.GetRegion(d))
+	if err != nil {
+		return fmtp.Errorf("Error creating Huaweicloud MRS V2 client: %s", err)
+	}
+	if d.HasChanges("analysis_task_nodes", "streaming_task_nodes", "custom_nodes") {
+		if err = updateMrsClusterAutoScalingPolicies(d, mrsV2Client); err != nil {
+			return err
+		}
+	}
+	if d.HasChange("bootstrap_scripts") {
+		if err = updateMrsClusterBootstrapScripts(d, mrsV2Client); err != nil {
+			return err
+		}
+	}
+
 	return resourceMRSClusterV2Read(d, meta)
 }
 
@@ -1096,3 +1366,266 @@ func queryMrsClusterHosts(d *schema.ResourceData, mrsV1Client *golangsdk.Service
 
 	return hostsMap, nil
 }
+
+func buildMrsClusterBootstrapScripts(d *schema.ResourceData) []clusterV2.ScriptOpts {
+	rawScripts := d.Get("bootstrap_scripts").([]interface{})
+	if len(rawScripts) == 0 {
+		return nil
+	}
+
+	result := make([]clusterV2.ScriptOpts, len(rawScripts))
+	for i, v := range rawScripts {
+		script := v.(map[string]interface{})
+		result[i] = clusterV2.ScriptOpts{
+			Name:                 script["name"].(string),
+			URI:                  script["uri"].(string),
+			Parameters:           script["parameters"].(string),
+			Nodes:                utils.ExpandToStringList(script["nodes"].([]interface{})),
+			FailAction:           script["fail_action"].(string),
+			ActiveMaster:         utils.Bool(script["active_master"].(bool)),
+			BeforeComponentStart: utils.Bool(script["before_component_start"].(bool)),
+		}
+	}
+	return result
+}
+
+func flattenMrsClusterBootstrapScripts(resp *cluster.Cluster) []map[string]interface{} {
+	if len(resp.BootstrapScripts) == 0 {
+		return nil
+	}
+
+	result := make([]map[string]interface{}, len(resp.BootstrapScripts))
+	for i, script := range resp.BootstrapScripts {
+		result[i] = map[string]interface{}{
+			"name":                   script.Name,
+			"uri":                    script.Uri,
+			"parameters":             script.Parameters,
+			"nodes":                  script.Nodes,
+			"fail_action":            script.FailAction,
+			"active_master":          script.ActiveMaster,
+			"before_component_start": script.BeforeComponentStart,
+			"state":                  script.State,
+			"start_time":             script.StartTime,
+		}
+	}
+	return result
+}
+
+// updateMrsClusterBootstrapScripts replaces the bootstrap scripts of the cluster, the new scripts are executed on the
+// nodes added afterwards.
+func updateMrsClusterBootstrapScripts(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	scripts := buildMrsClusterBootstrapScripts(d)
+	if scripts == nil {
+		scripts = make([]clusterV2.ScriptOpts, 0)
+	}
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 204},
+		JSONBody: map[string]interface{}{
+			"bootstrap_scripts": scripts,
+		},
+	}
+	_, err := client.Request("PUT", client.ServiceURL("clusters", d.Id(), "bootstrap-scripts"), &opt)
+	if err != nil {
+		return fmtp.Errorf("Error updating bootstrap scripts of MapReduce cluster (%s): %s", d.Id(), err)
+	}
+	return nil
+}
+
+// mrsAutoScalingNodeGroups maps the node group arguments which support the auto scaling policy to the group names,
+// the custom node groups use their own group names.
+var mrsAutoScalingNodeGroups = map[string]string{
+	"analysis_task_nodes":  analysisTaskGroup,
+	"streaming_task_nodes": streamingTaskGroup,
+	"custom_nodes":         "",
+}
+
+func collectMrsAutoScalingPolicies(policies map[string]interface{}, nodeGroups []interface{}, defaultName string) {
+	for _, v := range nodeGroups {
+		group := v.(map[string]interface{})
+		groupName := defaultName
+		if customName, ok := group["group_name"]; ok {
+			groupName = customName.(string)
+		}
+		if policy, ok := group["auto_scaling_policy"].([]interface{}); ok && len(policy) > 0 && policy[0] != nil {
+			policies[groupName] = policy[0]
+		}
+	}
+}
+
+// hasMrsAutoScalingPolicies checks whether any node group configures the auto scaling policy, the policies are
+// queried only in this case.
+func hasMrsAutoScalingPolicies(d *schema.ResourceData) bool {
+	policies := make(map[string]interface{})
+	for key, groupName := range mrsAutoScalingNodeGroups {
+		collectMrsAutoScalingPolicies(policies, d.Get(key).([]interface{}), groupName)
+	}
+	return len(policies) > 0
+}
+
+func buildMrsAutoScalingRules(rawRules []interface{}) []map[string]interface{} {
+	rules := make([]map[string]interface{}, len(rawRules))
+	for i, v := range rawRules {
+		rule := v.(map[string]interface{})
+		trigger := rule["trigger"].([]interface{})[0].(map[string]interface{})
+		rules[i] = map[string]interface{}{
+			"name":               rule["name"],
+			"description":        utils.ValueIngoreEmpty(rule["description"]),
+			"adjustment_type":    rule["adjustment_type"],
+			"cool_down_minutes":  rule["cool_down_minutes"],
+			"scaling_adjustment": rule["scaling_adjustment"],
+			"trigger": map[string]interface{}{
+				"metric_name":         trigger["metric_name"],
+				"metric_value":        trigger["metric_value"],
+				"comparison_operator": utils.ValueIngoreEmpty(trigger["comparison_operator"]),
+				"evaluation_periods":  trigger["evaluation_periods"],
+			},
+		}
+	}
+	return rules
+}
+
+func buildMrsAutoScalingPolicyBody(groupName string, policy map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"node_group_name":    groupName,
+		"resource_pool_name": policy["resource_pool_name"],
+		"auto_scaling_policy": map[string]interface{}{
+			"auto_scaling_enable": policy["auto_scaling_enable"],
+			"min_capacity":        policy["min_capacity"],
+			"max_capacity":        policy["max_capacity"],
+			// the arguments of the resource plans and the scripts are the same as the request fields
+			"resources_plans": policy["resources_plans"],
+			"rules":           buildMrsAutoScalingRules(policy["rules"].([]interface{})),
+			"exec_scripts":    policy["exec_scripts"],
+		},
+	}
+}
+
+// updateMrsClusterAutoScalingPolicies compares the policies of the node groups before and after the change, and
+// creates, updates or deletes the policies accordingly.
+func updateMrsClusterAutoScalingPolicies(d *schema.ResourceData, client *golangsdk.ServiceClient) error {
+	oldPolicies := make(map[string]interface{})
+	newPolicies := make(map[string]interface{})
+	for key, groupName := range mrsAutoScalingNodeGroups {
+		oldRaws, newRaws := d.GetChange(key)
+		collectMrsAutoScalingPolicies(oldPolicies, oldRaws.([]interface{}), groupName)
+		collectMrsAutoScalingPolicies(newPolicies, newRaws.([]interface{}), groupName)
+	}
+
+	policyURL := client.ServiceURL("autoscaling-policy", d.Id())
+	for groupName, policy := range oldPolicies {
+		if _, ok := newPolicies[groupName]; ok {
+			continue
+		}
+		opt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes:          []int{200, 204},
+			JSONBody: map[string]interface{}{
+				"node_group_name":    groupName,
+				"resource_pool_name": policy.(map[string]interface{})["resource_pool_name"],
+			},
+		}
+		if _, err := client.Request("DELETE", policyURL, &opt); err != nil {
+			return fmtp.Errorf("Error deleting auto scaling policy of node group (%s): %s", groupName, err)
+		}
+	}
+
+	for groupName, policy := range newPolicies {
+		method := "POST"
+		if oldPolicy, ok := oldPolicies[groupName]; ok {
+			if reflect.DeepEqual(oldPolicy, policy) {
+				continue
+			}
+			method = "PUT"
+		}
+		body := buildMrsAutoScalingPolicyBody(groupName, policy.(map[string]interface{}))
+		logp.Printf("[DEBUG] The auto scaling policy of node group (%s) is: %#v", groupName, body)
+		opt := golangsdk.RequestOpts{
+			KeepResponseBody: true,
+			OkCodes:          []int{200, 201, 204},
+			JSONBody:         utils.RemoveNil(body),
+		}
+		if _, err := client.Request(method, policyURL, &opt); err != nil {
+			return fmtp.Errorf("Error configuring auto scaling policy of node group (%s): %s", groupName, err)
+		}
+	}
+	return nil
+}
+
+// queryMrsClusterAutoScalingPolicies returns the list of the auto scaling policies of all node groups.
+func queryMrsClusterAutoScalingPolicies(client *golangsdk.ServiceClient, clusterId string) (interface{}, error) {
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", client.ServiceURL("autoscaling-policy", clusterId), &opt)
+	if _, ok := err.(golangsdk.ErrDefault404); ok {
+		// the cluster has no auto scaling policy
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmtp.Errorf("Error querying auto scaling policies of MapReduce cluster (%s): %s", clusterId, err)
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func flattenMrsAutoScalingPolicy(policy interface{}) []map[string]interface{} {
+	rawPlans := utils.PathSearch("auto_scaling_policy.resources_plans", policy,
+		make([]interface{}, 0)).([]interface{})
+	plans := make([]map[string]interface{}, len(rawPlans))
+	for i, plan := range rawPlans {
+		plans[i] = map[string]interface{}{
+			"period_type":  utils.PathSearch("period_type", plan, nil),
+			"start_time":   utils.PathSearch("start_time", plan, nil),
+			"end_time":     utils.PathSearch("end_time", plan, nil),
+			"min_capacity": utils.PathSearch("min_capacity", plan, nil),
+			"max_capacity": utils.PathSearch("max_capacity", plan, nil),
+		}
+	}
+
+	rawRules := utils.PathSearch("auto_scaling_policy.rules", policy, make([]interface{}, 0)).([]interface{})
+	rules := make([]map[string]interface{}, len(rawRules))
+	for i, rule := range rawRules {
+		rules[i] = map[string]interface{}{
+			"name":               utils.PathSearch("name", rule, nil),
+			"description":        utils.PathSearch("description", rule, nil),
+			"adjustment_type":    utils.PathSearch("adjustment_type", rule, nil),
+			"cool_down_minutes":  utils.PathSearch("cool_down_minutes", rule, nil),
+			"scaling_adjustment": utils.PathSearch("scaling_adjustment", rule, nil),
+			"trigger": []map[string]interface{}{
+				{
+					"metric_name":         utils.PathSearch("trigger.metric_name", rule, nil),
+					"metric_value":        utils.PathSearch("trigger.metric_value", rule, nil),
+					"comparison_operator": utils.PathSearch("trigger.comparison_operator", rule, nil),
+					"evaluation_periods":  utils.PathSearch("trigger.evaluation_periods", rule, nil),
+				},
+			},
+		}
+	}
+
+	rawScripts := utils.PathSearch("auto_scaling_policy.exec_scripts", policy, make([]interface{}, 0)).([]interface{})
+	scripts := make([]map[string]interface{}, len(rawScripts))
+	for i, script := range rawScripts {
+		scripts[i] = map[string]interface{}{
+			"name":          utils.PathSearch("name", script, nil),
+			"uri":           utils.PathSearch("uri", script, nil),
+			"nodes":         utils.PathSearch("nodes", script, nil),
+			"action_stage":  utils.PathSearch("action_stage", script, nil),
+			"fail_action":   utils.PathSearch("fail_action", script, nil),
+			"parameters":    utils.PathSearch("parameters", script, nil),
+			"active_master": utils.PathSearch("active_master", script, nil),
+		}
+	}
+
+	return []map[string]interface{}{
+		{
+			"auto_scaling_enable": utils.PathSearch("auto_scaling_policy.auto_scaling_enable", policy, nil),
+			"min_capacity":        utils.PathSearch("auto_scaling_policy.min_capacity", policy, nil),
+			"max_capacity":        utils.PathSearch("auto_scaling_policy.max_capacity", policy, nil),
+			"resource_pool_name":  utils.PathSearch("resource_pool_name", policy, nil),
+			"resources_plans":     plans,
+			"rules":               rules,
+			"exec_scripts":        scripts,
+		},
+	}
+}
@@ -0,0 +1,330 @@
+package nat
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourcePrivateDnatRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePrivateDnatRuleCreate,
+		ReadContext:   resourcePrivateDnatRuleRead,
+		UpdateContext: resourcePrivateDnatRuleUpdate,
+		DeleteContext: resourcePrivateDnatRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the private NAT gateway to which the DNAT rule belongs.`,
+			},
+			"transit_ip_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The ID of the transit IP for private NAT.`,
+			},
+			"backend_interface_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"backend_private_ip"},
+				Description:  `The network interface ID of the backend instance.`,
+			},
+			"backend_private_ip": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The private IP address of the backend instance.`,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"tcp", "udp", "any",
+				}, false),
+				Description: `The protocol type.`,
+			},
+			"internal_service_port": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The port or port range of the backend instance.`,
+			},
+			"transit_service_port": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `The port or port range of the transit IP.`,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+				Description:  `The description of the DNAT rule.`,
+			},
+			"backend_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The type of the backend instance.`,
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the enterprise project to which the DNAT rule belongs.`,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func buildCreatePrivateDnatRuleBodyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"gateway_id":            d.Get("gateway_id"),
+		"transit_ip_id":         d.Get("transit_ip_id"),
+		"network_interface_id":  utils.ValueIngoreEmpty(d.Get("backend_interface_id")),
+		"private_ip_address":    utils.ValueIngoreEmpty(d.Get("backend_private_ip")),
+		"protocol":              utils.ValueIngoreEmpty(d.Get("protocol")),
+		"internal_service_port": utils.ValueIngoreEmpty(d.Get("internal_service_port")),
+		"transit_service_port":  utils.ValueIngoreEmpty(d.Get("transit_service_port")),
+		"description":           d.Get("description"),
+	}
+}
+
+func buildUpdatePrivateDnatRuleBodyParams(d *schema.ResourceData) map[string]interface{} {
+	params := map[string]interface{}{
+		"transit_ip_id":         d.Get("transit_ip_id"),
+		"protocol":              utils.ValueIngoreEmpty(d.Get("protocol")),
+		"internal_service_port": utils.ValueIngoreEmpty(d.Get("internal_service_port")),
+		"transit_service_port":  utils.ValueIngoreEmpty(d.Get("transit_service_port")),
+		"description":           d.Get("description"),
+	}
+	// Both backend fields are returned by the API, so only the changed one is sent, the other one is kept in the
+	// state (computed) after the backend is switched.
+	if d.HasChange("backend_interface_id") {
+		params["network_interface_id"] = utils.ValueIngoreEmpty(d.Get("backend_interface_id"))
+	}
+	if d.HasChange("backend_private_ip") {
+		params["private_ip_address"] = utils.ValueIngoreEmpty(d.Get("backend_private_ip"))
+	}
+	return params
+}
+
+func resourcePrivateDnatRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NatV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	createPath := client.Endpoint + "v3/{project_id}/private-nat/dnat-rules"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{201},
+		JSONBody: map[string]interface{}{
+			"dnat_rule": utils.RemoveNil(buildCreatePrivateDnatRuleBodyParams(d)),
+		},
+	}
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating private DNAT rule: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ruleId := utils.PathSearch("dnat_rule.id", respBody, "").(string)
+	if ruleId == "" {
+		return diag.Errorf("unable to find the private DNAT rule ID from the API response")
+	}
+	d.SetId(ruleId)
+
+	if err = waitForPrivateDnatRuleActive(ctx, client, ruleId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for the private DNAT rule (%s) to become active: %s", ruleId, err)
+	}
+
+	return resourcePrivateDnatRuleRead(ctx, d, meta)
+}
+
+// GetPrivateDnatRule is a method to query the private DNAT rule details by its ID.
+func GetPrivateDnatRule(client *golangsdk.ServiceClient, ruleId string) (interface{}, error) {
+	getPath := client.Endpoint + "v3/{project_id}/private-nat/dnat-rules/{dnat_rule_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{dnat_rule_id}", ruleId)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func privateDnatRuleStateRefreshFunc(client *golangsdk.ServiceClient, ruleId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		respBody, err := GetPrivateDnatRule(client, ruleId)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		status := utils.PathSearch("dnat_rule.status", respBody, "").(string)
+		if status == "ACTIVE" {
+			return respBody, status, nil
+		}
+		if status == "FROZEN" {
+			return respBody, status, fmt.Errorf("the private DNAT rule is frozen")
+		}
+		return respBody, "PENDING", nil
+	}
+}
+
+func waitForPrivateDnatRuleActive(ctx context.Context, client *golangsdk.ServiceClient, ruleId string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"ACTIVE"},
+		Refresh:      privateDnatRuleStateRefreshFunc(client, ruleId),
+		Timeout:      timeout,
+		Delay:        3 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourcePrivateDnatRuleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NatV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	respBody, err := GetPrivateDnatRule(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving private DNAT rule")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("gateway_id", utils.PathSearch("dnat_rule.gateway_id", respBody, nil)),
+		d.Set("transit_ip_id", utils.PathSearch("dnat_rule.transit_ip_id", respBody, nil)),
+		d.Set("backend_interface_id", utils.PathSearch("dnat_rule.network_interface_id", respBody, nil)),
+		d.Set("backend_private_ip", utils.PathSearch("dnat_rule.private_ip_address", respBody, nil)),
+		d.Set("protocol", utils.PathSearch("dnat_rule.protocol", respBody, nil)),
+		d.Set("internal_service_port", utils.PathSearch("dnat_rule.internal_service_port", respBody, nil)),
+		d.Set("transit_service_port", utils.PathSearch("dnat_rule.transit_service_port", respBody, nil)),
+		d.Set("description", utils.PathSearch("dnat_rule.description", respBody, nil)),
+		d.Set("backend_type", utils.PathSearch("dnat_rule.type", respBody, nil)),
+		d.Set("enterprise_project_id", utils.PathSearch("dnat_rule.enterprise_project_id", respBody, nil)),
+		d.Set("created_at", utils.PathSearch("dnat_rule.created_at", respBody, nil)),
+		d.Set("updated_at", utils.PathSearch("dnat_rule.updated_at", respBody, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving private DNAT rule fields: %s", err)
+	}
+	return nil
+}
+
+func resourcePrivateDnatRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NatV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	updatePath := client.Endpoint + "v3/{project_id}/private-nat/dnat-rules/{dnat_rule_id}"
+	updatePath = strings.ReplaceAll(updatePath, "{project_id}", client.ProjectID)
+	updatePath = strings.ReplaceAll(updatePath, "{dnat_rule_id}", d.Id())
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		JSONBody: map[string]interface{}{
+			"dnat_rule": utils.RemoveNil(buildUpdatePrivateDnatRuleBodyParams(d)),
+		},
+	}
+	if _, err = client.Request("PUT", updatePath, &updateOpt); err != nil {
+		return diag.Errorf("error updating private DNAT rule (%s): %s", d.Id(), err)
+	}
+
+	if err = waitForPrivateDnatRuleActive(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("error waiting for the private DNAT rule (%s) to become active: %s", d.Id(), err)
+	}
+
+	return resourcePrivateDnatRuleRead(ctx, d, meta)
+}
+
+func resourcePrivateDnatRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NatV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	deletePath := client.Endpoint + "v3/{project_id}/private-nat/dnat-rules/{dnat_rule_id}"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{dnat_rule_id}", d.Id())
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{204},
+	}
+	if _, err = client.Request("DELETE", deletePath, &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting private DNAT rule")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING", "ACTIVE"},
+		Target:       []string{"DELETED"},
+		Refresh:      privateDnatRuleStateRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        3 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the private DNAT rule (%s) to be deleted: %s", d.Id(), err)
+	}
+	return nil
+}
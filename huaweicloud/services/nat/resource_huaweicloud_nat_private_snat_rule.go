@@ -0,0 +1,286 @@
+package nat
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourcePrivateSnatRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePrivateSnatRuleCreate,
+		ReadContext:   resourcePrivateSnatRuleRead,
+		UpdateContext: resourcePrivateSnatRuleUpdate,
+		DeleteContext: resourcePrivateSnatRuleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the private NAT gateway to which the SNAT rule belongs.`,
+			},
+			"transit_ip_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `The ID of the transit IP associated with the SNAT rule.`,
+			},
+			"cidr": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"subnet_id"},
+				Description:  `The CIDR block of the matching rule.`,
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The subnet ID of the matching rule.`,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255),
+				Description:  `The description of the SNAT rule.`,
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the enterprise project to which the SNAT rule belongs.`,
+			},
+			"transit_ip_address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The IP address of the transit IP associated with the SNAT rule.`,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePrivateSnatRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NatV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	createPath := client.Endpoint + "v3/{project_id}/private-nat/snat-rules"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{201},
+		JSONBody: map[string]interface{}{
+			"snat_rule": utils.RemoveNil(map[string]interface{}{
+				"gateway_id":     d.Get("gateway_id"),
+				"transit_ip_ids": []string{d.Get("transit_ip_id").(string)},
+				"cidr":           utils.ValueIngoreEmpty(d.Get("cidr")),
+				"virsubnet_id":   utils.ValueIngoreEmpty(d.Get("subnet_id")),
+				"description":    utils.ValueIngoreEmpty(d.Get("description")),
+			}),
+		},
+	}
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating private SNAT rule: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	ruleId := utils.PathSearch("snat_rule.id", respBody, "").(string)
+	if ruleId == "" {
+		return diag.Errorf("unable to find the private SNAT rule ID from the API response")
+	}
+	d.SetId(ruleId)
+
+	if err = waitForPrivateSnatRuleActive(ctx, client, ruleId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("error waiting for the private SNAT rule (%s) to become active: %s", ruleId, err)
+	}
+
+	return resourcePrivateSnatRuleRead(ctx, d, meta)
+}
+
+// GetPrivateSnatRule is a method to query the private SNAT rule details by its ID.
+func GetPrivateSnatRule(client *golangsdk.ServiceClient, ruleId string) (interface{}, error) {
+	getPath := client.Endpoint + "v3/{project_id}/private-nat/snat-rules/{snat_rule_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{snat_rule_id}", ruleId)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return nil, err
+	}
+	return utils.FlattenResponse(resp)
+}
+
+func privateSnatRuleStateRefreshFunc(client *golangsdk.ServiceClient, ruleId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		respBody, err := GetPrivateSnatRule(client, ruleId)
+		if err != nil {
+			if _, ok := err.(golangsdk.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		status := utils.PathSearch("snat_rule.status", respBody, "").(string)
+		if status == "ACTIVE" {
+			return respBody, status, nil
+		}
+		if status == "FROZEN" {
+			return respBody, status, fmt.Errorf("the private SNAT rule is frozen")
+		}
+		return respBody, "PENDING", nil
+	}
+}
+
+func waitForPrivateSnatRuleActive(ctx context.Context, client *golangsdk.ServiceClient, ruleId string,
+	timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING"},
+		Target:       []string{"ACTIVE"},
+		Refresh:      privateSnatRuleStateRefreshFunc(client, ruleId),
+		Timeout:      timeout,
+		Delay:        3 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+func resourcePrivateSnatRuleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NatV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	respBody, err := GetPrivateSnatRule(client, d.Id())
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving private SNAT rule")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("gateway_id", utils.PathSearch("snat_rule.gateway_id", respBody, nil)),
+		d.Set("transit_ip_id", utils.PathSearch("snat_rule.transit_ip_associations[0].transit_ip_id", respBody, nil)),
+		d.Set("transit_ip_address", utils.PathSearch("snat_rule.transit_ip_associations[0].transit_ip_address",
+			respBody, nil)),
+		d.Set("cidr", utils.PathSearch("snat_rule.cidr", respBody, nil)),
+		d.Set("subnet_id", utils.PathSearch("snat_rule.virsubnet_id", respBody, nil)),
+		d.Set("description", utils.PathSearch("snat_rule.description", respBody, nil)),
+		d.Set("enterprise_project_id", utils.PathSearch("snat_rule.enterprise_project_id", respBody, nil)),
+		d.Set("created_at", utils.PathSearch("snat_rule.created_at", respBody, nil)),
+		d.Set("updated_at", utils.PathSearch("snat_rule.updated_at", respBody, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving private SNAT rule fields: %s", err)
+	}
+	return nil
+}
+
+func resourcePrivateSnatRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NatV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	updatePath := client.Endpoint + "v3/{project_id}/private-nat/snat-rules/{snat_rule_id}"
+	updatePath = strings.ReplaceAll(updatePath, "{project_id}", client.ProjectID)
+	updatePath = strings.ReplaceAll(updatePath, "{snat_rule_id}", d.Id())
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		JSONBody: map[string]interface{}{
+			"snat_rule": map[string]interface{}{
+				"transit_ip_ids": []string{d.Get("transit_ip_id").(string)},
+				"description":    d.Get("description"),
+			},
+		},
+	}
+	if _, err = client.Request("PUT", updatePath, &updateOpt); err != nil {
+		return diag.Errorf("error updating private SNAT rule (%s): %s", d.Id(), err)
+	}
+
+	if err = waitForPrivateSnatRuleActive(ctx, client, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("error waiting for the private SNAT rule (%s) to become active: %s", d.Id(), err)
+	}
+
+	return resourcePrivateSnatRuleRead(ctx, d, meta)
+}
+
+func resourcePrivateSnatRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NatV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	deletePath := client.Endpoint + "v3/{project_id}/private-nat/snat-rules/{snat_rule_id}"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{snat_rule_id}", d.Id())
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{204},
+	}
+	if _, err = client.Request("DELETE", deletePath, &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting private SNAT rule")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"PENDING", "ACTIVE"},
+		Target:       []string{"DELETED"},
+		Refresh:      privateSnatRuleStateRefreshFunc(client, d.Id()),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Delay:        3 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
+		return diag.Errorf("error waiting for the private SNAT rule (%s) to be deleted: %s", d.Id(), err)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package nat
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func ResourcePrivateTransitIp() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePrivateTransitIpCreate,
+		ReadContext:   resourcePrivateTransitIpRead,
+		DeleteContext: resourcePrivateTransitIpDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `The ID of the transit subnet to which the transit IP belongs.`,
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The IP address of the transit IP.`,
+			},
+			"enterprise_project_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: `The ID of the enterprise project to which the transit IP belongs.`,
+			},
+			"tags": common.TagsForceNewSchema(),
+			"network_interface_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The network interface ID of the transit IP.`,
+			},
+			"gateway_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `The ID of the private NAT gateway to which the transit IP is associated.`,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourcePrivateTransitIpCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NatV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	createPath := client.Endpoint + "v3/{project_id}/private-nat/transit-ips"
+	createPath = strings.ReplaceAll(createPath, "{project_id}", client.ProjectID)
+	createOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{201},
+		JSONBody: map[string]interface{}{
+			"transit_ip": utils.RemoveNil(map[string]interface{}{
+				"virsubnet_id":          d.Get("subnet_id"),
+				"ip_address":            utils.ValueIngoreEmpty(d.Get("ip_address")),
+				"enterprise_project_id": utils.ValueIngoreEmpty(common.GetEnterpriseProjectID(d, cfg)),
+				"tags":                  utils.ExpandResourceTagsMap(d.Get("tags").(map[string]interface{})),
+			}),
+		},
+	}
+	resp, err := client.Request("POST", createPath, &createOpt)
+	if err != nil {
+		return diag.Errorf("error creating transit IP: %s", err)
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	transitIpId := utils.PathSearch("transit_ip.id", respBody, "").(string)
+	if transitIpId == "" {
+		return diag.Errorf("unable to find the transit IP ID from the API response")
+	}
+	d.SetId(transitIpId)
+
+	return resourcePrivateTransitIpRead(ctx, d, meta)
+}
+
+func resourcePrivateTransitIpRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NatV2Client(region)
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	getPath := client.Endpoint + "v3/{project_id}/private-nat/transit-ips/{transit_ip_id}"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{transit_ip_id}", d.Id())
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+	}
+	resp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving transit IP")
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("region", region),
+		d.Set("subnet_id", utils.PathSearch("transit_ip.virsubnet_id", respBody, nil)),
+		d.Set("ip_address", utils.PathSearch("transit_ip.ip_address", respBody, nil)),
+		d.Set("enterprise_project_id", utils.PathSearch("transit_ip.enterprise_project_id", respBody, nil)),
+		d.Set("tags", utils.FlattenTagsToMap(utils.PathSearch("transit_ip.tags", respBody, nil))),
+		d.Set("network_interface_id", utils.PathSearch("transit_ip.network_interface_id", respBody, nil)),
+		d.Set("gateway_id", utils.PathSearch("transit_ip.gateway_id", respBody, nil)),
+		d.Set("created_at", utils.PathSearch("transit_ip.created_at", respBody, nil)),
+		d.Set("updated_at", utils.PathSearch("transit_ip.updated_at", respBody, nil)),
+	)
+	if err = mErr.ErrorOrNil(); err != nil {
+		return diag.Errorf("error saving transit IP fields: %s", err)
+	}
+	return nil
+}
+
+func resourcePrivateTransitIpDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	client, err := cfg.NatV2Client(cfg.GetRegion(d))
+	if err != nil {
+		return diag.Errorf("error creating NAT v2 client: %s", err)
+	}
+
+	deletePath := client.Endpoint + "v3/{project_id}/private-nat/transit-ips/{transit_ip_id}"
+	deletePath = strings.ReplaceAll(deletePath, "{project_id}", client.ProjectID)
+	deletePath = strings.ReplaceAll(deletePath, "{transit_ip_id}", d.Id())
+	deleteOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{204},
+	}
+	if _, err = client.Request("DELETE", deletePath, &deleteOpt); err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting transit IP")
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+func DataSourceRestoreTimeRanges() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRestoreTimeRangesRead,
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the ID of the RDS instance.`,
+			},
+			"date": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Specifies the date to be queried, in the "yyyy-mm-dd" format.`,
+			},
+			"restore_time": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: `Indicates the list of restoration time ranges.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"start_time": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `Indicates the start time of the restoration time range, in milliseconds.`,
+						},
+						"end_time": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: `Indicates the end time of the restoration time range, in milliseconds.`,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRestoreTimeRangesRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	var mErr *multierror.Error
+
+	// getRestoreTime: Query the restoration time ranges of the RDS instance.
+	var (
+		getRestoreTimeHttpUrl = "v3/{project_id}/instances/{instance_id}/restore-time"
+		getRestoreTimeProduct = "rds"
+	)
+	getRestoreTimeClient, err := cfg.NewServiceClient(getRestoreTimeProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating RDS Client: %s", err)
+	}
+
+	getRestoreTimePath := getRestoreTimeClient.Endpoint + getRestoreTimeHttpUrl
+	getRestoreTimePath = strings.ReplaceAll(getRestoreTimePath, "{project_id}", getRestoreTimeClient.ProjectID)
+	getRestoreTimePath = strings.ReplaceAll(getRestoreTimePath, "{instance_id}", d.Get("instance_id").(string))
+	if v, ok := d.GetOk("date"); ok {
+		getRestoreTimePath += fmt.Sprintf("?date=%v", v)
+	}
+
+	getRestoreTimeOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	}
+	getRestoreTimeResp, err := getRestoreTimeClient.Request("GET", getRestoreTimePath, &getRestoreTimeOpt)
+	if err != nil {
+		return diag.Errorf("error retrieving RDS restoration time ranges: %s", err)
+	}
+
+	getRestoreTimeRespBody, err := utils.FlattenResponse(getRestoreTimeResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	uuid, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(uuid)
+
+	mErr = multierror.Append(
+		mErr,
+		d.Set("region", region),
+		d.Set("restore_time", flattenRestoreTimeRanges(getRestoreTimeRespBody)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenRestoreTimeRanges(resp interface{}) []interface{} {
+	curJson := utils.PathSearch("restore_time", resp, make([]interface{}, 0))
+	curArray := curJson.([]interface{})
+	rst := make([]interface{}, 0, len(curArray))
+	for _, v := range curArray {
+		rst = append(rst, map[string]interface{}{
+			"start_time": utils.PathSearch("start_time", v, nil),
+			"end_time":   utils.PathSearch("end_time", v, nil),
+		})
+	}
+	return rst
+}
@@ -0,0 +1,220 @@
+package rds
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceCrossRegionBackupStrategy is the impl for huaweicloud_rds_cross_region_backup_strategy resource,
+// the resource ID is the instance ID.
+func ResourceCrossRegionBackupStrategy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCrossRegionBackupStrategyCreate,
+		ReadContext:   resourceCrossRegionBackupStrategyRead,
+		UpdateContext: resourceCrossRegionBackupStrategyUpdate,
+		DeleteContext: resourceCrossRegionBackupStrategyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the RDS instance.`,
+			},
+			"backup_type": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the type of the backups to be replicated to the destination region.`,
+				ValidateFunc: validation.StringInSlice([]string{
+					"auto", "all",
+				}, false),
+			},
+			"keep_days": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  `Specifies the number of days to retain the backups in the destination region.`,
+				ValidateFunc: validation.IntBetween(1, 1825),
+			},
+			"destination_region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the region to which the backups are replicated.`,
+			},
+			"destination_project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the project ID of the destination region.`,
+			},
+		},
+	}
+}
+
+func updateCrossRegionBackupStrategy(client *golangsdk.ServiceClient, instanceID string,
+	params map[string]interface{}) error {
+	// updateStrategy: Set or disable the cross-region backup policy of the RDS instance.
+	updateStrategyHttpUrl := "v3/{project_id}/instances/{instance_id}/backups/offsite-policy"
+	updateStrategyPath := client.Endpoint + updateStrategyHttpUrl
+	updateStrategyPath = strings.ReplaceAll(updateStrategyPath, "{project_id}", client.ProjectID)
+	updateStrategyPath = strings.ReplaceAll(updateStrategyPath, "{instance_id}", instanceID)
+
+	updateStrategyOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+		JSONBody: map[string]interface{}{
+			"policy_para": params,
+		},
+	}
+	_, err := client.Request("PUT", updateStrategyPath, &updateStrategyOpt)
+	return err
+}
+
+func buildCrossRegionBackupStrategyParams(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"backup_type":            d.Get("backup_type"),
+		"keep_days":              d.Get("keep_days"),
+		"destination_region":     d.Get("destination_region"),
+		"destination_project_id": d.Get("destination_project_id"),
+	}
+}
+
+func resourceCrossRegionBackupStrategyCreate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("rds", region)
+	if err != nil {
+		return diag.Errorf("error creating RDS Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	err = updateCrossRegionBackupStrategy(client, instanceID, buildCrossRegionBackupStrategyParams(d))
+	if err != nil {
+		return diag.Errorf("error setting cross-region backup strategy of RDS instance (%s): %s", instanceID, err)
+	}
+
+	d.SetId(instanceID)
+
+	return resourceCrossRegionBackupStrategyRead(ctx, d, meta)
+}
+
+func resourceCrossRegionBackupStrategyRead(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	var mErr *multierror.Error
+
+	// getStrategy: Query the cross-region backup policy of the RDS instance.
+	var (
+		getStrategyHttpUrl = "v3/{project_id}/instances/{instance_id}/backups/offsite-policy"
+		getStrategyProduct = "rds"
+	)
+	getStrategyClient, err := cfg.NewServiceClient(getStrategyProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating RDS Client: %s", err)
+	}
+
+	getStrategyPath := getStrategyClient.Endpoint + getStrategyHttpUrl
+	getStrategyPath = strings.ReplaceAll(getStrategyPath, "{project_id}", getStrategyClient.ProjectID)
+	getStrategyPath = strings.ReplaceAll(getStrategyPath, "{instance_id}", d.Id())
+
+	getStrategyOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	}
+	getStrategyResp, err := getStrategyClient.Request("GET", getStrategyPath, &getStrategyOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving RDS cross-region backup strategy")
+	}
+
+	getStrategyRespBody, err := utils.FlattenResponse(getStrategyResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the policy is disabled if the retention days is 0
+	policy := utils.PathSearch("policy_para[?keep_days > `0`] | [0]", getStrategyRespBody, nil)
+	if policy == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving RDS cross-region backup strategy")
+	}
+
+	mErr = multierror.Append(
+		mErr,
+		d.Set("region", region),
+		d.Set("instance_id", d.Id()),
+		d.Set("backup_type", utils.PathSearch("backup_type", policy, nil)),
+		d.Set("keep_days", utils.PathSearch("keep_days", policy, nil)),
+		d.Set("destination_region", utils.PathSearch("destination_region", policy, nil)),
+		d.Set("destination_project_id", utils.PathSearch("destination_project_id", policy, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceCrossRegionBackupStrategyUpdate(ctx context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("rds", region)
+	if err != nil {
+		return diag.Errorf("error creating RDS Client: %s", err)
+	}
+
+	err = updateCrossRegionBackupStrategy(client, d.Id(), buildCrossRegionBackupStrategyParams(d))
+	if err != nil {
+		return diag.Errorf("error updating cross-region backup strategy of RDS instance (%s): %s", d.Id(), err)
+	}
+
+	return resourceCrossRegionBackupStrategyRead(ctx, d, meta)
+}
+
+func resourceCrossRegionBackupStrategyDelete(_ context.Context, d *schema.ResourceData,
+	meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("rds", region)
+	if err != nil {
+		return diag.Errorf("error creating RDS Client: %s", err)
+	}
+
+	// the cross-region backup policy is disabled by setting the retention days to 0
+	params := buildCrossRegionBackupStrategyParams(d)
+	params["keep_days"] = 0
+	if err = updateCrossRegionBackupStrategy(client, d.Id(), params); err != nil {
+		return common.CheckDeletedDiag(d, err, "error disabling RDS cross-region backup strategy")
+	}
+
+	return nil
+}
@@ -0,0 +1,256 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceDrRelationship is the impl for huaweicloud_rds_dr_relationship resource, which builds a disaster recovery
+// relationship between an RDS instance and a DR instance in another region.
+// The resource ID is in the format of <instance_id>/<dr_instance_id>.
+func ResourceDrRelationship() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDrRelationshipCreate,
+		ReadContext:   resourceDrRelationshipRead,
+		DeleteContext: resourceDrRelationshipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDrRelationshipImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the primary RDS instance.`,
+			},
+			"dr_region": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the region of the DR instance.`,
+			},
+			"dr_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the DR instance.`,
+			},
+			"master_instance_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the ID of the instance which is currently the primary one of the relationship.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the status of the DR relationship.`,
+			},
+		},
+	}
+}
+
+func buildDrRelationTargetParams(client *golangsdk.ServiceClient, region, instanceID string) (map[string]interface{},
+	error) {
+	instance, err := GetRdsInstanceByID(client, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	if instance.Id == "" {
+		return nil, fmt.Errorf("the RDS instance (%s) does not exist in region %s", instanceID, region)
+	}
+	if len(instance.PrivateIps) == 0 {
+		return nil, fmt.Errorf("the private IP of RDS instance (%s) is not found", instanceID)
+	}
+
+	return map[string]interface{}{
+		"target_instance_id": instanceID,
+		"target_project_id":  client.ProjectID,
+		"target_region":      region,
+		"target_ip":          instance.PrivateIps[0],
+	}, nil
+}
+
+func resourceDrRelationshipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	drRegion := d.Get("dr_region").(string)
+	client, err := cfg.NewServiceClient("rds", region)
+	if err != nil {
+		return diag.Errorf("error creating RDS Client: %s", err)
+	}
+	drClient, err := cfg.NewServiceClient("rds", drRegion)
+	if err != nil {
+		return diag.Errorf("error creating RDS Client of the DR region: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	drInstanceID := d.Get("dr_instance_id").(string)
+	primaryParams, err := buildDrRelationTargetParams(client, region, instanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	drParams, err := buildDrRelationTargetParams(drClient, drRegion, drInstanceID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the DR instance must be configured before the primary instance
+	err = doRdsInstanceActionAndWait(drClient, drInstanceID, "build_slave_dr_relation", primaryParams,
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error configuring DR instance (%s): %s", drInstanceID, err)
+	}
+	err = doRdsInstanceActionAndWait(client, instanceID, "build_master_dr_relation", drParams,
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error configuring primary instance (%s) for DR: %s", instanceID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, drInstanceID))
+
+	return resourceDrRelationshipRead(ctx, d, meta)
+}
+
+func resourceDrRelationshipRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	var mErr *multierror.Error
+
+	// getDrInfos: Query the DR relationships of the RDS instance.
+	var (
+		getDrInfosHttpUrl = "v3/{project_id}/instances/{instance_id}/disaster-recovery-infos"
+		getDrInfosProduct = "rds"
+	)
+	getDrInfosClient, err := cfg.NewServiceClient(getDrInfosProduct, region)
+	if err != nil {
+		return diag.Errorf("error creating RDS Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	drInstanceID := d.Get("dr_instance_id").(string)
+	getDrInfosPath := getDrInfosClient.Endpoint + getDrInfosHttpUrl
+	getDrInfosPath = strings.ReplaceAll(getDrInfosPath, "{project_id}", getDrInfosClient.ProjectID)
+	getDrInfosPath = strings.ReplaceAll(getDrInfosPath, "{instance_id}", instanceID)
+
+	getDrInfosOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	}
+	getDrInfosResp, err := getDrInfosClient.Request("GET", getDrInfosPath, &getDrInfosOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving RDS DR relationship")
+	}
+
+	getDrInfosRespBody, err := utils.FlattenResponse(getDrInfosResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the roles of the two instances are exchanged after a switchover
+	drInfo := utils.PathSearch(fmt.Sprintf("instance_dr_infos[?(master_instance_id=='%[1]s'&&slave_instance_id=='%[2]s')"+
+		"||(master_instance_id=='%[2]s'&&slave_instance_id=='%[1]s')] | [0]", instanceID, drInstanceID),
+		getDrInfosRespBody, nil)
+	if drInfo == nil {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving RDS DR relationship")
+	}
+
+	masterInstanceID := utils.PathSearch("master_instance_id", drInfo, "").(string)
+	drRegion := utils.PathSearch("slave_region", drInfo, nil)
+	if masterInstanceID == drInstanceID {
+		drRegion = utils.PathSearch("master_region", drInfo, nil)
+	}
+
+	mErr = multierror.Append(
+		mErr,
+		d.Set("region", region),
+		d.Set("dr_region", drRegion),
+		d.Set("master_instance_id", masterInstanceID),
+		d.Set("status", utils.PathSearch("status", drInfo, nil)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func resourceDrRelationshipDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	drRegion := d.Get("dr_region").(string)
+	client, err := cfg.NewServiceClient("rds", region)
+	if err != nil {
+		return diag.Errorf("error creating RDS Client: %s", err)
+	}
+	drClient, err := cfg.NewServiceClient("rds", drRegion)
+	if err != nil {
+		return diag.Errorf("error creating RDS Client of the DR region: %s", err)
+	}
+
+	masterClient, masterRegion, masterID := client, region, d.Get("instance_id").(string)
+	slaveClient, slaveRegion, slaveID := drClient, drRegion, d.Get("dr_instance_id").(string)
+	if d.Get("master_instance_id").(string) == slaveID {
+		masterClient, masterRegion, masterID, slaveClient, slaveRegion, slaveID =
+			slaveClient, slaveRegion, slaveID, masterClient, masterRegion, masterID
+	}
+
+	masterParams, err := buildDrRelationTargetParams(masterClient, masterRegion, masterID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	slaveParams, err := buildDrRelationTargetParams(slaveClient, slaveRegion, slaveID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = doRdsInstanceActionAndWait(masterClient, masterID, "delete_master_dr_relation", slaveParams,
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting DR configuration of primary instance")
+	}
+	err = doRdsInstanceActionAndWait(slaveClient, slaveID, "delete_slave_dr_relation", masterParams,
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.Errorf("error deleting DR configuration of DR instance (%s): %s", slaveID, err)
+	}
+
+	return nil
+}
+
+func resourceDrRelationshipImportState(_ context.Context, d *schema.ResourceData,
+	_ interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid format specified for import ID, must be <instance_id>/<dr_instance_id>")
+	}
+
+	mErr := multierror.Append(nil,
+		d.Set("instance_id", parts[0]),
+		d.Set("dr_instance_id", parts[1]),
+	)
+	return []*schema.ResourceData{d}, mErr.ErrorOrNil()
+}
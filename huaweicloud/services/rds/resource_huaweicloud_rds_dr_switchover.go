@@ -0,0 +1,94 @@
+package rds
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+)
+
+// ResourceDrSwitchover is the impl for huaweicloud_rds_dr_switchover resource, which promotes a DR instance to the
+// primary one. It is a one-time operation, re-create the resource to perform the operation again.
+func ResourceDrSwitchover() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDrSwitchoverCreate,
+		ReadContext:   resourceDrSwitchoverRead,
+		DeleteContext: resourceDrSwitchoverDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the DR instance to be promoted.`,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "switchover",
+				Description: `Specifies the type of the promotion.`,
+				ValidateFunc: validation.StringInSlice([]string{
+					"switchover", "failover",
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceDrSwitchoverCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("rds", region)
+	if err != nil {
+		return diag.Errorf("error creating RDS Client: %s", err)
+	}
+
+	// switchover exchanges the roles of the two instances, and failover promotes the DR instance to a standalone one
+	// when the primary instance is unavailable.
+	instanceID := d.Get("instance_id").(string)
+	action := d.Get("type").(string) + "_dr"
+	err = doRdsInstanceActionAndWait(client, instanceID, action, map[string]interface{}{},
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error promoting DR instance (%s): %s", instanceID, err)
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return diag.Errorf("unable to generate ID: %s", err)
+	}
+	d.SetId(id)
+
+	return resourceDrSwitchoverRead(ctx, d, meta)
+}
+
+func resourceDrSwitchoverRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceDrSwitchoverDelete(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	errorMsg := "Deleting DR switchover resource is not supported. The resource is only removed from the state, " +
+		"the roles of the instances remain unchanged."
+	return diag.Diagnostics{
+		diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  errorMsg,
+		},
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/chnsz/golangsdk"
 	"github.com/chnsz/golangsdk/openstack/bss/v2/orders"
@@ -34,6 +35,15 @@ func ResourceRdsInstance() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: func(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+			// only the time zone of MySQL instances can be changed through the parameter
+			if d.Id() != "" && d.HasChange("time_zone") &&
+				!strings.EqualFold(d.Get("db.0.type").(string), "mysql") {
+				return d.ForceNew("time_zone")
+			}
+			return nil
+		},
+
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(30 * time.Minute),
 			Update:  schema.DefaultTimeout(30 * time.Minute),
@@ -183,7 +193,6 @@ func ResourceRdsInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
 
 			"param_group_id": {
@@ -192,6 +201,68 @@ func ResourceRdsInstance() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"parameters": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
+			"restart_for_parameters": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"sql_audit": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"keep_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 732),
+						},
+					},
+				},
+			},
+
+			"lts_configs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"log_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"error_log", "slow_log",
+							}, false),
+						},
+						"lts_group_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"lts_stream_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+
 			"collation": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -205,11 +276,43 @@ func ResourceRdsInstance() *schema.Resource {
 
 			"tags": common.TagsSchema(),
 
+			"restore": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"backup_id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"restore.0.restore_time"},
+						},
+						"restore_time": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+						"database_name": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
 			"time_zone": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
-				ForceNew: true,
 			},
 
 			"nodes": {
@@ -262,6 +365,11 @@ func ResourceRdsInstance() *schema.Resource {
 				Computed: true,
 			},
 
+			"restart_required": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
 			"created": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -290,6 +398,50 @@ func isMySQLDatabase(d *schema.ResourceData) bool {
 	return strings.ToLower(dbType) == "mysql"
 }
 
+// restoreCreateOpts is used to create a new instance from the backup or the point in time of an existing instance.
+type restoreCreateOpts struct {
+	instances.CreateOpts
+	RestorePoint *restorePoint `json:"restore_point"`
+}
+
+type restorePoint struct {
+	InstanceId   string                 `json:"instance_id"`
+	Type         string                 `json:"type"`
+	BackupId     string                 `json:"backup_id,omitempty"`
+	RestoreTime  int                    `json:"restore_time,omitempty"`
+	DatabaseName map[string]interface{} `json:"database_name,omitempty"`
+}
+
+func (opts restoreCreateOpts) ToInstancesCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOpts.ToInstancesCreateMap()
+	if err != nil {
+		return nil, err
+	}
+	b["restore_point"] = opts.RestorePoint
+	return b, nil
+}
+
+func buildRdsInstanceRestorePoint(d *schema.ResourceData) *restorePoint {
+	restoreRaw := d.Get("restore").([]interface{})
+	if len(restoreRaw) < 1 {
+		return nil
+	}
+
+	raw := restoreRaw[0].(map[string]interface{})
+	point := restorePoint{
+		InstanceId:   raw["instance_id"].(string),
+		BackupId:     raw["backup_id"].(string),
+		RestoreTime:  raw["restore_time"].(int),
+		DatabaseName: raw["database_name"].(map[string]interface{}),
+	}
+	if point.BackupId != "" {
+		point.Type = "backup"
+	} else {
+		point.Type = "timestamp"
+	}
+	return &point
+}
+
 func resourceRdsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	config := meta.(*config.Config)
 	region := config.GetRegion(d)
@@ -343,7 +495,16 @@ func resourceRdsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 	// Add password here so it wouldn't go in the above log entry
 	createOpts.Password = d.Get("db.0.password").(string)
 
-	res, err := instances.Create(client, createOpts).Extract()
+	var createBuilder instances.CreateRdsBuilder = createOpts
+	if point := buildRdsInstanceRestorePoint(d); point != nil {
+		log.Printf("[DEBUG] Restore point: %#v", point)
+		createBuilder = restoreCreateOpts{
+			CreateOpts:   createOpts,
+			RestorePoint: point,
+		}
+	}
+
+	res, err := instances.Create(client, createBuilder).Extract()
 	if err != nil {
 		return diag.Errorf("error creating RDS instance: %s", err)
 	}
@@ -401,6 +562,18 @@ func resourceRdsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if err := updateRdsInstanceParameters(d, client, instanceID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateRdsInstanceSQLAudit(d, client, instanceID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := updateRdsInstanceLtsConfigs(d, client, instanceID); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceRdsInstanceRead(ctx, d, meta)
 }
 
@@ -500,6 +673,20 @@ func resourceRdsInstanceRead(ctx context.Context, d *schema.ResourceData, meta i
 		return diag.Errorf("error saving nodes to RDS instance (%s): %s", instanceID, err)
 	}
 
+	if err := setRdsInstanceParameters(d, client, instanceID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if isMySQLDatabase(d) {
+		if err := setRdsInstanceSQLAudit(d, client, instanceID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := setRdsInstanceLtsConfigs(d, client, instanceID); err != nil {
+		return diag.FromErr(err)
+	}
+
 	az1 := instance.Nodes[0].AvailabilityZone
 	if strings.HasSuffix(d.Get("flavor").(string), ".ha") {
 		if len(instance.Nodes) < 2 {
@@ -574,6 +761,28 @@ func resourceRdsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.FromErr(err)
 	}
 
+	if err := updateRdsInstanceHaReplicationMode(d, client, instanceID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("parameters", "time_zone") {
+		if err := updateRdsInstanceParameters(d, client, instanceID, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("sql_audit") {
+		if err := updateRdsInstanceSQLAudit(d, client, instanceID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("lts_configs") {
+		if err := updateRdsInstanceLtsConfigs(d, client, instanceID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	if d.HasChange("tags") {
 		tagErr := utils.UpdateResourceTags(client, d, "instances", instanceID)
 		if tagErr != nil {
@@ -925,6 +1134,49 @@ func configRdsInstanceSSL(d *schema.ResourceData, client *golangsdk.ServiceClien
 	return nil
 }
 
+// doRdsInstanceAction performs an action on the RDS instance and returns the job ID.
+func doRdsInstanceAction(client *golangsdk.ServiceClient, instanceID, action string,
+	params map[string]interface{}) (string, error) {
+	operateHttpUrl := "v3/{project_id}/instances/{instance_id}/action"
+	operatePath := client.Endpoint + operateHttpUrl
+	operatePath = strings.ReplaceAll(operatePath, "{project_id}", client.ProjectID)
+	operatePath = strings.ReplaceAll(operatePath, "{instance_id}", instanceID)
+
+	operateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 202,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+		JSONBody: map[string]interface{}{
+			action: params,
+		},
+	}
+	log.Printf("[DEBUG] Perform %s on RDS instance (%s): %#v", action, instanceID, params)
+	operateResp, err := client.Request("POST", operatePath, &operateOpt)
+	if err != nil {
+		return "", err
+	}
+
+	operateRespBody, err := utils.FlattenResponse(operateResp)
+	if err != nil {
+		return "", err
+	}
+	return utils.PathSearch("job_id", operateRespBody, "").(string), nil
+}
+
+func doRdsInstanceActionAndWait(client *golangsdk.ServiceClient, instanceID, action string,
+	params map[string]interface{}, timeout time.Duration) error {
+	jobID, err := doRdsInstanceAction(client, instanceID, action, params)
+	if err != nil {
+		return err
+	}
+	if jobID == "" {
+		return nil
+	}
+	return checkRDSInstanceJobFinish(client, jobID, timeout)
+}
+
 func checkRDSInstanceJobFinish(client *golangsdk.ServiceClient, jobID string, timeout time.Duration) error {
 	stateConf := &resource.StateChangeConf{
 		Pending:      []string{"Running"},
@@ -982,3 +1234,305 @@ func updateRdsRootPassword(d *schema.ResourceData, client *golangsdk.ServiceClie
 	}
 	return nil
 }
+
+func updateRdsInstanceHaReplicationMode(d *schema.ResourceData, client *golangsdk.ServiceClient,
+	instanceID string) error {
+	if !d.HasChange("ha_replication_mode") {
+		return nil
+	}
+
+	updatePath := client.Endpoint + "v3/{project_id}/instances/{instance_id}/failover/mode"
+	updatePath = strings.ReplaceAll(updatePath, "{project_id}", client.ProjectID)
+	updatePath = strings.ReplaceAll(updatePath, "{instance_id}", instanceID)
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 202},
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+		JSONBody: map[string]interface{}{
+			"mode": d.Get("ha_replication_mode"),
+		},
+	}
+	if _, err := client.Request("PUT", updatePath, &updateOpt); err != nil {
+		return fmt.Errorf("error updating replication mode of RDS instance (%s): %s", instanceID, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{"MODIFYING"},
+		Target:       []string{"ACTIVE"},
+		Refresh:      rdsInstanceStateRefreshFunc(client, instanceID),
+		Timeout:      d.Timeout(schema.TimeoutUpdate),
+		Delay:        5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for RDS instance (%s) replication mode to be updated: %s", instanceID, err)
+	}
+	return nil
+}
+
+// buildRdsInstanceParameterValues returns the parameters to be applied on top of the parameter group, the time zone of
+// MySQL instances is changed by the time_zone parameter, e.g. UTC+08:00 is converted to +08:00.
+func buildRdsInstanceParameterValues(d *schema.ResourceData) map[string]interface{} {
+	values := make(map[string]interface{})
+	for _, v := range d.Get("parameters").(*schema.Set).List() {
+		param := v.(map[string]interface{})
+		values[param["name"].(string)] = param["value"]
+	}
+
+	if !d.IsNewResource() && d.HasChange("time_zone") {
+		offset := strings.TrimPrefix(d.Get("time_zone").(string), "UTC")
+		if offset == "" {
+			offset = "+00:00"
+		}
+		values["time_zone"] = offset
+	}
+	return values
+}
+
+func updateRdsInstanceParameters(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string,
+	timeout time.Duration) error {
+	values := buildRdsInstanceParameterValues(d)
+	if len(values) == 0 {
+		return nil
+	}
+
+	updatePath := client.Endpoint + "v3/{project_id}/instances/{instance_id}/configurations"
+	updatePath = strings.ReplaceAll(updatePath, "{project_id}", client.ProjectID)
+	updatePath = strings.ReplaceAll(updatePath, "{instance_id}", instanceID)
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200, 202},
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+		JSONBody: map[string]interface{}{
+			"values": values,
+		},
+	}
+	log.Printf("[DEBUG] Update parameters of RDS instance (%s): %#v", instanceID, values)
+	updateResp, err := client.Request("PUT", updatePath, &updateOpt)
+	if err != nil {
+		return fmt.Errorf("error updating parameters of RDS instance (%s): %s", instanceID, err)
+	}
+	updateRespBody, err := utils.FlattenResponse(updateResp)
+	if err != nil {
+		return err
+	}
+
+	if jobID := utils.PathSearch("job_id", updateRespBody, "").(string); jobID != "" {
+		if err := checkRDSInstanceJobFinish(client, jobID, timeout); err != nil {
+			return fmt.Errorf("error updating parameters of RDS instance (%s): %s", instanceID, err)
+		}
+	}
+
+	restartRequired := utils.PathSearch("restart_required", updateRespBody, false).(bool)
+	if restartRequired && d.Get("restart_for_parameters").(bool) {
+		err = doRdsInstanceActionAndWait(client, instanceID, "restart", map[string]interface{}{}, timeout)
+		if err != nil {
+			return fmt.Errorf("error restarting RDS instance (%s) to apply the parameters: %s", instanceID, err)
+		}
+		restartRequired = false
+	}
+	if restartRequired {
+		log.Printf("[WARN] the parameters of RDS instance (%s) take effect after the instance is restarted", instanceID)
+	}
+
+	return d.Set("restart_required", restartRequired)
+}
+
+func setRdsInstanceParameters(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) error {
+	rawParams := d.Get("parameters").(*schema.Set).List()
+	if len(rawParams) == 0 {
+		return nil
+	}
+
+	getPath := client.Endpoint + "v3/{project_id}/instances/{instance_id}/configurations"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{instance_id}", instanceID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return fmt.Errorf("error retrieving parameters of RDS instance (%s): %s", instanceID, err)
+	}
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return err
+	}
+
+	// only the parameters specified in the configuration are saved
+	params := make([]map[string]interface{}, 0, len(rawParams))
+	for _, v := range rawParams {
+		name := v.(map[string]interface{})["name"].(string)
+		expression := fmt.Sprintf("configuration_parameters[?name=='%s'] | [0].value", name)
+		if value := utils.PathSearch(expression, getRespBody, nil); value != nil {
+			params = append(params, map[string]interface{}{
+				"name":  name,
+				"value": value,
+			})
+		}
+	}
+	return d.Set("parameters", params)
+}
+
+func updateRdsInstanceSQLAudit(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) error {
+	auditRaw := d.Get("sql_audit").([]interface{})
+	if d.IsNewResource() && len(auditRaw) == 0 {
+		return nil
+	}
+	if !isMySQLDatabase(d) {
+		return fmt.Errorf("only MySQL database support SQL audit")
+	}
+
+	// the SQL audit is disabled if the retention days is 0, and the existing audit logs are retained
+	params := map[string]interface{}{
+		"keep_days": 0,
+	}
+	if len(auditRaw) > 0 {
+		params["keep_days"] = auditRaw[0].(map[string]interface{})["keep_days"]
+	} else {
+		params["reserve_auditlogs"] = true
+	}
+
+	updatePath := client.Endpoint + "v3/{project_id}/instances/{instance_id}/auditlog-policy"
+	updatePath = strings.ReplaceAll(updatePath, "{project_id}", client.ProjectID)
+	updatePath = strings.ReplaceAll(updatePath, "{instance_id}", instanceID)
+	updateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+		JSONBody:         params,
+	}
+	if _, err := client.Request("PUT", updatePath, &updateOpt); err != nil {
+		return fmt.Errorf("error updating SQL audit of RDS instance (%s): %s", instanceID, err)
+	}
+	return nil
+}
+
+func setRdsInstanceSQLAudit(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) error {
+	if len(d.Get("sql_audit").([]interface{})) == 0 {
+		return nil
+	}
+
+	getPath := client.Endpoint + "v3/{project_id}/instances/{instance_id}/auditlog-policy"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath = strings.ReplaceAll(getPath, "{instance_id}", instanceID)
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return fmt.Errorf("error retrieving SQL audit of RDS instance (%s): %s", instanceID, err)
+	}
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return err
+	}
+
+	keepDays := utils.PathSearch("keep_days", getRespBody, float64(0)).(float64)
+	if keepDays == 0 {
+		return d.Set("sql_audit", nil)
+	}
+	return d.Set("sql_audit", []map[string]interface{}{
+		{
+			"keep_days": keepDays,
+		},
+	})
+}
+
+func operateRdsInstanceLtsConfigs(client *golangsdk.ServiceClient, method string, configs []interface{}) error {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	operatePath := client.Endpoint + "v3/{project_id}/logs/lts-configs"
+	operatePath = strings.ReplaceAll(operatePath, "{project_id}", client.ProjectID)
+	operateOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+		JSONBody: map[string]interface{}{
+			"log_configs": configs,
+		},
+	}
+	_, err := client.Request(method, operatePath, &operateOpt)
+	return err
+}
+
+func updateRdsInstanceLtsConfigs(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) error {
+	oldRaw, newRaw := d.GetChange("lts_configs")
+	oldSet, newSet := oldRaw.(*schema.Set), newRaw.(*schema.Set)
+
+	newTypes := make(map[string]bool)
+	for _, v := range newSet.List() {
+		newTypes[v.(map[string]interface{})["log_type"].(string)] = true
+	}
+
+	// the log types which are not configured any more are disassociated, the others are overwritten
+	removeConfigs := make([]interface{}, 0)
+	for _, v := range oldSet.List() {
+		logType := v.(map[string]interface{})["log_type"].(string)
+		if !newTypes[logType] {
+			removeConfigs = append(removeConfigs, map[string]interface{}{
+				"instance_id": instanceID,
+				"log_type":    logType,
+			})
+		}
+	}
+	if err := operateRdsInstanceLtsConfigs(client, "DELETE", removeConfigs); err != nil {
+		return fmt.Errorf("error disassociating RDS instance (%s) from LTS: %s", instanceID, err)
+	}
+
+	addConfigs := make([]interface{}, 0)
+	for _, v := range newSet.Difference(oldSet).List() {
+		raw := v.(map[string]interface{})
+		addConfigs = append(addConfigs, map[string]interface{}{
+			"instance_id":   instanceID,
+			"log_type":      raw["log_type"],
+			"lts_group_id":  raw["lts_group_id"],
+			"lts_stream_id": raw["lts_stream_id"],
+		})
+	}
+	if err := operateRdsInstanceLtsConfigs(client, "POST", addConfigs); err != nil {
+		return fmt.Errorf("error associating RDS instance (%s) with LTS: %s", instanceID, err)
+	}
+	return nil
+}
+
+func setRdsInstanceLtsConfigs(d *schema.ResourceData, client *golangsdk.ServiceClient, instanceID string) error {
+	if d.Get("lts_configs").(*schema.Set).Len() == 0 {
+		return nil
+	}
+
+	getPath := client.Endpoint + "v3/{project_id}/logs/lts-configs"
+	getPath = strings.ReplaceAll(getPath, "{project_id}", client.ProjectID)
+	getPath += fmt.Sprintf("?instance_id=%s&engine=%s", instanceID, strings.ToLower(d.Get("db.0.type").(string)))
+	getOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes:          []int{200},
+		MoreHeaders:      map[string]string{"Content-Type": "application/json"},
+	}
+	getResp, err := client.Request("GET", getPath, &getOpt)
+	if err != nil {
+		return fmt.Errorf("error retrieving LTS configurations of RDS instance (%s): %s", instanceID, err)
+	}
+	getRespBody, err := utils.FlattenResponse(getResp)
+	if err != nil {
+		return err
+	}
+
+	curJson := utils.PathSearch("instance_lts_configs[0].lts_configs[?enabled]", getRespBody, make([]interface{}, 0))
+	curArray := curJson.([]interface{})
+	configs := make([]map[string]interface{}, 0, len(curArray))
+	for _, v := range curArray {
+		configs = append(configs, map[string]interface{}{
+			"log_type":      utils.PathSearch("log_type", v, nil),
+			"lts_group_id":  utils.PathSearch("lts_group_id", v, nil),
+			"lts_stream_id": utils.PathSearch("lts_stream_id", v, nil),
+		})
+	}
+	return d.Set("lts_configs", configs)
+}
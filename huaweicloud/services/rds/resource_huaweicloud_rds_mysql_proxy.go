@@ -0,0 +1,349 @@
+package rds
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/chnsz/golangsdk"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/common"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/config"
+	"github.com/huaweicloud/terraform-provider-huaweicloud/huaweicloud/utils"
+)
+
+// ResourceMysqlProxy is the impl for huaweicloud_rds_mysql_proxy resource, the resource ID is the instance ID.
+func ResourceMysqlProxy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMysqlProxyCreate,
+		ReadContext:   resourceMysqlProxyRead,
+		UpdateContext: resourceMysqlProxyUpdate,
+		DeleteContext: resourceMysqlProxyDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Specifies the ID of the RDS MySQL instance.`,
+			},
+			"flavor": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: `Specifies the flavor of the proxy.`,
+			},
+			"node_num": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  `Specifies the number of the proxy nodes.`,
+				ValidateFunc: validation.IntBetween(2, 32),
+			},
+			"delay_threshold_in_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  `Specifies the replication delay threshold, in seconds.`,
+				ValidateFunc: validation.IntBetween(0, 7200),
+			},
+			"master_node_weight": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  `Specifies the read weight of the primary instance.`,
+				ValidateFunc: validation.IntBetween(0, 1000),
+			},
+			"readonly_nodes_weight": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: `Specifies the read weights of the read replicas.`,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: `Specifies the ID of the read replica.`,
+						},
+						"weight": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							Description:  `Specifies the read weight of the read replica.`,
+							ValidateFunc: validation.IntBetween(0, 1000),
+						},
+					},
+				},
+			},
+			"connection_pool_type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: `Specifies the connection pool type of the proxy.`,
+				ValidateFunc: validation.StringInSlice([]string{
+					"CLOSED", "SESSION",
+				}, false),
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the read/write splitting address of the proxy.`,
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: `Indicates the port of the proxy.`,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: `Indicates the status of the proxy.`,
+			},
+		},
+	}
+}
+
+func buildMysqlProxyPath(client *golangsdk.ServiceClient, instanceID, subPath string) string {
+	path := client.Endpoint + "v3/{project_id}/instances/{instance_id}/proxy" + subPath
+	path = strings.ReplaceAll(path, "{project_id}", client.ProjectID)
+	return strings.ReplaceAll(path, "{instance_id}", instanceID)
+}
+
+// requestMysqlProxy sends the request of the proxy and waits for the job to complete if there is one.
+func requestMysqlProxy(client *golangsdk.ServiceClient, method, path string, params map[string]interface{},
+	timeout time.Duration) error {
+	opt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200, 202,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	}
+	if params != nil {
+		opt.JSONBody = params
+	}
+	resp, err := client.Request(method, path, &opt)
+	if err != nil {
+		return err
+	}
+
+	respBody, err := utils.FlattenResponse(resp)
+	if err != nil {
+		return err
+	}
+	if jobID := utils.PathSearch("job_id", respBody, "").(string); jobID != "" {
+		return checkRDSInstanceJobFinish(client, jobID, timeout)
+	}
+	return nil
+}
+
+func resourceMysqlProxyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("rds", region)
+	if err != nil {
+		return diag.Errorf("error creating RDS Client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	params := map[string]interface{}{
+		"flavor_ref": d.Get("flavor"),
+		"node_num":   d.Get("node_num"),
+	}
+	err = requestMysqlProxy(client, "POST", buildMysqlProxyPath(client, instanceID, ""), params,
+		d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.Errorf("error creating RDS MySQL proxy: %s", err)
+	}
+
+	d.SetId(instanceID)
+
+	if err = updateMysqlProxyConfigs(d, client, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceMysqlProxyRead(ctx, d, meta)
+}
+
+func resourceMysqlProxyRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+
+	var mErr *multierror.Error
+
+	// getProxy: Query the proxy of the RDS MySQL instance.
+	getProxyClient, err := cfg.NewServiceClient("rds", region)
+	if err != nil {
+		return diag.Errorf("error creating RDS Client: %s", err)
+	}
+
+	getProxyOpt := golangsdk.RequestOpts{
+		KeepResponseBody: true,
+		OkCodes: []int{
+			200,
+		},
+		MoreHeaders: map[string]string{"Content-Type": "application/json"},
+	}
+	getProxyResp, err := getProxyClient.Request("GET", buildMysqlProxyPath(getProxyClient, d.Id(), ""), &getProxyOpt)
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error retrieving RDS MySQL proxy")
+	}
+
+	getProxyRespBody, err := utils.FlattenResponse(getProxyResp)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// the proxy information is still returned after the proxy is disabled
+	status := utils.PathSearch("proxy.status", getProxyRespBody, "").(string)
+	if status == "" || status == "shutdown" {
+		return common.CheckDeletedDiag(d, golangsdk.ErrDefault404{}, "error retrieving RDS MySQL proxy")
+	}
+
+	mErr = multierror.Append(
+		mErr,
+		d.Set("region", region),
+		d.Set("instance_id", d.Id()),
+		d.Set("flavor", utils.PathSearch("proxy.flavor_ref", getProxyRespBody, nil)),
+		d.Set("node_num", utils.PathSearch("proxy.node_num", getProxyRespBody, nil)),
+		d.Set("delay_threshold_in_seconds", utils.PathSearch("proxy.delay_threshold_in_seconds",
+			getProxyRespBody, nil)),
+		d.Set("connection_pool_type", utils.PathSearch("proxy.connection_pool_type", getProxyRespBody, nil)),
+		d.Set("address", utils.PathSearch("proxy.address", getProxyRespBody, nil)),
+		d.Set("port", utils.PathSearch("proxy.port", getProxyRespBody, nil)),
+		d.Set("status", status),
+		d.Set("master_node_weight", utils.PathSearch("master_instance.weight", getProxyRespBody, nil)),
+		d.Set("readonly_nodes_weight", flattenMysqlProxyReadonlyNodesWeight(getProxyRespBody)),
+	)
+
+	return diag.FromErr(mErr.ErrorOrNil())
+}
+
+func flattenMysqlProxyReadonlyNodesWeight(resp interface{}) []interface{} {
+	curJson := utils.PathSearch("readonly_instances", resp, make([]interface{}, 0))
+	curArray := curJson.([]interface{})
+	rst := make([]interface{}, 0, len(curArray))
+	for _, v := range curArray {
+		rst = append(rst, map[string]interface{}{
+			"id":     utils.PathSearch("id", v, nil),
+			"weight": utils.PathSearch("weight", v, nil),
+		})
+	}
+	return rst
+}
+
+func buildMysqlProxyReadonlyNodesWeight(rawWeights []interface{}) []map[string]interface{} {
+	rst := make([]map[string]interface{}, 0, len(rawWeights))
+	for _, v := range rawWeights {
+		raw := v.(map[string]interface{})
+		rst = append(rst, map[string]interface{}{
+			"id":     raw["id"],
+			"weight": raw["weight"],
+		})
+	}
+	return rst
+}
+
+// updateMysqlProxyConfigs updates the read/write splitting configurations, the configurations which are not specified
+// when creating the proxy are skipped.
+func updateMysqlProxyConfigs(d *schema.ResourceData, client *golangsdk.ServiceClient, timeout time.Duration) error {
+	instanceID := d.Id()
+	if d.HasChanges("master_node_weight", "readonly_nodes_weight") {
+		params := map[string]interface{}{
+			"readonly_instances": buildMysqlProxyReadonlyNodesWeight(
+				d.Get("readonly_nodes_weight").(*schema.Set).List()),
+		}
+		if v, ok := d.GetOk("master_node_weight"); ok {
+			params["master_weight"] = v
+		}
+		log.Printf("[DEBUG] Update read weights of RDS MySQL proxy (%s): %#v", instanceID, params)
+		err := requestMysqlProxy(client, "PUT", buildMysqlProxyPath(client, instanceID, "/weight"), params, timeout)
+		if err != nil {
+			return fmt.Errorf("error updating read weights of RDS MySQL proxy (%s): %s", instanceID, err)
+		}
+	}
+
+	if d.HasChange("delay_threshold_in_seconds") {
+		params := map[string]interface{}{
+			"delay_threshold_in_seconds": d.Get("delay_threshold_in_seconds"),
+		}
+		err := requestMysqlProxy(client, "PUT", buildMysqlProxyPath(client, instanceID, "/delay"), params, timeout)
+		if err != nil {
+			return fmt.Errorf("error updating delay threshold of RDS MySQL proxy (%s): %s", instanceID, err)
+		}
+	}
+
+	if d.HasChange("connection_pool_type") {
+		params := map[string]interface{}{
+			"connection_pool_type": d.Get("connection_pool_type"),
+		}
+		err := requestMysqlProxy(client, "PUT", buildMysqlProxyPath(client, instanceID, "/connection-pool-type"),
+			params, timeout)
+		if err != nil {
+			return fmt.Errorf("error updating connection pool type of RDS MySQL proxy (%s): %s", instanceID, err)
+		}
+	}
+	return nil
+}
+
+func resourceMysqlProxyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("rds", region)
+	if err != nil {
+		return diag.Errorf("error creating RDS Client: %s", err)
+	}
+
+	if d.HasChanges("flavor", "node_num") {
+		params := map[string]interface{}{
+			"flavor_ref": d.Get("flavor"),
+			"node_num":   d.Get("node_num"),
+		}
+		err = requestMysqlProxy(client, "POST", buildMysqlProxyPath(client, d.Id(), "/scale"), params,
+			d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.Errorf("error scaling RDS MySQL proxy (%s): %s", d.Id(), err)
+		}
+	}
+
+	if err = updateMysqlProxyConfigs(d, client, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceMysqlProxyRead(ctx, d, meta)
+}
+
+func resourceMysqlProxyDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cfg := meta.(*config.Config)
+	region := cfg.GetRegion(d)
+	client, err := cfg.NewServiceClient("rds", region)
+	if err != nil {
+		return diag.Errorf("error creating RDS Client: %s", err)
+	}
+
+	err = requestMysqlProxy(client, "DELETE", buildMysqlProxyPath(client, d.Id(), ""), nil,
+		d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return common.CheckDeletedDiag(d, err, "error deleting RDS MySQL proxy")
+	}
+	return nil
+}
@@ -30,6 +30,7 @@ func ResourceRdsReadReplicaInstance() *schema.Resource {
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -67,7 +68,6 @@ func ResourceRdsReadReplicaInstance() *schema.Resource {
 			"volume": {
 				Type:     schema.TypeList,
 				Required: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -330,6 +330,10 @@ func resourceRdsReadReplicaInstanceUpdate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	if err = updateRdsInstanceVolumeSize(d, client, instanceID); err != nil {
+		return diag.FromErr(err)
+	}
+
 	if err = updateRdsInstanceAutoRenew(d, config); err != nil {
 		return diag.FromErr(err)
 	}